@@ -0,0 +1,337 @@
+// Package backup implements export/import of a portable snapshot of a
+// jobsearch installation: the SQLite database, a redacted copy of the
+// config, and a manifest describing what's inside - so a user can move
+// their data between machines or snapshot before a risky bulk merge.
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// SchemaVersion is the highest migration this binary knows how to apply.
+// Manifest.SchemaVersion is compared against it on import: an archive from
+// a newer binary is refused outright, since there's no way to know what an
+// unrecognized migration changed; an archive from an older one is brought
+// forward by the normal database.Open migration path.
+const SchemaVersion = 29
+
+const (
+	dbEntryName       = "jobsearch.db"
+	configEntryName   = "config.toml"
+	manifestEntryName = "manifest.json"
+)
+
+// Manifest describes the contents of a backup archive.
+type Manifest struct {
+	SchemaVersion int              `json:"schema_version"`
+	CreatedAt     time.Time        `json:"created_at"`
+	DBChecksum    string           `json:"db_checksum_sha256"`
+	RowCounts     map[string]int64 `json:"row_counts"`
+}
+
+// Export writes a tar.gz archive to w containing the database at dbPath,
+// a Redacted copy of cfg, and a manifest with the schema version, a
+// SHA-256 of the database file, and per-table row counts.
+func Export(ctx context.Context, db *database.DB, cfg *config.Config, dbPath string, w io.Writer) (*Manifest, error) {
+	// Flush WAL contents into the main file first, so the checksum and the
+	// archived bytes reflect everything committed so far.
+	if _, err := db.ExecContext(ctx, "PRAGMA wal_checkpoint(FULL)"); err != nil {
+		return nil, fmt.Errorf("failed to checkpoint database: %w", err)
+	}
+
+	rowCounts, err := tableRowCounts(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count rows: %w", err)
+	}
+
+	checksum, err := sha256File(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to checksum database: %w", err)
+	}
+
+	manifest := &Manifest{
+		SchemaVersion: SchemaVersion,
+		CreatedAt:     time.Now().UTC(),
+		DBChecksum:    checksum,
+		RowCounts:     rowCounts,
+	}
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	configTOML, err := toml.Marshal(cfg.Redacted())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	if err := addTarFile(tw, dbEntryName, dbPath); err != nil {
+		return nil, err
+	}
+	if err := addTarBytes(tw, configEntryName, configTOML); err != nil {
+		return nil, err
+	}
+	if err := addTarBytes(tw, manifestEntryName, manifestJSON); err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize archive: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// Import extracts the archive at archivePath and replaces the database at
+// dbPath with it: extracted into a temp file alongside dbPath, opened
+// (which runs any migrations the archive predates), verified with
+// PRAGMA integrity_check, then atomically renamed into place. The
+// previous database, if any, is kept at dbPath+".bak" rather than deleted.
+//
+// Archives whose manifest schema version is newer than SchemaVersion are
+// refused - this binary doesn't know what a migration it's never heard of
+// did to the schema, so downgrading is not attempted.
+func Import(archivePath, dbPath string) (*Manifest, error) {
+	manifest, configTOML, dbTemp, err := extract(archivePath, filepath.Dir(dbPath))
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(dbTemp)
+	_ = configTOML // returned to callers that want to inspect/merge it; not applied automatically
+
+	if manifest.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("archive schema version %d is newer than this binary supports (%d); upgrade jobsearch before importing", manifest.SchemaVersion, SchemaVersion)
+	}
+
+	checksum, err := sha256File(dbTemp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to checksum extracted database: %w", err)
+	}
+	if checksum != manifest.DBChecksum {
+		return nil, fmt.Errorf("extracted database checksum %s does not match manifest %s; archive may be corrupt", checksum, manifest.DBChecksum)
+	}
+
+	// Open runs migrations forward if the archive predates SchemaVersion.
+	db, err := database.Open(dbTemp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open extracted database: %w", err)
+	}
+
+	var integrity string
+	scanErr := db.QueryRow("PRAGMA integrity_check").Scan(&integrity)
+	_, checkpointErr := db.Exec("PRAGMA wal_checkpoint(FULL)")
+	db.Close()
+	for _, suffix := range []string{"-wal", "-shm"} {
+		os.Remove(dbTemp + suffix)
+	}
+	if scanErr != nil {
+		return nil, fmt.Errorf("failed to run integrity check: %w", scanErr)
+	}
+	if integrity != "ok" {
+		return nil, fmt.Errorf("integrity check failed: %s", integrity)
+	}
+	if checkpointErr != nil {
+		return nil, fmt.Errorf("failed to checkpoint imported database: %w", checkpointErr)
+	}
+
+	if _, err := os.Stat(dbPath); err == nil {
+		if err := os.Rename(dbPath, dbPath+".bak"); err != nil {
+			return nil, fmt.Errorf("failed to back up existing database: %w", err)
+		}
+	}
+	if err := os.Rename(dbTemp, dbPath); err != nil {
+		return nil, fmt.Errorf("failed to install imported database: %w", err)
+	}
+
+	return manifest, nil
+}
+
+func addTarFile(tw *tar.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: info.Size(),
+	}); err != nil {
+		return fmt.Errorf("failed to write archive header for %s: %w", name, err)
+	}
+
+	if _, err := io.Copy(tw, f); err != nil {
+		return fmt.Errorf("failed to write %s into archive: %w", name, err)
+	}
+	return nil
+}
+
+func addTarBytes(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: int64(len(data)),
+	}); err != nil {
+		return fmt.Errorf("failed to write archive header for %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s into archive: %w", name, err)
+	}
+	return nil
+}
+
+// extract reads the archive at archivePath, writing its database entry to
+// a new temp file in tempDir (so the caller can os.Rename it atomically)
+// and returning the parsed manifest and the raw config TOML bytes.
+func extract(archivePath, tempDir string) (*Manifest, []byte, string, error) {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+
+	var manifest *Manifest
+	var configTOML []byte
+	var dbTemp string
+	ok := false
+	defer func() {
+		if !ok && dbTemp != "" {
+			os.Remove(dbTemp)
+		}
+	}()
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("failed to read archive entry: %w", err)
+		}
+
+		switch header.Name {
+		case manifestEntryName:
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, nil, "", fmt.Errorf("failed to read manifest: %w", err)
+			}
+			manifest = &Manifest{}
+			if err := json.Unmarshal(data, manifest); err != nil {
+				return nil, nil, "", fmt.Errorf("failed to parse manifest: %w", err)
+			}
+		case configEntryName:
+			configTOML, err = io.ReadAll(tr)
+			if err != nil {
+				return nil, nil, "", fmt.Errorf("failed to read config: %w", err)
+			}
+		case dbEntryName:
+			tmp, err := os.CreateTemp(tempDir, "jobsearch-import-*.db")
+			if err != nil {
+				return nil, nil, "", fmt.Errorf("failed to create temp file: %w", err)
+			}
+			dbTemp = tmp.Name()
+			_, copyErr := io.Copy(tmp, tr)
+			closeErr := tmp.Close()
+			if copyErr != nil {
+				return nil, nil, "", fmt.Errorf("failed to extract database: %w", copyErr)
+			}
+			if closeErr != nil {
+				return nil, nil, "", fmt.Errorf("failed to extract database: %w", closeErr)
+			}
+		}
+	}
+
+	if manifest == nil {
+		return nil, nil, "", fmt.Errorf("archive is missing %s", manifestEntryName)
+	}
+	if dbTemp == "" {
+		return nil, nil, "", fmt.Errorf("archive is missing %s", dbEntryName)
+	}
+
+	ok = true
+	return manifest, configTOML, dbTemp, nil
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// tableRowCounts returns a row count for every user table in the database,
+// for the manifest - a cheap sanity check an operator can eyeball without
+// opening the archive.
+func tableRowCounts(ctx context.Context, db *database.DB) (map[string]int64, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'
+	`)
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		tables = append(tables, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	counts := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		var count int64
+		if err := db.QueryRowContext(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM "%s"`, table)).Scan(&count); err != nil {
+			return nil, fmt.Errorf("failed to count rows in %s: %w", table, err)
+		}
+		counts[table] = count
+	}
+	return counts, nil
+}
@@ -0,0 +1,103 @@
+// Package bounce detects and parses delivery-status notifications (RFC
+// 3464 bounces) in inbound email, independent of which email.Provider
+// fetched the message - a DSN looks the same whether it arrived via
+// Gmail, JMAP, or IMAP. Tracker.HandleBounce uses this to recognize a
+// bounce during sync and correlate it back to the conversation whose
+// outbound email failed to deliver, the way listmonk's bounce pipeline
+// parses provider-agnostic DSNs before acting on them.
+package bounce
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// Type distinguishes a permanently failing address from a transient
+// delivery failure worth retrying.
+type Type string
+
+const (
+	Hard Type = "hard" // permanent failure (RFC 3463 5.x.x) - the address is probably dead
+	Soft Type = "soft" // transient failure (RFC 3463 4.x.x) - leave the conversation alone and retry
+)
+
+// Report is a parsed delivery-status notification, ready to apply against
+// the conversation that sent the original email.
+type Report struct {
+	Recipient         string // address the DSN says failed to receive the message
+	Type              Type
+	StatusCode        string // RFC 3463 enhanced status code, e.g. "5.1.1"
+	Action            string // DSN "Action:" field, e.g. "failed" or "delayed"
+	Diagnostic        string // DSN "Diagnostic-Code:" field
+	OriginalMessageID string // Message-ID of the outbound email that bounced, if recoverable
+}
+
+// enhancedStatusCodePattern matches an RFC 3463 enhanced mail status code
+// like "5.1.1" (permanent failure) or "4.7.1" (transient failure).
+var enhancedStatusCodePattern = regexp.MustCompile(`\b([45])\.\d{1,3}\.\d{1,3}\b`)
+
+// recipientPattern matches a DSN's "Original-Recipient:"/"Final-Recipient:"
+// field, e.g. "Final-Recipient: rfc822;jane@acme.com".
+var recipientPattern = regexp.MustCompile(`(?i)(?:original|final)-recipient:\s*rfc822;\s*(\S+@\S+)`)
+
+// actionPattern matches a DSN's "Action:" field, e.g. "Action: failed".
+var actionPattern = regexp.MustCompile(`(?i)action:\s*(\w+)`)
+
+// diagnosticPattern matches a DSN's "Diagnostic-Code:" field, which
+// typically runs to the end of the line.
+var diagnosticPattern = regexp.MustCompile(`(?i)diagnostic-code:\s*(.+)`)
+
+// messageIDPattern matches a Message-ID header, which MTAs commonly
+// reproduce (in the DSN itself or in the message/rfc822 part it attaches)
+// when identifying the original message that bounced.
+var messageIDPattern = regexp.MustCompile(`(?i)message-id:\s*(<[^>]+>)`)
+
+// Detect recognizes a delivery status notification: an RFC 3464
+// multipart/report or message/delivery-status, or a message from
+// mailer-daemon/postmaster carrying an RFC 3463 enhanced status code
+// somewhere in its body. ok is false if e doesn't look like a bounce at
+// all.
+func Detect(e *email.Email) (*Report, bool) {
+	contentType := strings.ToLower(e.Headers["Content-Type"])
+	isDSN := strings.Contains(contentType, "multipart/report") && strings.Contains(contentType, "delivery-status") ||
+		strings.Contains(contentType, "message/delivery-status")
+
+	from := strings.ToLower(e.From.Email)
+	fromMailerDaemon := strings.Contains(from, "mailer-daemon") || strings.Contains(from, "postmaster")
+
+	statusCode := enhancedStatusCodePattern.FindString(e.Body)
+	if !isDSN && !fromMailerDaemon {
+		return nil, false
+	}
+	if !isDSN && statusCode == "" {
+		return nil, false
+	}
+
+	report := &Report{
+		Recipient:  extractField(recipientPattern, e.Body),
+		StatusCode: statusCode,
+		Action:     strings.ToLower(extractField(actionPattern, e.Body)),
+		Diagnostic: extractField(diagnosticPattern, e.Body),
+		Type:       Soft,
+	}
+	if strings.HasPrefix(statusCode, "5.") || report.Action == "failed" {
+		report.Type = Hard
+	}
+
+	if ids := messageIDPattern.FindAllStringSubmatch(e.Body, -1); len(ids) > 0 {
+		// The DSN's own Message-ID (if any) is always the first match; the
+		// original message's, if reproduced, follows it.
+		report.OriginalMessageID = ids[len(ids)-1][1]
+	}
+
+	return report, true
+}
+
+func extractField(pattern *regexp.Regexp, body string) string {
+	if m := pattern.FindStringSubmatch(body); len(m) == 2 {
+		return strings.TrimSpace(m[1])
+	}
+	return ""
+}
@@ -0,0 +1,172 @@
+package bounce
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+)
+
+// sesNotification is the subset of an SES SNS notification this package
+// cares about. SES can deliver bounce notifications either wrapped in an
+// SNS envelope ({"Type":"Notification","Message":"<json>"}) or, if the
+// SNS subscription is configured to skip the envelope, as the bounce
+// payload directly - both shapes are tried in that order.
+type sesNotification struct {
+	Type    string `json:"Type"`
+	Message string `json:"Message"`
+}
+
+type sesBouncePayload struct {
+	NotificationType string `json:"notificationType"`
+	Bounce           struct {
+		BounceType        string `json:"bounceType"` // "Permanent" or "Transient"
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+			Action         string `json:"action"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Mail struct {
+		MessageID     string `json:"messageId"`
+		CommonHeaders struct {
+			MessageID string `json:"messageId"`
+		} `json:"commonHeaders"`
+	} `json:"mail"`
+}
+
+// ParseSES decodes an SES bounce notification (SNS-wrapped or raw) into
+// one Report per bounced recipient. It returns no reports, without
+// error, for an SES event that isn't a bounce notification (e.g. a
+// delivery or complaint notification delivered to the same endpoint).
+func ParseSES(body []byte) ([]*Report, error) {
+	var env sesNotification
+	payloadJSON := body
+	if err := json.Unmarshal(body, &env); err == nil && env.Message != "" {
+		payloadJSON = []byte(env.Message)
+	}
+
+	var payload sesBouncePayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return nil, fmt.Errorf("malformed SES bounce payload: %w", err)
+	}
+	if payload.NotificationType != "Bounce" {
+		return nil, nil
+	}
+
+	bounceType := Soft
+	if payload.Bounce.BounceType == "Permanent" {
+		bounceType = Hard
+	}
+
+	origMessageID := payload.Mail.CommonHeaders.MessageID
+	if origMessageID == "" {
+		origMessageID = payload.Mail.MessageID
+	}
+
+	reports := make([]*Report, 0, len(payload.Bounce.BouncedRecipients))
+	for _, r := range payload.Bounce.BouncedRecipients {
+		reports = append(reports, &Report{
+			Recipient:         r.EmailAddress,
+			Type:              bounceType,
+			Action:            r.Action,
+			Diagnostic:        r.DiagnosticCode,
+			OriginalMessageID: origMessageID,
+		})
+	}
+	return reports, nil
+}
+
+// sendGridEvent is the subset of a SendGrid Event Webhook event this
+// package cares about. SendGrid posts a JSON array of events, of which
+// only "bounce" and "dropped" (treated as a hard bounce, since SendGrid
+// drops an address it has already suppressed) are delivery failures.
+type sendGridEvent struct {
+	Event  string `json:"event"`
+	Email  string `json:"email"`
+	Reason string `json:"reason"`
+	Type   string `json:"type"` // "bounce" -> "bounce" or "blocked"; absent for "dropped"
+	SMTPID string `json:"smtp-id"`
+}
+
+// ParseSendGrid decodes a SendGrid Event Webhook POST body - a JSON array
+// of events - into one Report per bounce/dropped event. Events that
+// aren't delivery failures (delivered, open, click, ...) are skipped.
+func ParseSendGrid(body []byte) ([]*Report, error) {
+	var events []sendGridEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, fmt.Errorf("malformed SendGrid event payload: %w", err)
+	}
+
+	var reports []*Report
+	for _, e := range events {
+		switch e.Event {
+		case "bounce":
+			bounceType := Soft
+			if e.Type == "bounce" {
+				bounceType = Hard
+			}
+			reports = append(reports, &Report{
+				Recipient:         e.Email,
+				Type:              bounceType,
+				Diagnostic:        e.Reason,
+				OriginalMessageID: e.SMTPID,
+			})
+		case "dropped":
+			reports = append(reports, &Report{
+				Recipient:         e.Email,
+				Type:              Hard,
+				Diagnostic:        e.Reason,
+				OriginalMessageID: e.SMTPID,
+			})
+		}
+	}
+	return reports, nil
+}
+
+// Apply is called with every Report a webhook handler parses out of a
+// provider's payload, so the handler can stay decoupled from
+// tracker.Tracker (which already imports this package).
+type Apply func(report *Report) error
+
+// WebhookHandler returns an http.HandlerFunc that decodes each POST body
+// with parse, then calls apply for every Report it contains. token must
+// match the bearer token the provider's webhook is configured to send; an
+// empty token disables the check, which is only appropriate behind a
+// private network. parse returning zero reports (a non-bounce event) is
+// not an error and still responds 200, since SES and SendGrid both retry
+// on anything but a 2xx.
+func WebhookHandler(token string, parse func(body []byte) ([]*Report, error), apply Apply) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if token != "" && r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		reports, err := parse(body)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("malformed payload: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		for _, report := range reports {
+			if err := apply(report); err != nil {
+				log.Printf("bounce: failed to apply webhook bounce for %s: %v", report.Recipient, err)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
@@ -0,0 +1,314 @@
+// Package calendar parses iCalendar (RFC 5545) VEVENT data attached to
+// inbound recruiter emails, independent of which email.Provider fetched the
+// message - an invite looks the same whether it arrived via Gmail, JMAP, or
+// IMAP. Tracker uses this to turn a scheduling email into a first-class
+// Interview row instead of just another conversation message.
+package calendar
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is one parsed VEVENT, with just the fields a recruiting interview
+// invite actually uses.
+type Event struct {
+	UID         string
+	Sequence    int    // RFC 5545 SEQUENCE - a later value for the same UID is a reschedule
+	Method      string // the enclosing VCALENDAR's METHOD, e.g. "REQUEST" or "CANCEL"
+	Summary     string
+	Location    string
+	Description string
+	Organizer   string   // email address, mailto: prefix stripped
+	Attendees   []string // email addresses, mailto: prefix stripped
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	// Floating is true when DTSTART/DTEND carried no "Z" suffix or TZID
+	// param (RFC 5545 "floating" local time). Start/End's wall-clock
+	// fields (Year/Month/.../Second) are exactly the invite's values in
+	// this case, stored in time.UTC only as a placeholder zone - a caller
+	// that cares about the actual instant should reinterpret them in
+	// whatever zone it considers "local".
+	Floating bool
+}
+
+// videoURLPattern matches a Zoom/Meet/Teams join link, the common places a
+// recruiter's calendar invite puts the interview's video call URL.
+var videoURLPattern = regexp.MustCompile(`https?://(?:[\w-]+\.)?(?:zoom\.us/j/\S+|meet\.google\.com/\S+|teams\.microsoft\.com/\S+)`)
+
+// VideoURL extracts a video call join link from an event's location and/or
+// description, or "" if neither carries one. Recruiters put it in either
+// field depending on how their calendar tool generated the invite.
+func VideoURL(location, description string) string {
+	if m := videoURLPattern.FindString(location); m != "" {
+		return strings.TrimRight(m, ").,>")
+	}
+	if m := videoURLPattern.FindString(description); m != "" {
+		return strings.TrimRight(m, ").,>")
+	}
+	return ""
+}
+
+// Parse reads every VEVENT in an iCalendar document (as attached to an
+// email's text/calendar part) and returns one Event per VEVENT. A VEVENT
+// with an RRULE is expanded to just its next occurrence at or after now,
+// per this package's "only expand the next occurrence" scope - a full
+// recurrence series isn't needed to detect or schedule a single interview.
+func Parse(data []byte, now time.Time) ([]Event, error) {
+	lines := unfoldLines(data)
+
+	var events []Event
+	var method string
+	var cur *rawEvent
+
+	for _, line := range lines {
+		name, params, value := splitProperty(line)
+		switch strings.ToUpper(name) {
+		case "METHOD":
+			method = strings.ToUpper(value)
+		case "BEGIN":
+			if strings.ToUpper(value) == "VEVENT" {
+				cur = &rawEvent{}
+			}
+		case "END":
+			if strings.ToUpper(value) == "VEVENT" && cur != nil {
+				ev, err := cur.toEvent(method, now)
+				if err != nil {
+					return nil, fmt.Errorf("invalid VEVENT %q: %w", cur.uid, err)
+				}
+				events = append(events, *ev)
+				cur = nil
+			}
+		default:
+			if cur != nil {
+				cur.setProperty(strings.ToUpper(name), params, value)
+			}
+		}
+	}
+
+	return events, nil
+}
+
+// rawEvent accumulates a VEVENT's properties as they're seen, so Start/End
+// (which need both the value and its VALUE=/TZID= params together) can be
+// resolved once the block is complete.
+type rawEvent struct {
+	uid              string
+	sequence         int
+	summary          string
+	location         string
+	description      string
+	organizer        string
+	attendees        []string
+	dtstart, dtend   string
+	dtstartP, dtendP map[string]string
+	rrule            string
+}
+
+func (r *rawEvent) setProperty(name string, params map[string]string, value string) {
+	switch name {
+	case "UID":
+		r.uid = value
+	case "SEQUENCE":
+		if n, err := strconv.Atoi(value); err == nil {
+			r.sequence = n
+		}
+	case "SUMMARY":
+		r.summary = unescapeText(value)
+	case "LOCATION":
+		r.location = unescapeText(value)
+	case "DESCRIPTION":
+		r.description = unescapeText(value)
+	case "ORGANIZER":
+		r.organizer = strings.TrimPrefix(strings.ToLower(value), "mailto:")
+	case "ATTENDEE":
+		r.attendees = append(r.attendees, strings.TrimPrefix(strings.ToLower(value), "mailto:"))
+	case "DTSTART":
+		r.dtstart, r.dtstartP = value, params
+	case "DTEND":
+		r.dtend, r.dtendP = value, params
+	case "RRULE":
+		r.rrule = value
+	}
+}
+
+func (r *rawEvent) toEvent(method string, now time.Time) (*Event, error) {
+	start, allDay, floating, err := parseDateTime(r.dtstart, r.dtstartP)
+	if err != nil {
+		return nil, fmt.Errorf("DTSTART: %w", err)
+	}
+	var end time.Time
+	if r.dtend != "" {
+		end, _, _, err = parseDateTime(r.dtend, r.dtendP)
+		if err != nil {
+			return nil, fmt.Errorf("DTEND: %w", err)
+		}
+	} else {
+		end = start
+	}
+
+	if r.rrule != "" {
+		start, end = nextOccurrence(start, end, r.rrule, now)
+	}
+
+	return &Event{
+		UID:         r.uid,
+		Sequence:    r.sequence,
+		Method:      method,
+		Summary:     r.summary,
+		Location:    r.location,
+		Description: r.description,
+		Organizer:   r.organizer,
+		Attendees:   r.attendees,
+		Start:       start,
+		End:         end,
+		AllDay:      allDay,
+		Floating:    floating,
+	}, nil
+}
+
+// parseDateTime parses a DTSTART/DTEND value using its params to tell an
+// all-day date, a UTC instant, a zoned instant, and a floating local time
+// apart - the four forms RFC 5545 allows.
+func parseDateTime(value string, params map[string]string) (t time.Time, allDay bool, floating bool, err error) {
+	if params["VALUE"] == "DATE" {
+		t, err = time.Parse("20060102", value)
+		return t, true, false, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err = time.Parse("20060102T150405Z", value)
+		return t, false, false, err
+	}
+	if tzid := params["TZID"]; tzid != "" {
+		loc, locErr := time.LoadLocation(tzid)
+		if locErr != nil {
+			loc = time.UTC
+		}
+		t, err = time.ParseInLocation("20060102T150405", value, loc)
+		return t, false, false, err
+	}
+	t, err = time.ParseInLocation("20060102T150405", value, time.UTC)
+	return t, false, true, err
+}
+
+// nextOccurrence steps an RRULE's FREQ/INTERVAL forward from start until it
+// reaches an occurrence at or after now, returning that occurrence's
+// start/end (end keeps the original start-to-end duration). Only
+// DAILY/WEEKLY/MONTHLY/YEARLY are understood; an unrecognized FREQ, or one
+// that can't reach now within a bounded number of steps (a malformed or
+// effectively-ended rule), returns the series' first occurrence unchanged.
+func nextOccurrence(start, end time.Time, rrule string, now time.Time) (time.Time, time.Time) {
+	duration := end.Sub(start)
+
+	freq := ""
+	interval := 1
+	for _, part := range strings.Split(rrule, ";") {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			freq = strings.ToUpper(v)
+		case "INTERVAL":
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				interval = n
+			}
+		}
+	}
+
+	var step func(time.Time) time.Time
+	switch freq {
+	case "DAILY":
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, interval) }
+	case "WEEKLY":
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, 7*interval) }
+	case "MONTHLY":
+		step = func(t time.Time) time.Time { return t.AddDate(0, interval, 0) }
+	case "YEARLY":
+		step = func(t time.Time) time.Time { return t.AddDate(interval, 0, 0) }
+	default:
+		return start, end
+	}
+
+	const maxSteps = 10000
+	occurrence := start
+	for i := 0; occurrence.Before(now) && i < maxSteps; i++ {
+		occurrence = step(occurrence)
+	}
+	return occurrence, occurrence.Add(duration)
+}
+
+// unfoldLines joins RFC 5545's folded continuation lines (a line starting
+// with a space or tab continues the previous one) back into single logical
+// lines, and drops blank lines.
+func unfoldLines(data []byte) []string {
+	raw := strings.ReplaceAll(string(data), "\r\n", "\n")
+	var lines []string
+	for _, l := range strings.Split(raw, "\n") {
+		// RFC 5545 folding inserts the CRLF + single-space/tab marker at an
+		// arbitrary byte offset, not necessarily a word boundary - producers
+		// commonly fold right at an existing space rather than doubling it.
+		// Since there's no way to tell which case produced a given line,
+		// keep the leading whitespace as real content instead of stripping
+		// it: an occasional doubled space reads fine, a swallowed
+		// word-boundary space does not.
+		if len(l) > 0 && (l[0] == ' ' || l[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += l
+			continue
+		}
+		if strings.TrimSpace(l) != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+// splitProperty splits one unfolded content line into its name,
+// semicolon-separated parameters, and value, e.g.
+// "DTSTART;TZID=America/New_York:20250601T140000" becomes ("DTSTART",
+// {"TZID": "America/New_York"}, "20250601T140000").
+func splitProperty(line string) (name string, params map[string]string, value string) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return line, nil, ""
+	}
+
+	parts := strings.Split(head, ";")
+	name = parts[0]
+	if len(parts) > 1 {
+		params = make(map[string]string, len(parts)-1)
+		for _, p := range parts[1:] {
+			k, v, ok := strings.Cut(p, "=")
+			if ok {
+				params[strings.ToUpper(k)] = v
+			}
+		}
+	}
+	return name, params, value
+}
+
+// unescapeText reverses RFC 5545 TEXT escaping (\\, \;, \,, \n).
+func unescapeText(s string) string {
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			switch s[i+1] {
+			case 'n', 'N':
+				out.WriteByte('\n')
+			case '\\', ';', ',':
+				out.WriteByte(s[i+1])
+			default:
+				out.WriteByte(s[i+1])
+			}
+			i++
+			continue
+		}
+		out.WriteByte(s[i])
+	}
+	return out.String()
+}
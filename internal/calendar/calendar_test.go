@@ -0,0 +1,174 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse_BasicRequest(t *testing.T) {
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"METHOD:REQUEST\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:interview-1@acme.com\r\n" +
+		"SEQUENCE:0\r\n" +
+		"SUMMARY:Phone Screen with Acme\r\n" +
+		"LOCATION:https://zoom.us/j/123456789\r\n" +
+		"ORGANIZER:mailto:recruiter@acme.com\r\n" +
+		"ATTENDEE:mailto:candidate@example.com\r\n" +
+		"DTSTART:20260801T140000Z\r\n" +
+		"DTEND:20260801T143000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse([]byte(ics), time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+
+	ev := events[0]
+	if ev.UID != "interview-1@acme.com" {
+		t.Errorf("UID = %q", ev.UID)
+	}
+	if ev.Method != "REQUEST" {
+		t.Errorf("Method = %q", ev.Method)
+	}
+	if ev.Summary != "Phone Screen with Acme" {
+		t.Errorf("Summary = %q", ev.Summary)
+	}
+	if ev.Organizer != "recruiter@acme.com" {
+		t.Errorf("Organizer = %q", ev.Organizer)
+	}
+	if ev.Floating {
+		t.Error("expected UTC DTSTART to not be floating")
+	}
+	if !ev.Start.Equal(time.Date(2026, 8, 1, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf("Start = %v", ev.Start)
+	}
+}
+
+func TestParse_FloatingAndAllDay(t *testing.T) {
+	floating := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:floating-1\r\n" +
+		"SUMMARY:Onsite\r\n" +
+		"DTSTART:20260801T090000\r\n" +
+		"DTEND:20260801T170000\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse([]byte(floating), time.Now())
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if !events[0].Floating {
+		t.Error("expected zoneless DTSTART to be floating")
+	}
+
+	allDay := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:allday-1\r\n" +
+		"SUMMARY:Offer deadline\r\n" +
+		"DTSTART;VALUE=DATE:20260801\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err = Parse([]byte(allDay), time.Now())
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if !events[0].AllDay {
+		t.Error("expected VALUE=DATE DTSTART to be AllDay")
+	}
+}
+
+func TestParse_CancelMethod(t *testing.T) {
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"METHOD:CANCEL\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:interview-1@acme.com\r\n" +
+		"SEQUENCE:1\r\n" +
+		"SUMMARY:Phone Screen with Acme\r\n" +
+		"DTSTART:20260801T140000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse([]byte(ics), time.Now())
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if events[0].Method != "CANCEL" {
+		t.Errorf("Method = %q", events[0].Method)
+	}
+	if events[0].Sequence != 1 {
+		t.Errorf("Sequence = %d", events[0].Sequence)
+	}
+}
+
+func TestParse_FoldedLineAndEscapedText(t *testing.T) {
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:folded-1\r\n" +
+		"SUMMARY:Onsite interview\\, final round\r\n" +
+		"DESCRIPTION:Bring a photo ID\\nArrive 10 minutes early\r\n" +
+		" and check in at the front desk.\r\n" +
+		"DTSTART:20260801T140000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse([]byte(ics), time.Now())
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if events[0].Summary != "Onsite interview, final round" {
+		t.Errorf("Summary = %q", events[0].Summary)
+	}
+	want := "Bring a photo ID\nArrive 10 minutes early and check in at the front desk."
+	if events[0].Description != want {
+		t.Errorf("Description = %q, want %q", events[0].Description, want)
+	}
+}
+
+func TestVideoURL(t *testing.T) {
+	cases := []struct {
+		name, location, description, want string
+	}{
+		{"zoom in location", "https://zoom.us/j/123456789?pwd=abc", "", "https://zoom.us/j/123456789?pwd=abc"},
+		{"meet in description", "Conference Room A", "Join: https://meet.google.com/abc-defg-hij", "https://meet.google.com/abc-defg-hij"},
+		{"none", "Conference Room A", "No video link here.", ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := VideoURL(c.location, c.description); got != c.want {
+				t.Errorf("VideoURL() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestNextOccurrence_WeeklyAdvancesToNow(t *testing.T) {
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:recurring-1\r\n" +
+		"SUMMARY:Weekly sync\r\n" +
+		"DTSTART:20260601T140000Z\r\n" +
+		"DTEND:20260601T150000Z\r\n" +
+		"RRULE:FREQ=WEEKLY;INTERVAL=1\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	now := time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC)
+	events, err := Parse([]byte(ics), now)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	ev := events[0]
+	if ev.Start.Before(now) {
+		t.Errorf("expected occurrence at or after now, got %v", ev.Start)
+	}
+	if ev.End.Sub(ev.Start) != time.Hour {
+		t.Errorf("expected 1h duration preserved, got %v", ev.End.Sub(ev.Start))
+	}
+}
@@ -0,0 +1,128 @@
+package classifier
+
+import (
+	"context"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// Result is the normalized outcome of a classification, independent of
+// which backend produced it.
+type Result struct {
+	IsJobRelated bool
+	Confidence   float64
+	Label        string
+	Company      *string
+	Position     *string
+}
+
+// Backend scores a single email for job-relatedness.
+type Backend interface {
+	Classify(ctx context.Context, e *email.Email) (Result, error)
+}
+
+// LLMBackend adapts the HTTP classification service to the Backend interface.
+type LLMBackend struct {
+	client   *Client
+	primary  string
+	fallback string
+}
+
+// NewLLMBackend creates a Backend backed by the LLM classification service
+func NewLLMBackend(client *Client, primary, fallback string) *LLMBackend {
+	return &LLMBackend{client: client, primary: primary, fallback: fallback}
+}
+
+// Classify sends the email to the classification service
+func (b *LLMBackend) Classify(ctx context.Context, e *email.Email) (Result, error) {
+	req := ClassifyRequest{
+		EmailSubject: e.Subject,
+		EmailBody:    e.Body,
+		EmailFrom:    e.From.Email,
+	}
+
+	resp, err := b.client.ClassifyWithFallback(ctx, req, b.primary, b.fallback)
+	if err != nil {
+		return Result{}, err
+	}
+
+	label := "junk"
+	if resp.IsJobRelated {
+		label = "good"
+	}
+
+	return Result{
+		IsJobRelated: resp.IsJobRelated,
+		Confidence:   resp.Confidence,
+		Label:        label,
+		Company:      resp.Company,
+		Position:     resp.Position,
+	}, nil
+}
+
+// BayesScorer is the subset of tracker.BayesClassifier's behavior BayesBackend
+// needs. It's declared here, rather than depending on the tracker package
+// directly, because tracker already depends on classifier (for the LLM
+// client) - any concrete *tracker.BayesClassifier satisfies this interface
+// as-is.
+type BayesScorer interface {
+	Classify(ctx context.Context, e *email.Email) (score float64, label string, err error)
+}
+
+// BayesBackend adapts a trained Bayesian classifier to the Backend interface.
+type BayesBackend struct {
+	scorer BayesScorer
+}
+
+// NewBayesBackend creates a Backend backed by a trained Bayesian classifier
+func NewBayesBackend(scorer BayesScorer) *BayesBackend {
+	return &BayesBackend{scorer: scorer}
+}
+
+// Classify scores the email against the trained Bayesian model
+func (b *BayesBackend) Classify(ctx context.Context, e *email.Email) (Result, error) {
+	score, label, err := b.scorer.Classify(ctx, e)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		IsJobRelated: label == "good",
+		Confidence:   score,
+		Label:        label,
+	}, nil
+}
+
+// Chain runs the Bayesian backend as a cheap pre-filter, only escalating to
+// the LLM backend for emails it isn't confident about either way.
+type Chain struct {
+	bayes      Backend
+	llm        Backend
+	lowCutoff  float64
+	highCutoff float64
+}
+
+// NewChain creates a Chain. Scores below lowCutoff are dropped as junk and
+// scores above highCutoff are accepted, both without consulting llm;
+// everything in between is escalated to it.
+func NewChain(bayes, llm Backend, lowCutoff, highCutoff float64) *Chain {
+	return &Chain{bayes: bayes, llm: llm, lowCutoff: lowCutoff, highCutoff: highCutoff}
+}
+
+// Classify runs the Bayesian pre-filter, escalating to the LLM backend when
+// the score falls between lowCutoff and highCutoff.
+func (c *Chain) Classify(ctx context.Context, e *email.Email) (Result, error) {
+	bayesResult, err := c.bayes.Classify(ctx, e)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if bayesResult.Confidence < c.lowCutoff {
+		return Result{IsJobRelated: false, Confidence: bayesResult.Confidence, Label: "junk"}, nil
+	}
+	if bayesResult.Confidence > c.highCutoff {
+		return Result{IsJobRelated: true, Confidence: bayesResult.Confidence, Label: "good"}, nil
+	}
+
+	return c.llm.Classify(ctx, e)
+}
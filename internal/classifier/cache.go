@@ -0,0 +1,96 @@
+package classifier
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// cacheExpiry is how long cached classifications are valid
+const cacheExpiry = 24 * time.Hour
+
+// ClassificationCache stores classification results keyed by Client's
+// cacheKey, so Classify can skip re-calling the LLM for an email it's
+// already classified. newMemoryCache (the default) is lost on restart;
+// NewDBCache persists across them.
+type ClassificationCache interface {
+	Get(ctx context.Context, key string) (*ClassifyResponse, bool)
+	Set(ctx context.Context, key string, response *ClassifyResponse)
+	Len(ctx context.Context) int
+	Clear(ctx context.Context) error
+	Prune(ctx context.Context, olderThan time.Duration) (int, error)
+}
+
+// cacheEntry holds a cached classification result
+type cacheEntry struct {
+	response  *ClassifyResponse
+	timestamp time.Time
+}
+
+// memoryCache is the process-local ClassificationCache Client uses by
+// default - fast, but empty again after every restart.
+type memoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{entries: make(map[string]cacheEntry)}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string) (*ClassifyResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, exists := c.entries[key]
+	if !exists || time.Since(entry.timestamp) > cacheExpiry {
+		return nil, false
+	}
+	return entry.response, true
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, response *ClassifyResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{response: response, timestamp: time.Now()}
+
+	// Prune expired entries if the cache is getting large, so a
+	// long-running daemon (jobsearch serve) doesn't grow it unbounded.
+	if len(c.entries) > 1000 {
+		now := time.Now()
+		for k, v := range c.entries {
+			if now.Sub(v.timestamp) > cacheExpiry {
+				delete(c.entries, k)
+			}
+		}
+	}
+}
+
+func (c *memoryCache) Len(ctx context.Context) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.entries)
+}
+
+func (c *memoryCache) Clear(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]cacheEntry)
+	return nil
+}
+
+func (c *memoryCache) Prune(ctx context.Context, olderThan time.Duration) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cutoff := time.Now().Add(-olderThan)
+	var pruned int
+	for k, v := range c.entries {
+		if v.timestamp.Before(cutoff) {
+			delete(c.entries, k)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
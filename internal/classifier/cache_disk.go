@@ -0,0 +1,60 @@
+package classifier
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// dbCache is a ClassificationCache backed by the main SQLite database's
+// classification_cache table, so cached classifications survive a process
+// restart instead of vanishing with memoryCache.
+type dbCache struct {
+	db *database.DB
+}
+
+// NewDBCache returns a ClassificationCache persisted to db, for
+// Client.SetCache.
+func NewDBCache(db *database.DB) ClassificationCache {
+	return &dbCache{db: db}
+}
+
+func (c *dbCache) Get(ctx context.Context, key string) (*ClassifyResponse, bool) {
+	raw, createdAt, found, err := c.db.GetClassificationCacheEntry(ctx, key)
+	if err != nil || !found || time.Since(createdAt) > cacheExpiry {
+		return nil, false
+	}
+
+	var response ClassifyResponse
+	if err := json.Unmarshal([]byte(raw), &response); err != nil {
+		return nil, false
+	}
+	return &response, true
+}
+
+func (c *dbCache) Set(ctx context.Context, key string, response *ClassifyResponse) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return
+	}
+	_ = c.db.SetClassificationCacheEntry(ctx, key, string(data))
+}
+
+func (c *dbCache) Len(ctx context.Context) int {
+	n, err := c.db.CountClassificationCacheEntries(ctx)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func (c *dbCache) Clear(ctx context.Context) error {
+	return c.db.ClearClassificationCache(ctx)
+}
+
+func (c *dbCache) Prune(ctx context.Context, olderThan time.Duration) (int, error) {
+	n, err := c.db.PruneClassificationCache(ctx, time.Now().Add(-olderThan))
+	return int(n), err
+}
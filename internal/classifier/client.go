@@ -20,23 +20,16 @@ type ProgressCallback func(current, total int)
 // concurrentClassifications is the number of parallel LLM classification calls
 const concurrentClassifications = 5
 
-// cacheExpiry is how long cached classifications are valid
-const cacheExpiry = 24 * time.Hour
-
-// cacheEntry holds a cached classification result
-type cacheEntry struct {
-	response  *ClassifyResponse
-	timestamp time.Time
-}
-
 // Client is an HTTP client for the Python classification service
 type Client struct {
 	baseURL       string
 	httpClient    *http.Client
-	cache         map[string]cacheEntry
-	cacheMu       sync.RWMutex
+	cache         ClassificationCache
 	cacheEnabled  bool
 	minConfidence float64 // Minimum confidence threshold
+
+	cacheHits   int64 // Atomic counters surfaced via ProgressCallback-adjacent CacheStats
+	cacheMisses int64
 }
 
 // ClassifyRequest is the request body for classification
@@ -66,23 +59,29 @@ type HealthResponse struct {
 	OpenAIAvailable bool   `json:"openai_available"`
 }
 
-// New creates a new classifier client
+// New creates a new classifier client, defaulting to an in-memory
+// ClassificationCache - call SetCache with NewDBCache to persist across
+// restarts.
 func New(baseURL string) *Client {
 	return &Client{
 		baseURL: baseURL,
 		httpClient: &http.Client{
 			Timeout: 120 * time.Second, // Long timeout for LLM inference
 		},
-		cache:         make(map[string]cacheEntry),
+		cache:         newMemoryCache(),
 		cacheEnabled:  true,
 		minConfidence: 0.5, // Default minimum confidence
 	}
 }
 
+// SetCache replaces the classification cache backend, e.g. with
+// NewDBCache(db) so classifications survive process restarts.
+func (c *Client) SetCache(cache ClassificationCache) {
+	c.cache = cache
+}
+
 // SetCacheEnabled enables or disables classification caching
 func (c *Client) SetCacheEnabled(enabled bool) {
-	c.cacheMu.Lock()
-	defer c.cacheMu.Unlock()
 	c.cacheEnabled = enabled
 }
 
@@ -93,80 +92,73 @@ func (c *Client) SetMinConfidence(threshold float64) {
 
 // CacheStats returns cache statistics
 type CacheStats struct {
-	Entries int `json:"entries"`
-	Enabled bool `json:"enabled"`
+	Entries int   `json:"entries"`
+	Enabled bool  `json:"enabled"`
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
 }
 
 // GetCacheStats returns current cache statistics
-func (c *Client) GetCacheStats() CacheStats {
-	c.cacheMu.RLock()
-	defer c.cacheMu.RUnlock()
+func (c *Client) GetCacheStats(ctx context.Context) CacheStats {
 	return CacheStats{
-		Entries: len(c.cache),
+		Entries: c.cache.Len(ctx),
 		Enabled: c.cacheEnabled,
+		Hits:    atomic.LoadInt64(&c.cacheHits),
+		Misses:  atomic.LoadInt64(&c.cacheMisses),
 	}
 }
 
 // ClearCache clears the classification cache
-func (c *Client) ClearCache() {
-	c.cacheMu.Lock()
-	defer c.cacheMu.Unlock()
-	c.cache = make(map[string]cacheEntry)
+func (c *Client) ClearCache(ctx context.Context) error {
+	return c.cache.Clear(ctx)
 }
 
-// cacheKey generates a cache key for a classification request
+// PruneCache removes entries older than cacheExpiry, returning how many
+// were removed. Client already prunes the in-memory cache opportunistically
+// on write (see memoryCache.Set); this is for an explicit sweep, e.g.
+// "jobsearch cache prune" or a background ticker against a disk-backed
+// cache that Set never touches for unrelated keys.
+func (c *Client) PruneCache(ctx context.Context) (int, error) {
+	return c.cache.Prune(ctx, cacheExpiry)
+}
+
+// cacheKey generates a cache key for a classification request, hashing
+// subject, from, a digest of body (bodies can be long), and the
+// provider/model so a reclassification with a different model - or a
+// different body from the same sender/subject - misses instead of
+// colliding with a stale entry.
 func (c *Client) cacheKey(req ClassifyRequest) string {
-	// Create a hash of subject + from (body can be long, so we use just subject+from)
-	data := fmt.Sprintf("%s|%s", req.EmailSubject, req.EmailFrom)
+	bodyHash := sha256.Sum256([]byte(req.EmailBody))
+	model := ""
+	if req.Model != nil {
+		model = *req.Model
+	}
+	data := fmt.Sprintf("%s|%s|%s|%s|%s", req.EmailSubject, req.EmailFrom, hex.EncodeToString(bodyHash[:]), req.Provider, model)
 	hash := sha256.Sum256([]byte(data))
 	return hex.EncodeToString(hash[:])
 }
 
 // getCached retrieves a cached classification if available
-func (c *Client) getCached(key string) (*ClassifyResponse, bool) {
-	c.cacheMu.RLock()
-	defer c.cacheMu.RUnlock()
-
+func (c *Client) getCached(ctx context.Context, key string) (*ClassifyResponse, bool) {
 	if !c.cacheEnabled {
 		return nil, false
 	}
 
-	entry, exists := c.cache[key]
-	if !exists {
-		return nil, false
+	response, found := c.cache.Get(ctx, key)
+	if found {
+		atomic.AddInt64(&c.cacheHits, 1)
+	} else {
+		atomic.AddInt64(&c.cacheMisses, 1)
 	}
-
-	// Check expiry
-	if time.Since(entry.timestamp) > cacheExpiry {
-		return nil, false
-	}
-
-	return entry.response, true
+	return response, found
 }
 
 // setCache stores a classification result in the cache
-func (c *Client) setCache(key string, response *ClassifyResponse) {
-	c.cacheMu.Lock()
-	defer c.cacheMu.Unlock()
-
+func (c *Client) setCache(ctx context.Context, key string, response *ClassifyResponse) {
 	if !c.cacheEnabled {
 		return
 	}
-
-	c.cache[key] = cacheEntry{
-		response:  response,
-		timestamp: time.Now(),
-	}
-
-	// Prune expired entries if cache is getting large
-	if len(c.cache) > 1000 {
-		now := time.Now()
-		for k, v := range c.cache {
-			if now.Sub(v.timestamp) > cacheExpiry {
-				delete(c.cache, k)
-			}
-		}
-	}
+	c.cache.Set(ctx, key, response)
 }
 
 // Health checks if the classification service is running
@@ -220,7 +212,7 @@ func (c *Client) EnsureRunning(ctx context.Context) error {
 func (c *Client) Classify(ctx context.Context, req ClassifyRequest) (*ClassifyResponse, error) {
 	// Check cache first
 	cacheKey := c.cacheKey(req)
-	if cached, found := c.getCached(cacheKey); found {
+	if cached, found := c.getCached(ctx, cacheKey); found {
 		return cached, nil
 	}
 
@@ -257,7 +249,7 @@ func (c *Client) Classify(ctx context.Context, req ClassifyRequest) (*ClassifyRe
 	}
 
 	// Cache the result
-	c.setCache(cacheKey, &result)
+	c.setCache(ctx, cacheKey, &result)
 
 	return &result, nil
 }
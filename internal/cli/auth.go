@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Store credentials for a non-Gmail email provider",
+}
+
+var authImapCmd = &cobra.Command{
+	Use:   "imap",
+	Short: "Save the IMAP account password to the configured password file",
+	Long: `Save the IMAP account password (or an app-specific password) to
+cfg.IMAP.PasswordPath, the file imap.Provider reads credentials from.
+
+This mirrors how Gmail and JMAP store their own credentials as a plain
+file rather than an OS keychain - there's no keychain integration in this
+tool yet.`,
+	RunE: runAuthIMAP,
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authImapCmd)
+}
+
+func runAuthIMAP(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("IMAP password for %s: ", cfg.IMAP.Username)
+	reader := bufio.NewReader(os.Stdin)
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read password: %w", err)
+	}
+	password := strings.TrimSpace(input)
+	if password == "" {
+		return fmt.Errorf("password cannot be empty")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.IMAP.PasswordPath), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(cfg.IMAP.PasswordPath, []byte(password), 0o600); err != nil {
+		return fmt.Errorf("failed to save password: %w", err)
+	}
+
+	fmt.Printf("Saved IMAP password to %s\n", cfg.IMAP.PasswordPath)
+	return nil
+}
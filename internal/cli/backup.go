@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/backup"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Export and import a portable snapshot of your jobsearch data",
+}
+
+var backupExportCmd = &cobra.Command{
+	Use:   "export <output.tar.gz>",
+	Short: "Export the database, config, and a manifest into a single archive",
+	Long: `Export a portable snapshot of your jobsearch installation: the SQLite
+database, a redacted copy of the TOML config (notifier secrets stripped),
+and a manifest with the schema version, a SHA-256 of the database file,
+and row counts per table.
+
+Examples:
+  jobsearch backup export snapshot.tar.gz
+  jobsearch backup export ~/backups/jobsearch-$(date +%F).tar.gz`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBackupExport,
+}
+
+var backupImportCmd = &cobra.Command{
+	Use:   "import <archive.tar.gz>",
+	Short: "Restore the database from an export archive",
+	Long: `Import a backup archive created by 'jobsearch backup export', replacing
+the current database.
+
+The archive is extracted to a temp file, its manifest's schema version is
+checked (newer-than-this-binary archives are refused), migrations are run
+forward if the archive predates the current schema, and its integrity is
+verified with PRAGMA integrity_check before it's swapped in atomically.
+The previous database is kept at <path>.bak rather than deleted.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBackupImport,
+}
+
+func init() {
+	backupCmd.AddCommand(backupExportCmd)
+	backupCmd.AddCommand(backupImportCmd)
+	rootCmd.AddCommand(backupCmd)
+}
+
+func runBackupExport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	outputPath := args[0]
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer f.Close()
+
+	manifest, err := backup.Export(ctx, db, cfg, cfg.Database.Path, f)
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+
+	fmt.Printf("Exported to %s\n", outputPath)
+	fmt.Printf("  schema version: %d\n", manifest.SchemaVersion)
+	fmt.Printf("  database sha256: %s\n", manifest.DBChecksum)
+	for table, count := range manifest.RowCounts {
+		fmt.Printf("  %s: %d row(s)\n", table, count)
+	}
+
+	return nil
+}
+
+func runBackupImport(cmd *cobra.Command, args []string) error {
+	archivePath := args[0]
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := backup.Import(archivePath, cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+
+	fmt.Printf("Imported %s into %s\n", archivePath, cfg.Database.Path)
+	fmt.Printf("  schema version: %d\n", manifest.SchemaVersion)
+	fmt.Printf("  previous database kept at %s.bak\n", cfg.Database.Path)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+)
+
+var bayesCmd = &cobra.Command{
+	Use:   "bayes",
+	Short: "Inspect and manage the local Bayesian spam classifier",
+}
+
+var bayesStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show how much the Bayes classifier has been trained",
+	RunE:  runBayesStats,
+}
+
+var bayesResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Wipe all trained Bayes classifier state",
+	Long: `Delete every token count and class total the Bayes classifier has
+learned, returning it to an untrained state.
+
+This does not touch the LLM classifier, learned filters, or the domain
+blacklist/whitelist - only the "mark-spam"/"unmark-spam"/"learn" trained
+token model.`,
+	RunE: runBayesReset,
+}
+
+func init() {
+	rootCmd.AddCommand(bayesCmd)
+	bayesCmd.AddCommand(bayesStatsCmd)
+	bayesCmd.AddCommand(bayesResetCmd)
+}
+
+func runBayesStats(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	stats, err := db.GetBayesStats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get bayes stats: %w", err)
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(stats)
+	}
+
+	fmt.Printf("Trained good messages: %d\n", stats.GoodMessages)
+	fmt.Printf("Trained junk messages: %d\n", stats.JunkMessages)
+	fmt.Printf("Vocabulary size:       %d\n", stats.VocabSize)
+	return nil
+}
+
+func runBayesReset(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.ResetBayesClassifier(ctx); err != nil {
+		return fmt.Errorf("failed to reset bayes classifier: %w", err)
+	}
+
+	fmt.Println("Bayes classifier reset. It will relearn from future mark-spam/unmark-spam/learn/sync feedback.")
+	return nil
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+)
+
+var bulkDryRun bool
+
+var bulkCmd = &cobra.Command{
+	Use:   "bulk <archive|unarchive>",
+	Short: "Apply archive or unarchive to every conversation matching a filter",
+	Long: `Run archive or unarchive across every conversation matching the given
+filters, in a single transaction. This is the same matching logic behind
+'archive'/'unarchive' run without an identifier, exposed as its own command
+for clarity when scripting.
+
+Examples:
+  jobsearch bulk archive --from="@recruiter.com" --older-than=30d --dry-run
+  jobsearch bulk unarchive --company="Stripe*"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBulk,
+}
+
+func init() {
+	addSearchCriteriaFlags(bulkCmd)
+	bulkCmd.Flags().BoolVar(&bulkDryRun, "dry-run", false, "Show matching conversations without applying the action")
+	rootCmd.AddCommand(bulkCmd)
+}
+
+func runBulk(cmd *cobra.Command, args []string) error {
+	action := args[0]
+	if action != "archive" && action != "unarchive" {
+		return fmt.Errorf("unknown bulk action: %s (use archive or unarchive)", action)
+	}
+
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	crit, err := parseSearchCriteria(cmd)
+	if err != nil {
+		return err
+	}
+	if action == "unarchive" {
+		crit.ArchivedOnly = true
+	}
+
+	return applyBulkAction(ctx, db, action, crit, bulkDryRun)
+}
+
+// applyBulkAction enumerates conversations matching crit and archives or
+// unarchives all of them in a single transaction, unless dryRun is set.
+func applyBulkAction(ctx context.Context, db *database.DB, action string, crit database.SearchCriteria, dryRun bool) error {
+	convs, err := db.Query(ctx, crit)
+	if err != nil {
+		return fmt.Errorf("failed to query conversations: %w", err)
+	}
+
+	if len(convs) == 0 {
+		fmt.Println("No conversations matched.")
+		return nil
+	}
+
+	if dryRun {
+		if outputFmt == "json" {
+			return output.JSON(convs)
+		}
+		fmt.Printf("Would %s %d conversation(s):\n", action, len(convs))
+		for _, c := range convs {
+			fmt.Printf("  %s (%s)\n", c.Company, c.ID)
+		}
+		return nil
+	}
+
+	ids := make([]string, len(convs))
+	for i, c := range convs {
+		ids[i] = c.ID
+	}
+
+	verb := "Archived"
+	if action == "unarchive" {
+		verb = "Unarchived"
+		err = db.BulkUnarchive(ctx, ids)
+	} else {
+		err = db.BulkArchive(ctx, ids)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(convs)
+	}
+
+	fmt.Printf("%s %d conversation(s):\n", verb, len(convs))
+	for _, c := range convs {
+		fmt.Printf("  %s (%s)\n", c.Company, c.ID)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/classifier"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect and manage the on-disk classification cache",
+	Long: `The classification cache stores LLM classification results keyed by a
+hash of (subject, from, body, provider, model), so "jobsearch sync" doesn't
+re-pay LLM latency for an email it's already classified (see
+classifier.ClassificationCache). Use subcommands to:
+  - stats: show how many entries are cached
+  - clear: delete every cached entry
+  - prune: delete entries older than the cache's TTL`,
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show classification cache statistics",
+	RunE:  runCacheStats,
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Delete every cached classification",
+	RunE:  runCacheClear,
+}
+
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete cached classifications older than the cache TTL",
+	RunE:  runCachePrune,
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheStatsCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+	cacheCmd.AddCommand(cachePruneCmd)
+}
+
+func openCacheDB() (*database.DB, error) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, err
+	}
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	return db, nil
+}
+
+// dbBackedClassifier returns a classifier.Client wired to the on-disk cache
+// for db, so the cache subcommands reuse Client's GetCacheStats/ClearCache/
+// PruneCache instead of re-implementing TTL handling here. Its baseURL is
+// unused - these subcommands never call Classify.
+func dbBackedClassifier(db *database.DB) *classifier.Client {
+	c := classifier.New("")
+	c.SetCache(classifier.NewDBCache(db))
+	return c
+}
+
+func runCacheStats(cmd *cobra.Command, args []string) error {
+	db, err := openCacheDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	stats := dbBackedClassifier(db).GetCacheStats(cmd.Context())
+	fmt.Printf("Cached classifications: %d\n", stats.Entries)
+	return nil
+}
+
+func runCacheClear(cmd *cobra.Command, args []string) error {
+	db, err := openCacheDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := dbBackedClassifier(db).ClearCache(cmd.Context()); err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	fmt.Println("Classification cache cleared.")
+	return nil
+}
+
+func runCachePrune(cmd *cobra.Command, args []string) error {
+	db, err := openCacheDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	n, err := dbBackedClassifier(db).PruneCache(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to prune cache: %w", err)
+	}
+	fmt.Printf("Pruned %d expired cache entries.\n", n)
+	return nil
+}
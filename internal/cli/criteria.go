@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// addSearchCriteriaFlags registers the filter flags shared by list, archive,
+// unarchive, and bulk, so they stay in sync across commands.
+//
+// A few aerc-style flags the "list" request asked for don't have a column to
+// push down to: there's no read/unread state anywhere in the schema (Gmail's
+// UNREAD label is never synced), email bodies are stored encrypted rather
+// than indexed (BodyEncrypted), and arbitrary headers beyond
+// Message-ID/In-Reply-To/References aren't kept. -r/--read, -u/--unread,
+// --body, and --header are left unimplemented rather than faked.
+func addSearchCriteriaFlags(cmd *cobra.Command) {
+	cmd.Flags().String("company", "", `Filter by company name (glob, e.g. "Stripe*")`)
+	cmd.Flags().String("position", "", `Filter by position title (glob, e.g. "*Engineer*")`)
+	cmd.Flags().String("from", "", `Filter by sender/recruiter email domain, e.g. "recruiter.com"`)
+	cmd.Flags().String("to", "", `Filter by recipient address (glob, e.g. "jobs+*@example.com")`)
+	cmd.Flags().String("recruiter", "", `Filter by recruiter name or email (glob, e.g. "jane*")`)
+	cmd.Flags().String("subject", "", `Filter by email subject (glob, e.g. "*interview*")`)
+	cmd.Flags().String("classification", "", `Filter by classification layer, e.g. "recruiter_outreach"`)
+	cmd.Flags().String("status", "", "Filter by status, comma-separated for multiple (waiting_on_me, waiting_on_them, stale, active, closed, bounced)")
+	cmd.Flags().String("since", "", "Only conversations active since (e.g., 7d, 2w, 1m)")
+	cmd.Flags().String("newer-than", "", "Alias for --since")
+	cmd.Flags().String("older-than", "", "Only conversations inactive since (e.g., 30d, 2w, 1m)")
+	cmd.Flags().Bool("has-recruiter", false, "Only conversations with a known recruiter email")
+	cmd.Flags().Bool("stale", false, "Only stale conversations")
+	cmd.Flags().Bool("review-suggested", false, "Only conversations flagged for manual review")
+	cmd.Flags().Bool("include-archived", false, "Include archived conversations")
+	cmd.Flags().String("search", "", "Full-text match against company, position, recruiter, and subject")
+	cmd.Flags().Int("min-emails", 0, "Only conversations with at least this many emails")
+	cmd.Flags().Int("max-emails", 0, "Only conversations with at most this many emails")
+	cmd.Flags().Int("limit", 0, "Maximum number of results")
+}
+
+// parseSearchCriteria builds a database.SearchCriteria from the flags
+// registered by addSearchCriteriaFlags. Commands that don't register those
+// flags simply get a zero-value criterion back for them.
+func parseSearchCriteria(cmd *cobra.Command) (database.SearchCriteria, error) {
+	var crit database.SearchCriteria
+
+	if v, _ := cmd.Flags().GetString("company"); v != "" {
+		crit.Company = &v
+	}
+	if v, _ := cmd.Flags().GetString("from"); v != "" {
+		crit.Domain = &v
+	}
+	if v, _ := cmd.Flags().GetString("recruiter"); v != "" {
+		crit.Recruiter = &v
+	}
+	if v, _ := cmd.Flags().GetString("position"); v != "" {
+		crit.Position = &v
+	}
+	if v, _ := cmd.Flags().GetString("to"); v != "" {
+		crit.To = &v
+	}
+	if v, _ := cmd.Flags().GetString("subject"); v != "" {
+		crit.Subject = &v
+	}
+	if v, _ := cmd.Flags().GetString("classification"); v != "" {
+		crit.Layer = &v
+	}
+	if v, _ := cmd.Flags().GetString("status"); v != "" {
+		if strings.Contains(v, ",") {
+			for _, s := range strings.Split(v, ",") {
+				crit.StatusIn = append(crit.StatusIn, database.ConversationStatus(strings.TrimSpace(s)))
+			}
+		} else {
+			status := database.ConversationStatus(v)
+			crit.Status = &status
+		}
+	}
+	since, _ := cmd.Flags().GetString("since")
+	if since == "" {
+		since, _ = cmd.Flags().GetString("newer-than")
+	}
+	if since != "" {
+		d, err := parseDuration(since)
+		if err != nil {
+			return crit, fmt.Errorf("invalid --since/--newer-than: %w", err)
+		}
+		t := time.Now().Add(-d)
+		crit.Since = &t
+	}
+	if v, _ := cmd.Flags().GetString("older-than"); v != "" {
+		d, err := parseDuration(v)
+		if err != nil {
+			return crit, fmt.Errorf("invalid --older-than: %w", err)
+		}
+		before := time.Now().Add(-d)
+		crit.Before = &before
+	}
+	if v, _ := cmd.Flags().GetBool("has-recruiter"); v {
+		crit.HasRecruiter = true
+	}
+	if v, _ := cmd.Flags().GetBool("stale"); v {
+		crit.StaleOnly = true
+	}
+	if v, _ := cmd.Flags().GetBool("review-suggested"); v {
+		crit.ReviewSuggested = &v
+	}
+	if v, _ := cmd.Flags().GetBool("include-archived"); v {
+		crit.IncludeArchived = true
+	}
+	if v, _ := cmd.Flags().GetString("search"); v != "" {
+		crit.FullText = &v
+	}
+	if v, _ := cmd.Flags().GetInt("min-emails"); v > 0 {
+		crit.MinEmailCount = &v
+	}
+	if v, _ := cmd.Flags().GetInt("max-emails"); v > 0 {
+		crit.MaxEmailCount = &v
+	}
+	if v, _ := cmd.Flags().GetInt("limit"); v > 0 {
+		crit.Limit = v
+	}
+
+	return crit, nil
+}
+
+// findConversation finds a single conversation by exact ID or company match,
+// falling back to a full-text match. It's a thin wrapper over
+// database.FindConversation that returns an *database.AmbiguousMatchError
+// (rather than silently picking a result) when the full-text fallback
+// matches more than one conversation.
+func findConversation(ctx context.Context, db *database.DB, identifier string) (*database.Conversation, error) {
+	return db.FindConversation(ctx, identifier, false)
+}
+
+// findConversationIncludingArchived is like findConversation but also
+// matches archived conversations.
+func findConversationIncludingArchived(ctx context.Context, db *database.DB, identifier string) (*database.Conversation, error) {
+	return db.FindConversation(ctx, identifier, true)
+}
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+)
+
+var dbNewVersionPattern = regexp.MustCompile(`^([0-9]+)[a-z]?_`)
+
+var (
+	dbMigrateTo     string
+	dbRollbackSteps int
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Manage the database schema",
+}
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply pending migrations",
+	RunE:  runDBMigrate,
+}
+
+var dbRollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Revert the most recently applied migrations",
+	RunE:  runDBRollback,
+}
+
+var dbStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show which migrations are applied and which are pending",
+	RunE:  runDBStatus,
+}
+
+var dbNewCmd = &cobra.Command{
+	Use:   "new <name>",
+	Short: "Scaffold a new paired .up.sql/.down.sql migration",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runDBNew,
+}
+
+func init() {
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(dbMigrateCmd)
+	dbCmd.AddCommand(dbRollbackCmd)
+	dbCmd.AddCommand(dbStatusCmd)
+	dbCmd.AddCommand(dbNewCmd)
+
+	dbMigrateCmd.Flags().StringVar(&dbMigrateTo, "to", "", "Migrate to this version instead of the latest")
+	dbRollbackCmd.Flags().IntVar(&dbRollbackSteps, "steps", 1, "Number of migrations to roll back")
+}
+
+func runDBMigrate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	// database.Open already migrated to latest; re-running here only
+	// matters when --to pins an earlier version than what Open applied.
+	if err := db.Migrate(ctx, dbMigrateTo); err != nil {
+		return fmt.Errorf("failed to migrate: %w", err)
+	}
+
+	fmt.Println("Migrations applied.")
+	return nil
+}
+
+func runDBRollback(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.Rollback(ctx, dbRollbackSteps); err != nil {
+		return fmt.Errorf("failed to roll back: %w", err)
+	}
+
+	fmt.Printf("Rolled back %d migration(s).\n", dbRollbackSteps)
+	return nil
+}
+
+func runDBStatus(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	statuses, err := db.MigrationStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read migration status: %w", err)
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(statuses)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tNAME\tAPPLIED\tAPPLIED AT")
+	fmt.Fprintln(tw, "-------\t----\t-------\t----------")
+	for _, s := range statuses {
+		appliedAt := ""
+		if s.AppliedAt != nil {
+			appliedAt = s.AppliedAt.Local().Format("Jan 02, 2006 15:04")
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%t\t%s\n", s.Version, s.Name, s.Applied, appliedAt)
+	}
+	return tw.Flush()
+}
+
+func runDBNew(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	migrationsDir := "internal/database/migrations"
+	entries, err := os.ReadDir(migrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	nextVersion := 1
+	for _, entry := range entries {
+		m := dbNewVersionPattern.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+		if n >= nextVersion {
+			nextVersion = n + 1
+		}
+	}
+
+	base := fmt.Sprintf("%03d_%s", nextVersion, name)
+	upPath := filepath.Join(migrationsDir, base+".up.sql")
+	downPath := filepath.Join(migrationsDir, base+".down.sql")
+
+	header := fmt.Sprintf("-- %s\n\n", time.Now().Format("2006-01-02"))
+	if err := os.WriteFile(upPath, []byte(header), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", upPath, err)
+	}
+	if err := os.WriteFile(downPath, []byte(header), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", downPath, err)
+	}
+
+	fmt.Printf("Created %s\n", upPath)
+	fmt.Printf("Created %s\n", downPath)
+	return nil
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/gmail"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/templates"
+)
+
+var (
+	draftDryRun bool
+	draftUser   string
+)
+
+var draftCmd = &cobra.Command{
+	Use:   "draft <company|id> <follow_up|nudge|decline|thank_you>",
+	Short: "Render an outbound reply template and send it (or preview it)",
+	Long: `Render one of the built-in reply templates - follow_up, nudge, decline,
+thank_you - against a conversation and the latest email in its thread, using
+templates.Render. The rendered reply is sent via the configured provider,
+threaded into the conversation, the same way 'show --reply' sends an
+editor-authored one.
+
+Examples:
+  jobsearch draft stripe follow_up --dry-run   # preview only, nothing sent
+  jobsearch draft stripe nudge --user-name "Jordan"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDraft,
+}
+
+func init() {
+	draftCmd.Flags().BoolVar(&draftDryRun, "dry-run", false, "Render to stdout instead of sending")
+	draftCmd.Flags().StringVar(&draftUser, "user-name", "", "Fills the {user_name} placeholder in the signature")
+	rootCmd.AddCommand(draftCmd)
+}
+
+func runDraft(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	identifier, templateName := args[0], args[1]
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	conv, err := db.GetConversationByCompany(ctx, identifier)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if conv == nil {
+		conv, err = db.GetConversation(ctx, identifier)
+		if err != nil {
+			return fmt.Errorf("database error: %w", err)
+		}
+	}
+	if conv == nil {
+		return fmt.Errorf("conversation not found: %s", identifier)
+	}
+
+	emails, err := db.ListEmailsForConversation(ctx, conv.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get emails: %w", err)
+	}
+	var latest *database.Email
+	if len(emails) > 0 {
+		latest = &emails[len(emails)-1]
+	}
+
+	if err := templates.EnsureDefaults(cfg.Templates); err != nil {
+		return fmt.Errorf("failed to materialize default templates: %w", err)
+	}
+
+	rendered, err := templates.Render(templates.Name(templateName), cfg.Templates, conv, latest, draftUser)
+	if err != nil {
+		return err
+	}
+
+	if draftDryRun {
+		fmt.Fprintf(os.Stdout, "Subject: %s\n\n%s\n", rendered.Subject, rendered.Text)
+		return nil
+	}
+
+	if latest == nil {
+		return fmt.Errorf("conversation has no emails to reply to")
+	}
+
+	provider, err := newEmailProvider(cfg)
+	if err != nil {
+		return err
+	}
+	if gmailProvider, ok := provider.(*gmail.Provider); ok {
+		gmailProvider.SetAuthMode(cfg.Gmail.AuthMode)
+	}
+	if err := provider.Authenticate(ctx); err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	replySender, ok := provider.(email.ReplySender)
+	if !ok {
+		return fmt.Errorf("%s provider does not support sending replies", provider.Name())
+	}
+	return replySender.SendReply(ctx, conv.ID, rendered.Text, emailFromDB(*latest))
+}
@@ -1,10 +1,13 @@
 package cli
 
 import (
+	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -15,16 +18,22 @@ import (
 
 var exportCmd = &cobra.Command{
 	Use:   "export",
-	Short: "Export conversations to CSV or JSON",
+	Short: "Export conversations to CSV, JSON, iCalendar, or Markdown",
 	Long: `Export job search conversations to a file.
 
 Supported formats:
   - csv: Comma-separated values (spreadsheet-compatible)
   - json: JSON array of conversation objects
+  - ics: iCalendar events, one per "waiting on me" conversation, for
+    subscribing to follow-up reminders from Google/Apple Calendar
+  - md: Markdown report grouped by status then company, for pasting into a
+    weekly review
 
 Examples:
-  jobsearch export --format=csv > conversations.csv
-  jobsearch export --format=json > conversations.json
+  jobsearch export --format=csv --output=conversations.csv
+  jobsearch export --format=json --output=conversations.json
+  jobsearch export --format=ics --output=followups.ics
+  jobsearch export --format=md --output=weekly-review.md
   jobsearch export --format=csv --include-archived > all.csv`,
 	RunE: runExport,
 }
@@ -32,13 +41,15 @@ Examples:
 var (
 	exportFormat          string
 	exportIncludeArchived bool
+	exportOutput          string
 )
 
 func init() {
 	rootCmd.AddCommand(exportCmd)
 
-	exportCmd.Flags().StringVar(&exportFormat, "format", "csv", "Export format (csv, json)")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "csv", "Export format (csv, json, ics, md)")
 	exportCmd.Flags().BoolVar(&exportIncludeArchived, "include-archived", false, "Include archived conversations")
+	exportCmd.Flags().StringVar(&exportOutput, "output", "", "Write to this file instead of stdout")
 }
 
 func runExport(cmd *cobra.Command, args []string) error {
@@ -57,22 +68,31 @@ func runExport(cmd *cobra.Command, args []string) error {
 	}
 	defer db.Close()
 
-	// Get all conversations
+	out := io.Writer(os.Stdout)
+	if exportOutput != "" {
+		f, err := os.Create(exportOutput)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
 	opts := database.ListOptions{
 		IncludeArchived: exportIncludeArchived,
 	}
-	convs, err := db.ListConversations(ctx, opts)
-	if err != nil {
-		return fmt.Errorf("failed to list conversations: %w", err)
-	}
 
 	switch exportFormat {
 	case "csv":
-		return exportCSV(convs)
+		return exportCSV(ctx, db, opts, out)
 	case "json":
-		return exportJSON(convs)
+		return exportJSON(ctx, db, opts, out)
+	case "ics":
+		return exportICS(ctx, db, opts, cfg, out)
+	case "md":
+		return exportMarkdown(ctx, db, opts, out)
 	default:
-		return fmt.Errorf("unknown format: %s (use csv or json)", exportFormat)
+		return fmt.Errorf("unknown format: %s (use csv, json, ics, or md)", exportFormat)
 	}
 }
 
@@ -116,22 +136,22 @@ func toExportRow(c database.Conversation) ExportRow {
 	return row
 }
 
-func exportCSV(convs []database.Conversation) error {
-	w := csv.NewWriter(os.Stdout)
-	defer w.Flush()
+// exportCSV streams conversations straight from the database to w via
+// IterConversations, rather than loading them all into a slice first, so
+// exports of tens of thousands of rows don't have to fit in memory.
+func exportCSV(ctx context.Context, db *database.DB, opts database.ListOptions, w io.Writer) error {
+	csvWriter := csv.NewWriter(w)
 
-	// Write header
 	header := []string{
 		"id", "company", "position", "recruiter_name", "recruiter_email",
 		"direction", "status", "last_activity_at", "days_since_activity",
 		"email_count", "archived", "created_at",
 	}
-	if err := w.Write(header); err != nil {
+	if err := csvWriter.Write(header); err != nil {
 		return fmt.Errorf("failed to write CSV header: %w", err)
 	}
 
-	// Write rows
-	for _, c := range convs {
+	err := db.IterConversations(ctx, opts, func(c database.Conversation) error {
 		row := toExportRow(c)
 		record := []string{
 			row.ID,
@@ -147,24 +167,199 @@ func exportCSV(convs []database.Conversation) error {
 			fmt.Sprintf("%t", row.Archived),
 			row.CreatedAt,
 		}
-		if err := w.Write(record); err != nil {
+		if err := csvWriter.Write(record); err != nil {
 			return fmt.Errorf("failed to write CSV row: %w", err)
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	return nil
+	csvWriter.Flush()
+	return csvWriter.Error()
 }
 
-func exportJSON(convs []database.Conversation) error {
-	rows := make([]ExportRow, len(convs))
-	for i, c := range convs {
-		rows[i] = toExportRow(c)
+// exportJSON streams conversations to w as a JSON array, encoding each row
+// as it's scanned from the database rather than building the full slice
+// first. json.Encoder has no native streaming-array support, so the
+// brackets and separating commas are written by hand around individually
+// encoded rows.
+func exportJSON(ctx context.Context, db *database.DB, opts database.ListOptions, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("  ", "  ")
+
+	if _, err := io.WriteString(w, "[\n"); err != nil {
+		return err
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(rows); err != nil {
+	first := true
+	err := db.IterConversations(ctx, opts, func(c database.Conversation) error {
+		if !first {
+			if _, err := io.WriteString(w, ",\n"); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		if _, err := io.WriteString(w, "  "); err != nil {
+			return err
+		}
+		return encoder.Encode(toExportRow(c))
+	})
+	if err != nil {
 		return fmt.Errorf("failed to encode JSON: %w", err)
 	}
+
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// exportICS writes one VEVENT per "waiting on me" conversation: a follow-up
+// reminder due tracking.follow_up_sla_days after the conversation's last
+// activity, so the user can subscribe to this file from a calendar app and
+// see recruiting follow-ups alongside everything else.
+func exportICS(ctx context.Context, db *database.DB, opts database.ListOptions, cfg *config.Config, w io.Writer) error {
+	status := database.StatusWaitingOnMe
+	opts.Status = &status
+
+	sla := cfg.Tracking.FollowUpSLA()
+	now := time.Now().UTC()
+
+	if _, err := io.WriteString(w, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//jobsearch-mcp//export//EN\r\n"); err != nil {
+		return err
+	}
+
+	err := db.IterConversations(ctx, opts, func(c database.Conversation) error {
+		dtstart := c.LastActivityAt.Add(sla).UTC()
+		position := ""
+		if c.Position != nil {
+			position = *c.Position
+		}
+		summary := icsEscape(fmt.Sprintf("Follow up: %s — %s", c.Company, position))
+
+		description := "Conversation ID: " + c.ID
+		if c.RecruiterName != nil && *c.RecruiterName != "" {
+			description += "\\nRecruiter: " + *c.RecruiterName
+		}
+		if c.RecruiterEmail != nil && *c.RecruiterEmail != "" {
+			description += "\\nEmail: " + *c.RecruiterEmail
+		}
+
+		event := "" +
+			"BEGIN:VEVENT\r\n" +
+			"UID:" + c.ID + "@jobsearch-mcp\r\n" +
+			"DTSTAMP:" + icsTime(now) + "\r\n" +
+			"DTSTART;VALUE=DATE:" + dtstart.Format("20060102") + "\r\n" +
+			"SUMMARY:" + summary + "\r\n" +
+			"DESCRIPTION:" + icsEscape(description) + "\r\n" +
+			"END:VEVENT\r\n"
+
+		_, err := io.WriteString(w, event)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write calendar events: %w", err)
+	}
+
+	_, err = io.WriteString(w, "END:VCALENDAR\r\n")
+	return err
+}
+
+// icsTime formats t as an iCalendar UTC DATE-TIME value.
+func icsTime(t time.Time) string {
+	return t.Format("20060102T150405Z")
+}
+
+// icsEscape escapes the characters iCalendar's TEXT value type requires
+// escaped: backslash, semicolon, and comma.
+func icsEscape(s string) string {
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\', ';', ',':
+			out = append(out, '\\', s[i])
+		default:
+			out = append(out, s[i])
+		}
+	}
+	return string(out)
+}
+
+// exportMarkdown writes a report grouped by status, then company, suitable
+// for pasting into a weekly review doc. Unlike the other formats, it
+// groups by status first, so it still needs every conversation in memory;
+// it uses IterConversations mainly for consistency with the others.
+func exportMarkdown(ctx context.Context, db *database.DB, opts database.ListOptions, w io.Writer) error {
+	byStatus := make(map[database.ConversationStatus][]database.Conversation)
+	err := db.IterConversations(ctx, opts, func(c database.Conversation) error {
+		byStatus[c.Status] = append(byStatus[c.Status], c)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list conversations: %w", err)
+	}
+
+	statusOrder := []database.ConversationStatus{
+		database.StatusWaitingOnMe,
+		database.StatusWaitingOnThem,
+		database.StatusActive,
+		database.StatusStale,
+		database.StatusClosed,
+	}
+
+	if _, err := fmt.Fprintf(w, "# Job Search Review — %s\n\n", time.Now().Format("2006-01-02")); err != nil {
+		return err
+	}
+
+	for _, status := range statusOrder {
+		convs := byStatus[status]
+		if len(convs) == 0 {
+			continue
+		}
+		sort.Slice(convs, func(i, j int) bool {
+			return convs[i].Company < convs[j].Company
+		})
+
+		if _, err := fmt.Fprintf(w, "## %s (%d)\n\n", markdownStatusTitle(status), len(convs)); err != nil {
+			return err
+		}
+
+		for _, c := range convs {
+			position := ""
+			if c.Position != nil && *c.Position != "" {
+				position = " — " + *c.Position
+			}
+			recruiter := ""
+			if c.RecruiterName != nil && *c.RecruiterName != "" {
+				recruiter = fmt.Sprintf(" (%s)", *c.RecruiterName)
+			}
+			if _, err := fmt.Fprintf(w, "- **%s**%s%s — %d days since activity, %d emails\n",
+				c.Company, position, recruiter, c.DaysSinceActivity(), c.EmailCount); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func markdownStatusTitle(status database.ConversationStatus) string {
+	switch status {
+	case database.StatusWaitingOnMe:
+		return "Waiting on me"
+	case database.StatusWaitingOnThem:
+		return "Waiting on them"
+	case database.StatusActive:
+		return "Active"
+	case database.StatusStale:
+		return "Stale"
+	case database.StatusClosed:
+		return "Closed"
+	default:
+		return string(status)
+	}
+}
@@ -12,12 +12,13 @@ import (
 
 var feedbackCmd = &cobra.Command{
 	Use:   "feedback",
-	Short: "Provide feedback on classifications",
-	Long: `Provide feedback on email classifications to improve filtering.
+	Short: "Provide feedback on classifications and tracker decisions",
+	Long: `Provide feedback on email classifications and automatic status changes.
 
-Use subcommands to mark conversations as:
-  - false-positive: Wrongly included (not job-related)
-  - false-negative: Wrongly excluded (was job-related)`,
+Use subcommands to:
+  - false-positive: Mark a conversation as wrongly included (not job-related)
+  - false-negative: Record an email wrongly excluded (was job-related)
+  - rollback-transition: Undo a content-based status change (see internal/transitions)`,
 }
 
 var feedbackFalsePositiveCmd = &cobra.Command{
@@ -47,10 +48,22 @@ Provide the sender's email address to learn from.`,
 	RunE: runFeedbackFalseNegative,
 }
 
+var feedbackRollbackTransitionCmd = &cobra.Command{
+	Use:   "rollback-transition <message-id>",
+	Short: "Undo a content-based status transition",
+	Long: `Undo a conversation status change that internal/transitions applied from
+the reply with the given Message-ID (see Tracker.applyContentTransition),
+setting the conversation back to its status before that transition fired
+and removing the logged record so it isn't offered for rollback again.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFeedbackRollbackTransition,
+}
+
 func init() {
 	rootCmd.AddCommand(feedbackCmd)
 	feedbackCmd.AddCommand(feedbackFalsePositiveCmd)
 	feedbackCmd.AddCommand(feedbackFalseNegativeCmd)
+	feedbackCmd.AddCommand(feedbackRollbackTransitionCmd)
 }
 
 func runFeedbackFalsePositive(cmd *cobra.Command, args []string) error {
@@ -135,3 +148,46 @@ func runFeedbackFalseNegative(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runFeedbackRollbackTransition(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	messageID := args[0]
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	transition, err := db.GetStatusTransitionByMessageID(ctx, messageID)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if transition == nil {
+		return fmt.Errorf("no status transition was logged for message %q", messageID)
+	}
+
+	conv, err := db.GetConversation(ctx, transition.ConversationID)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if conv == nil {
+		return fmt.Errorf("conversation %q no longer exists", transition.ConversationID)
+	}
+
+	conv.Status = transition.FromStatus
+	if err := db.UpdateConversation(ctx, conv); err != nil {
+		return fmt.Errorf("failed to restore conversation status: %w", err)
+	}
+	if err := db.DeleteStatusTransition(ctx, transition.ID); err != nil {
+		return fmt.Errorf("failed to remove transition record: %w", err)
+	}
+
+	fmt.Printf("Rolled back '%s' from %q to %q (rule %q).\n", conv.Company, transition.ToStatus, transition.FromStatus, transition.RuleName)
+	return nil
+}
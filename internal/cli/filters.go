@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
 	"os"
 	"strings"
@@ -23,8 +24,11 @@ The system learns from:
 
 Use subcommands to:
   - list: View all learned filters
+  - review: Interactively accept/reject pending suggestions
   - approve: Approve an AI suggestion
   - reject: Reject/delete a filter
+  - stats: View per-filter evidence, precision, and false-positive rate
+  - policy: View or print updated auto-promotion/demotion thresholds
   - export: Export filters to add to config.toml`,
 }
 
@@ -58,9 +62,46 @@ to make the learned filters permanent.`,
 	RunE: runFiltersExport,
 }
 
+var filtersReviewCmd = &cobra.Command{
+	Use:   "review",
+	Short: "Interactively accept or reject pending AI suggestions",
+	Long: `Walk through each AI-suggested filter that hasn't cleared the
+auto-promotion threshold yet (see the [learning] config section) and
+approve or reject it one at a time.`,
+	RunE: runFiltersReview,
+}
+
+var filtersStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show per-filter evidence, precision, and false-positive rate",
+	Long: `Show every learned filter's support count (distinct emails that
+produced it), its clean-run length since the last false positive, the
+resulting precision estimate (database.LearnedFilter.Precision), and when
+it was last seen - the same numbers the auto-promotion and demotion
+thresholds in [learning] are evaluated against.`,
+	RunE: runFiltersStats,
+}
+
+var filtersPolicyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "View or print updated auto-promotion/demotion thresholds",
+	Long: `Show the [learning] thresholds that govern auto-promotion (min_support,
+clean_run_required, max_false_positive_rate, auto_approve_threshold) and
+demotion (demote_below_precision).
+
+Pass --threshold or --min-evidence to print an updated [learning] block to
+paste into config.toml - config.toml is the source of truth for these
+settings, same as "jobsearch filters export" for the filters themselves,
+so this command never writes the file for you.`,
+	RunE: runFiltersPolicy,
+}
+
 var (
 	filtersTypeFlag   string
 	filtersSourceFlag string
+
+	filtersPolicyThreshold   float64
+	filtersPolicyMinEvidence int
 )
 
 func init() {
@@ -69,9 +110,15 @@ func init() {
 	filtersCmd.AddCommand(filtersApproveCmd)
 	filtersCmd.AddCommand(filtersRejectCmd)
 	filtersCmd.AddCommand(filtersExportCmd)
+	filtersCmd.AddCommand(filtersReviewCmd)
+	filtersCmd.AddCommand(filtersStatsCmd)
+	filtersCmd.AddCommand(filtersPolicyCmd)
 
 	filtersListCmd.Flags().StringVar(&filtersTypeFlag, "type", "", "Filter by type (domain_whitelist, domain_blacklist, subject_keyword, body_keyword, subject_blacklist)")
 	filtersListCmd.Flags().StringVar(&filtersSourceFlag, "source", "", "Filter by source (user, ai_suggested, ai_confirmed)")
+
+	filtersPolicyCmd.Flags().Float64Var(&filtersPolicyThreshold, "threshold", 0, "New auto_approve_threshold (confidence*precision) to print")
+	filtersPolicyCmd.Flags().IntVar(&filtersPolicyMinEvidence, "min-evidence", 0, "New min_support to print")
 }
 
 func runFiltersList(cmd *cobra.Command, args []string) error {
@@ -316,3 +363,141 @@ func runFiltersExport(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runFiltersReview(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	source := database.FilterSourceAISuggested
+	pending, err := db.ListLearnedFilters(ctx, database.LearnedFilterListOptions{Source: &source})
+	if err != nil {
+		return fmt.Errorf("failed to list filters: %w", err)
+	}
+
+	if len(pending) == 0 {
+		fmt.Println("No pending suggestions to review.")
+		return nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	for _, f := range pending {
+		fpRate := 0.0
+		if f.SupportCount > 0 {
+			fpRate = float64(f.FalsePositiveCount) / float64(f.SupportCount)
+		}
+		fmt.Printf("\n%s = %q  (support=%d, false_positives=%d, fp_rate=%.0f%%)\n",
+			f.FilterType, f.Value, f.SupportCount, f.FalsePositiveCount, fpRate*100)
+		fmt.Print("Approve, reject, or skip? [a/r/s] ")
+
+		input, _ := reader.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(input)) {
+		case "a":
+			if err := db.ApproveLearnedFilter(ctx, f.ID); err != nil {
+				return fmt.Errorf("failed to approve filter: %w", err)
+			}
+			fmt.Println("Approved.")
+		case "r":
+			if err := db.DeleteLearnedFilter(ctx, f.ID); err != nil {
+				return fmt.Errorf("failed to delete filter: %w", err)
+			}
+			fmt.Println("Rejected.")
+		default:
+			fmt.Println("Skipped.")
+		}
+	}
+
+	return nil
+}
+
+func runFiltersStats(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	filters, err := db.ListLearnedFilters(ctx, database.LearnedFilterListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list filters: %w", err)
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(filters)
+	}
+
+	if len(filters) == 0 {
+		fmt.Println("No learned filters yet.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tVALUE\tSOURCE\tSUPPORT\tCLEAN_RUN\tFALSE_POS\tPRECISION\tLAST_SEEN")
+	fmt.Fprintln(w, "────\t─────\t──────\t───────\t─────────\t─────────\t─────────\t─────────")
+	for _, f := range filters {
+		lastSeen := "-"
+		if f.LastSeen != nil {
+			lastSeen = f.LastSeen.Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%.0f%%\t%s\n",
+			f.FilterType, f.Value, f.Source, f.SupportCount, f.SupportSinceFP, f.FalsePositiveCount, f.Precision()*100, lastSeen)
+	}
+	w.Flush()
+
+	return nil
+}
+
+func runFiltersPolicy(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	threshold := cfg.Learning.AutoApproveThreshold
+	minEvidence := cfg.Learning.MinSupport
+	if filtersPolicyThreshold > 0 {
+		threshold = filtersPolicyThreshold
+	}
+	if filtersPolicyMinEvidence > 0 {
+		minEvidence = filtersPolicyMinEvidence
+	}
+
+	fmt.Println("Current auto-promotion/demotion policy (from [learning] in config.toml):")
+	fmt.Printf("  min_support            = %d\n", cfg.Learning.MinSupport)
+	fmt.Printf("  clean_run_required     = %d\n", cfg.Learning.CleanRunRequired)
+	fmt.Printf("  max_false_positive_rate = %.2f\n", cfg.Learning.MaxFalsePositiveRate)
+	fmt.Printf("  auto_approve_threshold = %.2f\n", cfg.Learning.AutoApproveThreshold)
+	fmt.Printf("  demote_below_precision = %.2f\n", cfg.Learning.DemoteBelowPrecision)
+
+	if filtersPolicyThreshold == 0 && filtersPolicyMinEvidence == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("# Add this to your config.toml [learning] section:")
+	fmt.Println()
+	fmt.Println("[learning]")
+	fmt.Printf("min_support = %d\n", minEvidence)
+	fmt.Printf("clean_run_required = %d\n", cfg.Learning.CleanRunRequired)
+	fmt.Printf("max_false_positive_rate = %.2f\n", cfg.Learning.MaxFalsePositiveRate)
+	fmt.Printf("auto_approve_threshold = %.2f\n", threshold)
+	fmt.Printf("demote_below_precision = %.2f\n", cfg.Learning.DemoteBelowPrecision)
+
+	return nil
+}
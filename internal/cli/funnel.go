@@ -0,0 +1,237 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// StageStat reports the funnel numbers for one pipeline stage: how many
+// conversations reached it, what fraction of the previous stage's
+// conversations made it this far, and the median number of days a
+// conversation spent in the stage before advancing (or, for whichever
+// stage it's still in, before its last activity).
+type StageStat struct {
+	Stage         string  `json:"stage"`
+	Count         int     `json:"count"`
+	ConversionPct float64 `json:"conversion_pct"` // relative to the previous stage; 100 for the first
+	MedianDays    float64 `json:"median_days"`
+}
+
+type compiledFunnelStage struct {
+	name     string
+	patterns []*regexp.Regexp
+}
+
+func compileFunnelStages(cfg config.FunnelConfig) ([]compiledFunnelStage, error) {
+	stages := make([]compiledFunnelStage, 0, len(cfg.Stages))
+	for _, s := range cfg.Stages {
+		cs := compiledFunnelStage{name: s.Name}
+		for _, p := range s.Patterns {
+			re, err := regexp.Compile("(?i)" + p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid funnel pattern for stage %q: %w", s.Name, err)
+			}
+			cs.patterns = append(cs.patterns, re)
+		}
+		stages = append(stages, cs)
+	}
+	return stages, nil
+}
+
+// funnelProgress is one conversation's furthest reached stage (-1 if none
+// matched) and the earliest email date at which it matched each stage, used
+// to estimate time-in-stage.
+type funnelProgress struct {
+	furthest  int
+	enteredAt map[int]time.Time
+}
+
+func inferFunnelProgress(stages []compiledFunnelStage, emails []database.Email) funnelProgress {
+	progress := funnelProgress{furthest: -1, enteredAt: make(map[int]time.Time)}
+
+	for _, e := range emails {
+		var text string
+		if e.Subject != nil {
+			text = *e.Subject + " "
+		}
+		if e.Snippet != nil {
+			text += *e.Snippet
+		}
+		if text == "" {
+			continue
+		}
+
+		for i, s := range stages {
+			matched := false
+			for _, re := range s.patterns {
+				if re.MatchString(text) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				continue
+			}
+			if existing, ok := progress.enteredAt[i]; !ok || e.Date.Before(existing) {
+				progress.enteredAt[i] = e.Date
+			}
+			if i > progress.furthest {
+				progress.furthest = i
+			}
+		}
+	}
+
+	return progress
+}
+
+// buildFunnel aggregates convs into per-stage counts, stage-to-stage
+// conversion rates, and median time spent in each stage. A conversation
+// that reaches stage N is counted as having passed through every stage up
+// to N, since recruiting pipelines aren't always narrated email-by-email
+// (e.g. a conversation might jump straight from outreach to an onsite
+// invite with no separate "phone screen" email).
+func buildFunnel(ctx context.Context, db *database.DB, convs []database.Conversation, cfg config.FunnelConfig) ([]StageStat, error) {
+	stages, err := compileFunnelStages(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(stages) == 0 {
+		return nil, nil
+	}
+
+	counts := make([]int, len(stages))
+	durations := make([][]float64, len(stages))
+
+	for _, c := range convs {
+		emails, err := db.ListEmailsForConversation(ctx, c.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load emails for %s: %w", c.ID, err)
+		}
+
+		progress := inferFunnelProgress(stages, emails)
+		if progress.furthest < 0 {
+			continue
+		}
+
+		for i := 0; i <= progress.furthest; i++ {
+			counts[i]++
+
+			start, ok := progress.enteredAt[i]
+			if !ok {
+				continue
+			}
+			end := c.LastActivityAt
+			if i < progress.furthest {
+				if next, ok := progress.enteredAt[i+1]; ok {
+					end = next
+				}
+			}
+			if end.Before(start) {
+				continue
+			}
+			durations[i] = append(durations[i], end.Sub(start).Hours()/24)
+		}
+	}
+
+	stats := make([]StageStat, len(stages))
+	for i, s := range stages {
+		stat := StageStat{Stage: s.name, Count: counts[i]}
+		if i == 0 {
+			stat.ConversionPct = 100
+		} else if counts[i-1] > 0 {
+			stat.ConversionPct = float64(counts[i]) / float64(counts[i-1]) * 100
+		}
+		stat.MedianDays = median(durations[i])
+		stats[i] = stat
+	}
+
+	return stats, nil
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// printFunnel renders the pipeline funnel as an ASCII bar chart, in the
+// same style as the 14-day activity chart in printDetailedStats. When prev
+// is non-nil (--compare-to was given), each line also shows the delta in
+// count and conversion rate against the prior window.
+func printFunnel(stats []StageStat, prev []StageStat) {
+	fmt.Println("Pipeline Funnel")
+	fmt.Println(strings.Repeat("-", 30))
+
+	maxCount := 0
+	for _, s := range stats {
+		if s.Count > maxCount {
+			maxCount = s.Count
+		}
+	}
+	if maxCount == 0 {
+		fmt.Println("  No conversations matched a funnel stage")
+		fmt.Println()
+		return
+	}
+
+	for i, s := range stats {
+		barLen := (s.Count * 20) / maxCount
+		line := fmt.Sprintf("  %-10s %s %d (%.0f%%, %.1fd)",
+			s.Stage, strings.Repeat("█", barLen), s.Count, s.ConversionPct, s.MedianDays)
+		if prev != nil && i < len(prev) {
+			line += fmt.Sprintf("  [%+d, %+.0f%%]", s.Count-prev[i].Count, s.ConversionPct-prev[i].ConversionPct)
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+}
+
+// funnelConversations loads the conversations that fall within [since,
+// before) for buildFunnel, including archived ones - a conversation
+// shouldn't drop out of the funnel just because it was archived after
+// being rejected.
+func funnelConversations(ctx context.Context, db *database.DB, since, before *time.Time) ([]database.Conversation, error) {
+	return db.Query(ctx, database.SearchCriteria{
+		Since:           since,
+		Before:          before,
+		IncludeArchived: true,
+	})
+}
+
+// funnelWindow resolves the current and, if compareTo is set, the
+// preceding funnel window: the current window starts at since (or 30 days
+// ago if since is nil, matching getClassificationMetrics' default) and has
+// no upper bound; the comparison window is the compareTo-long span
+// immediately before it.
+func funnelWindow(since *time.Time, compareTo string) (curSince time.Time, prevSince, prevBefore *time.Time, err error) {
+	curSince = time.Now().AddDate(0, 0, -30)
+	if since != nil {
+		curSince = *since
+	}
+
+	if compareTo == "" {
+		return curSince, nil, nil, nil
+	}
+
+	d, err := parseDuration(compareTo)
+	if err != nil {
+		return curSince, nil, nil, fmt.Errorf("invalid --compare-to duration: %w", err)
+	}
+
+	start := curSince.Add(-d)
+	return curSince, &start, &curSince, nil
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+)
+
+// interviewsCmd groups the read-only views over the Interview rows
+// Tracker.handleInterviews creates from calendar invites detected during
+// sync - there's no write subcommand because interviews are only ever
+// created/updated by ingestion, never by hand.
+var interviewsCmd = &cobra.Command{
+	Use:   "interviews",
+	Short: "View interviews detected from calendar invites",
+	Long: `View the Interview rows created from calendar invites (and cancellations)
+found in synced mail.
+
+Use subcommands to:
+  - list <conversation-id>: Show every interview recorded for one conversation
+  - upcoming: Show every not-yet-cancelled interview starting from now on`,
+}
+
+var interviewsListCmd = &cobra.Command{
+	Use:   "list <conversation-id>",
+	Short: "List every interview recorded for a conversation",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runInterviewsList,
+}
+
+var interviewsUpcomingCmd = &cobra.Command{
+	Use:   "upcoming",
+	Short: "List every not-yet-cancelled upcoming interview",
+	RunE:  runInterviewsUpcoming,
+}
+
+func init() {
+	rootCmd.AddCommand(interviewsCmd)
+	interviewsCmd.AddCommand(interviewsListCmd)
+	interviewsCmd.AddCommand(interviewsUpcomingCmd)
+}
+
+func runInterviewsList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	interviews, err := db.ListInterviews(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to list interviews: %w", err)
+	}
+
+	return printInterviews(interviews)
+}
+
+func runInterviewsUpcoming(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	interviews, err := db.ListUpcomingInterviews(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to list upcoming interviews: %w", err)
+	}
+
+	return printInterviews(interviews)
+}
+
+func printInterviews(interviews []database.Interview) error {
+	if outputFmt == "json" {
+		return output.JSON(interviews)
+	}
+
+	if len(interviews) == 0 {
+		fmt.Println("No interviews found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "START\tSUMMARY\tLOCATION\tSTATUS\tCONVERSATION")
+	for _, iv := range interviews {
+		location := "-"
+		if iv.VideoURL != nil && *iv.VideoURL != "" {
+			location = *iv.VideoURL
+		} else if iv.Location != nil && *iv.Location != "" {
+			location = *iv.Location
+		}
+		start := iv.StartTime.Format("2006-01-02 15:04")
+		if iv.AllDay {
+			start = iv.StartTime.Format("2006-01-02") + " (all day)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", start, iv.Summary, location, iv.Status, iv.ConversationID)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+var labelCmd = &cobra.Command{
+	Use:   "label <email-id>",
+	Short: "Set a gold label for an email's classification",
+	Long: `Record the true classification of an email, independent of whatever the
+filter/LLM pipeline predicted for it.
+
+This builds a labeled evaluation set: 'jobsearch stats --classification
+--eval' joins these labels against each email's actual classification to
+compute precision, recall, and F1.
+
+Use 'jobsearch label import' to bulk-load labels from a CSV file instead.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runLabel,
+}
+
+var labelImportCmd = &cobra.Command{
+	Use:   "import <file.csv>",
+	Short: "Bulk-import gold labels from a CSV file",
+	Long: `Import gold labels from a CSV file with the header "email_id,gold_label",
+where gold_label is "good" or "junk" (see 'jobsearch label --help').`,
+	Args: cobra.ExactArgs(1),
+	RunE: runLabelImport,
+}
+
+var (
+	labelGood bool
+	labelJunk bool
+)
+
+func init() {
+	labelCmd.Flags().BoolVar(&labelGood, "good", false, "label the email job-related")
+	labelCmd.Flags().BoolVar(&labelJunk, "junk", false, "label the email not job-related")
+	labelCmd.AddCommand(labelImportCmd)
+	rootCmd.AddCommand(labelCmd)
+}
+
+func runLabel(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	emailID := args[0]
+
+	if labelGood == labelJunk {
+		return fmt.Errorf("exactly one of --good or --junk is required")
+	}
+	goldLabel := database.BayesClassJunk
+	if labelGood {
+		goldLabel = database.BayesClassGood
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	e, err := db.GetEmail(ctx, emailID)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if e == nil {
+		return fmt.Errorf("email not found: %s", emailID)
+	}
+
+	if err := db.UpsertClassificationLabel(ctx, database.ClassificationLabel{
+		EmailID:   emailID,
+		GoldLabel: goldLabel,
+		Source:    database.LabelSourceUser,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		return fmt.Errorf("failed to save label: %w", err)
+	}
+
+	fmt.Printf("Labeled %s as %q.\n", emailID, goldLabel)
+	return nil
+}
+
+func runLabelImport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	path := args[0]
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	reader := csv.NewReader(f)
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	emailIDCol, goldLabelCol := -1, -1
+	for i, col := range header {
+		switch col {
+		case "email_id":
+			emailIDCol = i
+		case "gold_label":
+			goldLabelCol = i
+		}
+	}
+	if emailIDCol == -1 || goldLabelCol == -1 {
+		return fmt.Errorf(`CSV header must include "email_id" and "gold_label" columns`)
+	}
+
+	now := time.Now()
+	imported, skipped := 0, 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read CSV row: %w", err)
+		}
+
+		goldLabel := record[goldLabelCol]
+		if goldLabel != database.BayesClassGood && goldLabel != database.BayesClassJunk {
+			return fmt.Errorf("row %d: gold_label must be %q or %q, got %q",
+				imported+skipped+2, database.BayesClassGood, database.BayesClassJunk, goldLabel)
+		}
+
+		emailID := record[emailIDCol]
+		e, err := db.GetEmail(ctx, emailID)
+		if err != nil {
+			return fmt.Errorf("database error: %w", err)
+		}
+		if e == nil {
+			skipped++
+			continue
+		}
+
+		if err := db.UpsertClassificationLabel(ctx, database.ClassificationLabel{
+			EmailID:   emailID,
+			GoldLabel: goldLabel,
+			Source:    database.LabelSourceImported,
+			CreatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("failed to save label for %s: %w", emailID, err)
+		}
+		imported++
+	}
+
+	fmt.Printf("Imported %d labels (%d skipped, email not found).\n", imported, skipped)
+	return nil
+}
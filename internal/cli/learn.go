@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+)
+
+var learnLabel string
+
+var learnCmd = &cobra.Command{
+	Use:   "learn <conversation-id>",
+	Short: "Train the Bayesian classifier from a conversation",
+	Long: `Train the Bayesian spam classifier directly from a conversation's first
+email, labeling it good (job-related) or junk.
+
+Unlike 'feedback false-positive/false-negative', this does not touch the
+conversation's status or the blacklist/whitelist filters - it only updates
+the classifier's token and class-total buckets.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runLearn,
+}
+
+func init() {
+	learnCmd.Flags().StringVar(&learnLabel, "label", "", "label to train: good or junk (required)")
+	rootCmd.AddCommand(learnCmd)
+}
+
+func runLearn(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	convID := args[0]
+
+	switch learnLabel {
+	case database.BayesClassGood, database.BayesClassJunk:
+	default:
+		return fmt.Errorf("--label must be %q or %q", database.BayesClassGood, database.BayesClassJunk)
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	t := tracker.New(db, nil, nil, nil, cfg)
+
+	if err := t.LearnConversation(ctx, convID, learnLabel); err != nil {
+		return fmt.Errorf("failed to train classifier: %w", err)
+	}
+
+	fmt.Printf("Trained classifier on conversation %s as %q.\n", convID, learnLabel)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/filter"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+)
+
+var learnKeywordsDryRun bool
+
+var learnKeywordsCmd = &cobra.Command{
+	Use:   "learn-keywords",
+	Short: "Extract recruiting keywords from your conversation history via RAKE",
+	Long: `Run a RAKE (Rapid Automatic Keyword Extraction) pass over every email
+belonging to a conversation you haven't archived or closed, and stage newly
+discovered subject/body phrases as ai_suggested filters - the same staged
+suggestion + auto-promotion path 'jobsearch sync' already uses for domains
+and hand-picked recruiting phrases, just scored from your own corpus
+instead of a fixed pattern list.
+
+Use --dry-run to preview what would be staged without writing anything.`,
+	RunE: runLearnKeywords,
+}
+
+func init() {
+	learnKeywordsCmd.Flags().BoolVar(&learnKeywordsDryRun, "dry-run", false, "Preview discovered phrases without staging them")
+	rootCmd.AddCommand(learnKeywordsCmd)
+}
+
+func runLearnKeywords(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	f := filter.New(cfg.Filters)
+	loadLearnedFilters(ctx, db, f)
+	t := tracker.New(db, nil, f, nil, cfg)
+
+	subjectPhrases, bodyPhrases, err := t.LearnKeywords(ctx, learnKeywordsDryRun)
+	if err != nil {
+		return fmt.Errorf("failed to learn keywords: %w", err)
+	}
+
+	if len(subjectPhrases) == 0 && len(bodyPhrases) == 0 {
+		fmt.Println("No new keyword phrases found.")
+		return nil
+	}
+
+	verb := "Staged"
+	if learnKeywordsDryRun {
+		verb = "Would stage"
+	}
+	if len(subjectPhrases) > 0 {
+		fmt.Printf("%s %d subject keyword(s): %v\n", verb, len(subjectPhrases), subjectPhrases)
+	}
+	if len(bodyPhrases) > 0 {
+		fmt.Printf("%s %d body keyword(s): %v\n", verb, len(bodyPhrases), bodyPhrases)
+	}
+
+	return nil
+}
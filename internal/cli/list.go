@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,30 +12,31 @@ import (
 )
 
 var listCmd = &cobra.Command{
-	Use:   "list",
+	Use:   "list [query terms...]",
 	Short: "List conversations",
 	Long: `List job search conversations with optional filters.
 
+Flags narrow the search with exact predicates (--company, --status, ...);
+positional arguments are free-text terms, ANDed together by default and
+pushed through the same query language "jobsearch search" uses, so they
+support field:value tokens, OR, NOT, and (parenthesized) groups for
+compound filters.
+
 Examples:
-  jobsearch list                           # List all conversations
-  jobsearch list --status=waiting_on_me    # List conversations needing your response
-  jobsearch list --since=7d                # List conversations from last 7 days
-  jobsearch list -o json                   # Output as JSON`,
+  jobsearch list                              # List all conversations
+  jobsearch list --status=waiting_on_me       # List conversations needing your response
+  jobsearch list --classification=recruiter_outreach --newer-than 14d stripe google
+  jobsearch list stripe OR google             # Either company
+  jobsearch list "(stripe OR google) status:active"
+  jobsearch list -o json                      # Output as JSON`,
 	RunE: runList,
 }
 
-var (
-	listStatus string
-	listSince  string
-	listLimit  int
-)
-
 func init() {
 	rootCmd.AddCommand(listCmd)
-
-	listCmd.Flags().StringVar(&listStatus, "status", "", "Filter by status (waiting_on_me, waiting_on_them, stale, active, closed)")
-	listCmd.Flags().StringVar(&listSince, "since", "", "Filter by time (e.g., 7d, 2w, 1m)")
-	listCmd.Flags().IntVar(&listLimit, "limit", 0, "Maximum number of results")
+	addSearchCriteriaFlags(listCmd)
+	listCmd.Flags().StringSlice("columns", nil,
+		`Override the column set for --format csv (e.g. --columns id,company,status)`)
 }
 
 func runList(cmd *cobra.Command, args []string) error {
@@ -53,31 +55,24 @@ func runList(cmd *cobra.Command, args []string) error {
 	}
 	defer db.Close()
 
-	// Build query options
-	opts := database.ListOptions{
-		Limit: listLimit,
-	}
-
-	if listStatus != "" {
-		status := database.ConversationStatus(listStatus)
-		opts.Status = &status
+	crit, err := parseSearchCriteria(cmd)
+	if err != nil {
+		return err
 	}
-
-	if listSince != "" {
-		since, err := parseDuration(listSince)
-		if err != nil {
-			return fmt.Errorf("invalid duration: %w", err)
-		}
-		sinceTime := time.Now().Add(-since)
-		opts.Since = &sinceTime
+	if len(args) > 0 {
+		crit.All = append(crit.All, database.ParseQuery(strings.Join(args, " ")))
 	}
 
 	// Query database
-	convs, err := db.ListConversations(ctx, opts)
+	convs, err := db.Query(ctx, crit)
 	if err != nil {
 		return fmt.Errorf("failed to list conversations: %w", err)
 	}
 
+	if columns, _ := cmd.Flags().GetStringSlice("columns"); len(columns) > 0 {
+		output.SetCSVColumns(columns)
+	}
+
 	// Output
 	return output.Output(outputFmt, convs)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
 )
 
 var markSpamCmd = &cobra.Command{
@@ -17,12 +18,12 @@ var markSpamCmd = &cobra.Command{
 	Long: `Mark a conversation as a false positive (not actually job-related).
 
 This command:
-1. Adds the recruiter's domain to your learned blacklist
-2. Increments the false positive count for that domain
-3. Archives the conversation
+1. Trains the Bayes classifier on this conversation's first email as junk
+2. Adds the sender's domain to the learned blacklist
+3. Closes and archives the conversation
 
-After multiple false positives from the same domain (default: 3),
-the domain will be auto-blacklisted for future syncs.
+Run 'jobsearch unmark-spam' to reverse this if a conversation was marked
+by mistake.
 
 Arguments can be:
   - Company name (case-insensitive, partial match)
@@ -37,12 +38,10 @@ Examples:
 
 // MarkSpamResult contains the result of marking as spam
 type MarkSpamResult struct {
-	ConversationID     string `json:"conversation_id"`
-	Company            string `json:"company"`
-	Domain             string `json:"domain"`
-	FalsePositiveCount int    `json:"false_positive_count"`
-	AutoBlacklisted    bool   `json:"auto_blacklisted"`
-	Archived           bool   `json:"archived"`
+	ConversationID string `json:"conversation_id"`
+	Company        string `json:"company"`
+	Domain         string `json:"domain"`
+	Archived       bool   `json:"archived"`
 }
 
 func init() {
@@ -75,7 +74,8 @@ func runMarkSpam(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("conversation not found: %s", identifier)
 	}
 
-	// Extract domain from recruiter email
+	// Extract domain from recruiter email, for display only - the learner
+	// derives its own domain from the conversation's first email.
 	var domain string
 	if conv.RecruiterEmail != nil && *conv.RecruiterEmail != "" {
 		parts := strings.Split(*conv.RecruiterEmail, "@")
@@ -91,27 +91,11 @@ func runMarkSpam(cmd *cobra.Command, args []string) error {
 		Archived:       true,
 	}
 
-	// Mark the domain as false positive (increments counter)
-	if domain != "" {
-		if err := db.MarkFalsePositive(ctx, domain); err != nil {
-			return fmt.Errorf("failed to record false positive: %w", err)
-		}
-
-		// Get the updated count
-		count, err := db.GetFalsePositiveCount(ctx, domain)
-		if err != nil {
-			return fmt.Errorf("failed to get false positive count: %w", err)
-		}
-		result.FalsePositiveCount = count
-
-		// Check if we should auto-blacklist (threshold is 3)
-		autoBlacklistThreshold := 3
-		if count >= autoBlacklistThreshold {
-			if err := db.PromoteToAutoBlacklist(ctx, domain); err != nil {
-				return fmt.Errorf("failed to auto-blacklist domain: %w", err)
-			}
-			result.AutoBlacklisted = true
-		}
+	// Train the Bayes classifier as junk, close the conversation, and
+	// blacklist the domain.
+	t := tracker.New(db, nil, nil, nil, cfg)
+	if err := t.MarkFalsePositive(ctx, conv.ID); err != nil {
+		return fmt.Errorf("failed to record false positive: %w", err)
 	}
 
 	// Archive the conversation
@@ -126,12 +110,10 @@ func runMarkSpam(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Marked as spam: %s\n", result.Company)
 	if result.Domain != "" {
-		fmt.Printf("  Domain: %s (false positive count: %d)\n", result.Domain, result.FalsePositiveCount)
-		if result.AutoBlacklisted {
-			fmt.Printf("  Domain auto-blacklisted (reached threshold)\n")
-		}
+		fmt.Printf("  Domain: %s\n", result.Domain)
 	}
-	fmt.Println("  Conversation archived")
+	fmt.Println("  Bayes classifier trained as junk")
+	fmt.Println("  Conversation closed and archived")
 	fmt.Println("\nEmails from this domain will be less likely to appear in future syncs.")
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -15,10 +16,11 @@ import (
 
 var mcpCmd = &cobra.Command{
 	Use:   "mcp",
-	Short: "Start MCP server (stdio transport)",
-	Long: `Start the MCP (Model Context Protocol) server using stdio transport.
+	Short: "Start MCP server (stdio or HTTP+SSE transport)",
+	Long: `Start the MCP (Model Context Protocol) server.
 
-This allows AI assistants like Claude Desktop to interact with your job search data.
+By default this uses stdio transport, which allows AI assistants like Claude
+Desktop to interact with your job search data.
 
 Add to Claude Desktop config (~/Library/Application Support/Claude/claude_desktop_config.json):
 
@@ -29,11 +31,19 @@ Add to Claude Desktop config (~/Library/Application Support/Claude/claude_deskto
       "args": ["mcp"]
     }
   }
-}`,
+}
+
+Pass --transport=http (or set [mcp] transport = "http" in the config) to run
+as an HTTP+SSE daemon instead, so multiple clients can share one running
+server: POST JSON-RPC requests to /rpc and receive responses over a GET
+/events Server-Sent Events stream.`,
 	RunE: runMCP,
 }
 
+var mcpTransport string
+
 func init() {
+	mcpCmd.Flags().StringVar(&mcpTransport, "transport", "", "Transport to use: stdio or http (overrides [mcp] transport)")
 	rootCmd.AddCommand(mcpCmd)
 }
 
@@ -70,6 +80,30 @@ func runMCP(cmd *cobra.Command, args []string) error {
 		cancel()
 	}()
 
-	// Run server
-	return server.Start(ctx)
+	transport := cfg.MCP.Transport
+	if mcpTransport != "" {
+		transport = mcpTransport
+	}
+
+	switch transport {
+	case "", "stdio":
+		return server.Start(ctx)
+	case "http":
+		addr := mcpListenAddr(cfg)
+		fmt.Printf("MCP HTTP server listening on %s (POST /rpc, GET /events)\n", addr)
+		return server.StartHTTP(ctx, addr)
+	default:
+		return fmt.Errorf("unknown mcp transport: %s", transport)
+	}
+}
+
+// mcpListenAddr returns the address the HTTP transport should bind to:
+// cfg.MCP.Listen if set, otherwise the classifier's host:port with the
+// port incremented by one so the two services don't collide.
+func mcpListenAddr(cfg *config.Config) string {
+	if cfg.MCP.Listen != "" {
+		return cfg.MCP.Listen
+	}
+	host := strings.TrimPrefix(strings.TrimPrefix(cfg.Classifier.Host, "http://"), "https://")
+	return fmt.Sprintf("%s:%d", host, cfg.Classifier.Port+1)
 }
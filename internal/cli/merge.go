@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -94,35 +93,3 @@ func runMerge(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
-
-// findConversation finds a conversation by company name or ID
-func findConversation(ctx context.Context, db *database.DB, identifier string) (*database.Conversation, error) {
-	// Try by company first
-	conv, err := db.GetConversationByCompany(ctx, identifier)
-	if err != nil {
-		return nil, err
-	}
-	if conv != nil {
-		return conv, nil
-	}
-
-	// Try by ID
-	conv, err = db.GetConversation(ctx, identifier)
-	if err != nil {
-		return nil, err
-	}
-	if conv != nil {
-		return conv, nil
-	}
-
-	// Try search and use first result
-	results, err := db.Search(ctx, identifier)
-	if err != nil {
-		return nil, err
-	}
-	if len(results) > 0 {
-		return &results[0], nil
-	}
-
-	return nil, nil
-}
@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
+)
+
+var notifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "Manage the notification/alerting subsystem",
+	Long: `Manage the rule-based notifications configured under [notify] in
+config.toml. See also "jobsearch filters rules" to approve, reject, or
+export those rules.`,
+}
+
+var notifyTestCmd = &cobra.Command{
+	Use:   "test <rule>",
+	Short: "Preview which conversations a configured rule would match",
+	Long: `Evaluate one [[notify.rules]] entry against every conversation in the
+database and print the ones that match, without sending any notification
+or recording a throttle fire.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNotifyTest,
+}
+
+// notifyTemplatesCmd groups the user-editable digest/alert templates
+// (notify.EventTemplateKinds) under their own subcommand, parallel to
+// notifyTestCmd's rule preview - "test" is already taken at the notifyCmd
+// level by rule matching, so template preview lives one level deeper.
+var notifyTemplatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Preview and override the digest/alert templates notifiers render",
+}
+
+var notifyTemplatesTestCmd = &cobra.Command{
+	Use:   "test <kind>",
+	Short: "Render a template against a sample conversation",
+	Long: fmt.Sprintf(`Render one of the digest/alert templates - %s - against a
+made-up sample conversation, interview, and email, the way a real
+notify.Event would be rendered. Uses the override under
+[notify].templates_dir if one exists for <kind>, the shipped default
+otherwise.`, strings.Join(notify.EventTemplateKinds, ", ")),
+	Args: cobra.ExactArgs(1),
+	RunE: runNotifyTemplatesTest,
+}
+
+func init() {
+	rootCmd.AddCommand(notifyCmd)
+	notifyCmd.AddCommand(notifyTestCmd)
+	notifyCmd.AddCommand(notifyTemplatesCmd)
+	notifyTemplatesCmd.AddCommand(notifyTemplatesTestCmd)
+}
+
+func runNotifyTemplatesTest(cmd *cobra.Command, args []string) error {
+	kind := args[0]
+	if !isKnownTemplateKind(kind) {
+		return fmt.Errorf("unknown template kind %q: expected one of %s", kind, strings.Join(notify.EventTemplateKinds, ", "))
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := notify.EnsureEventDefaults(cfg.Notify.TemplatesDir); err != nil {
+		return fmt.Errorf("failed to materialize default templates: %w", err)
+	}
+
+	rendered, err := notify.RenderEvent(sampleEvent(kind), cfg.Notify.TemplatesDir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Subject: %s\n\n--- text ---\n%s\n--- html ---\n%s\n", rendered.Subject, rendered.Text, rendered.HTML)
+	return nil
+}
+
+func isKnownTemplateKind(kind string) bool {
+	for _, k := range notify.EventTemplateKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleEvent builds a made-up Event for kind, standing in for the real
+// Conversation/Email/Interview "jobsearch notify templates test" doesn't
+// have one of handy.
+func sampleEvent(kind string) notify.Event {
+	recruiter := "Jordan Lee"
+	position := "Senior Backend Engineer"
+	conv := &database.Conversation{
+		Company:        "Acme Corp",
+		Position:       &position,
+		RecruiterName:  &recruiter,
+		Status:         database.StatusActive,
+		LastActivityAt: time.Now().Add(-9 * 24 * time.Hour),
+	}
+
+	switch kind {
+	case "interview_scheduled":
+		location := "https://zoom.us/j/123456789"
+		conv.Status = database.StatusInterviewScheduled
+		return notify.Event{
+			Type:         notify.EventInterviewScheduled,
+			Conversation: conv,
+			Interview: &database.Interview{
+				Summary:   "Phone screen with Acme Corp",
+				Location:  &location,
+				StartTime: time.Now().Add(3 * 24 * time.Hour),
+			},
+		}
+	case "stale_conversation":
+		conv.Status = database.StatusStale
+		return notify.Event{Type: notify.EventStaleThresholdCrossed, Conversation: conv}
+	case "rejection_detected":
+		conv.Status = database.StatusClosed
+		return notify.Event{Type: notify.EventRejectionDetected, Conversation: conv}
+	default: // "weekly_summary"
+		return notify.Event{
+			Type:    notify.EventWeeklySummary,
+			Message: "Active: 5 | Waiting on you: 2 | Waiting on them: 3 | Stale: 1 | Interviews scheduled: 1 | Upcoming interviews: 1 | Closed: 12",
+		}
+	}
+}
+
+func runNotifyTest(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	ruleName := args[0]
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	var rule *notify.Rule
+	for _, r := range notify.BuildRules(cfg.Notify) {
+		r := r
+		if r.Name == ruleName {
+			rule = &r
+			break
+		}
+	}
+	if rule == nil {
+		return fmt.Errorf("no [[notify.rules]] entry named %q", ruleName)
+	}
+
+	convs, err := db.Query(ctx, database.SearchCriteria{})
+	if err != nil {
+		return fmt.Errorf("failed to list conversations: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "COMPANY\tSTAGE\tDAYS_SINCE_REPLY")
+	matched := 0
+	for _, conv := range convs {
+		ruleCtx := notify.RuleContext{
+			Stage:              string(conv.Status),
+			Company:            conv.Company,
+			DaysSinceLastReply: conv.DaysSinceActivity(),
+			SenderDomain:       senderDomain(conv.RecruiterEmail),
+			Watchlist:          cfg.Notify.Watchlist,
+			Whitelist:          cfg.Filters.DomainWhitelist,
+		}
+
+		ok, err := rule.Eval(ruleCtx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		matched++
+		fmt.Fprintf(w, "%s\t%s\t%d\n", conv.Company, conv.Status, conv.DaysSinceActivity())
+	}
+	w.Flush()
+
+	if matched == 0 {
+		fmt.Println("No conversations currently match this rule.")
+	} else {
+		fmt.Printf("\n%d conversation(s) would fire %q (notifiers: %v, throttle: %s)\n",
+			matched, rule.Name, rule.Notifiers, rule.Throttle)
+	}
+
+	return nil
+}
+
+// senderDomain extracts the domain of a recruiter email address, or "" if
+// addr is nil or has no "@". Mirrors tracker.senderDomain, which isn't
+// exported since rule evaluation there runs against an already-loaded
+// *database.Conversation rather than a CLI-driven list.
+func senderDomain(addr *string) string {
+	if addr == nil {
+		return ""
+	}
+	s := *addr
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == '@' {
+			return s[i+1:]
+		}
+	}
+	return ""
+}
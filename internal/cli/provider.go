@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/gmail"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/imap"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/jmap"
+)
+
+// newEmailProvider constructs the email.Provider cfg.Provider selects, so
+// callers like runSync don't need to know which backend they end up
+// talking to - future providers (Outlook Graph) drop in here the same way.
+func newEmailProvider(cfg *config.Config) (email.Provider, error) {
+	switch cfg.Provider {
+	case "", "gmail":
+		return gmail.New(cfg.Gmail.CredentialsPath, cfg.Gmail.TokenPath), nil
+	case "imap":
+		return imap.New(cfg.IMAP.Host, cfg.IMAP.Port, cfg.IMAP.Username, cfg.IMAP.PasswordPath, cfg.IMAP.Mailbox, cfg.IMAP.UseTLS), nil
+	case "jmap":
+		return jmap.New(cfg.JMAP.SessionURL, cfg.JMAP.TokenPath), nil
+	default:
+		return nil, fmt.Errorf("unknown email provider %q (expected \"gmail\", \"imap\", or \"jmap\")", cfg.Provider)
+	}
+}
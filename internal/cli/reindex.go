@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+var reindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "Rebuild the full-text search index",
+	Long: `Drops and recreates the emails_fts/conversations_fts FTS5 tables
+that 'jobsearch search', 'jobsearch list', and search_conversations use for
+ranked keyword matches (see internal/database/fts.go), then repopulates
+them from the emails and conversations tables.
+
+Normal operation never needs this - sync keeps the index in sync
+automatically via triggers on every insert/update/delete - but a rebuild is
+useful after restoring a backup taken before FTS5 was enabled, or if the
+fts5 sqlite3 module becomes available on a binary that was previously built
+without it.`,
+	RunE: runReindex,
+}
+
+func init() {
+	rootCmd.AddCommand(reindexCmd)
+}
+
+func runReindex(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.RebuildFTS(ctx); err != nil {
+		return fmt.Errorf("reindex failed: %w", err)
+	}
+
+	fmt.Println("Full-text search index rebuilt.")
+	return nil
+}
@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+)
+
+var (
+	remindIn       string
+	remindAction   string
+	remindNote     string
+	remindWebhook  string
+	remindTemplate string
+)
+
+var remindCmd = &cobra.Command{
+	Use:   "remind <company|id>",
+	Short: "Schedule a one-off follow-up reminder against a conversation",
+	Long: `Schedule a reminder.Manager follow-up that fires in the future regardless
+of conversation status - unlike the reminder Tracker.updateAllStatuses
+auto-creates on a waiting_on_them transition, this one has no AnchorStatus,
+so it fires even if the conversation has moved on by then.
+
+Examples:
+  jobsearch remind stripe --in 3d
+  jobsearch remind stripe --in 1w --action webhook --webhook-url https://example.com/hook
+  jobsearch remind stripe --in 3d --action draft-reply --template nudge`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRemind,
+}
+
+var remindersCmd = &cobra.Command{
+	Use:   "reminders",
+	Short: "List and cancel scheduled reminders",
+}
+
+var remindersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List every pending reminder",
+	RunE:  runRemindersList,
+}
+
+var remindersCancelCmd = &cobra.Command{
+	Use:   "cancel <reminder-id>",
+	Short: "Cancel a pending reminder",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRemindersCancel,
+}
+
+func init() {
+	remindCmd.Flags().StringVar(&remindIn, "in", "", `When to fire, e.g. "3d", "1w" (required)`)
+	remindCmd.Flags().StringVar(&remindAction, "action", "desktop", "Sink to fire through: desktop, draft-reply, or webhook")
+	remindCmd.Flags().StringVar(&remindNote, "note", "", "Human-readable note shown when the reminder fires")
+	remindCmd.Flags().StringVar(&remindWebhook, "webhook-url", "", "URL to POST to for --action=webhook")
+	remindCmd.Flags().StringVar(&remindTemplate, "template", "follow_up", "Reply template to render for --action=draft-reply")
+	rootCmd.AddCommand(remindCmd)
+
+	rootCmd.AddCommand(remindersCmd)
+	remindersCmd.AddCommand(remindersListCmd)
+	remindersCmd.AddCommand(remindersCancelCmd)
+}
+
+func runRemind(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	identifier := args[0]
+
+	if remindIn == "" {
+		return fmt.Errorf("--in is required, e.g. --in 3d")
+	}
+	d, err := parseDuration(remindIn)
+	if err != nil {
+		return fmt.Errorf("invalid --in: %w", err)
+	}
+
+	action := database.ReminderAction(remindAction)
+	var params string
+	switch action {
+	case database.ReminderActionDesktop:
+		params = "{}"
+	case database.ReminderActionWebhook:
+		if remindWebhook == "" {
+			return fmt.Errorf("--webhook-url is required for --action=webhook")
+		}
+		params = fmt.Sprintf(`{"url":%q}`, remindWebhook)
+	case database.ReminderActionDraftReply:
+		params = fmt.Sprintf(`{"template":%q}`, remindTemplate)
+	default:
+		return fmt.Errorf("unknown --action %q (want desktop, draft-reply, or webhook)", remindAction)
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	conv, err := db.GetConversationByCompany(ctx, identifier)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if conv == nil {
+		conv, err = db.GetConversation(ctx, identifier)
+		if err != nil {
+			return fmt.Errorf("database error: %w", err)
+		}
+	}
+	if conv == nil {
+		return fmt.Errorf("conversation not found: %s", identifier)
+	}
+
+	r := &database.Reminder{
+		ConversationID: conv.ID,
+		FireAt:         time.Now().Add(d),
+		Action:         action,
+		Params:         params,
+		Note:           remindNote,
+	}
+	if err := db.CreateReminder(ctx, r); err != nil {
+		return fmt.Errorf("failed to create reminder: %w", err)
+	}
+
+	fmt.Printf("Reminder %s scheduled for %s (%s) on %s\n", r.ID, r.FireAt.Format("2006-01-02 15:04"), action, conv.Company)
+	return nil
+}
+
+func runRemindersList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	reminders, err := db.ListPendingReminders(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list reminders: %w", err)
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(reminders)
+	}
+
+	if len(reminders) == 0 {
+		fmt.Println("No pending reminders.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tFIRE AT\tACTION\tCONVERSATION\tNOTE")
+	for _, r := range reminders {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.ID, r.FireAt.Format("2006-01-02 15:04"), r.Action, r.ConversationID, r.Note)
+	}
+	return w.Flush()
+}
+
+func runRemindersCancel(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.CancelReminder(ctx, args[0]); err != nil {
+		return fmt.Errorf("failed to cancel reminder: %w", err)
+	}
+	fmt.Printf("Reminder %s cancelled.\n", args[0])
+	return nil
+}
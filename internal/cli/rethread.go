@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/threading"
+)
+
+var rethreadApply bool
+
+var rethreadCmd = &cobra.Command{
+	Use:   "rethread",
+	Short: "Find conversations that JWZ threading says should be merged",
+	Long: `Rethread runs the JWZ threading algorithm (the same one used during
+sync) over every email's Message-ID/In-Reply-To/References headers and
+reports groups of conversations that the reply chain says belong together,
+but that got split apart by thread-ID or company-name matching - e.g. a
+recruiter who started a fresh Gmail thread to loop in a hiring manager.
+
+By default this only previews the merges it would make. Pass --apply to
+actually perform them (oldest conversation in each group is kept as the
+merge target).
+
+Note: only emails synced since message threading was added have a
+Message-ID recorded, so older conversations may not be found until they're
+re-synced.`,
+	RunE: runRethread,
+}
+
+func init() {
+	rootCmd.AddCommand(rethreadCmd)
+	rethreadCmd.Flags().BoolVar(&rethreadApply, "apply", false, "Perform the merges instead of just previewing them")
+}
+
+// rethreadGroup is a set of conversations JWZ threading found under one
+// thread root.
+type rethreadGroup struct {
+	TargetID        string   `json:"target_id"`
+	TargetCompany   string   `json:"target_company"`
+	SourceIDs       []string `json:"source_ids"`
+	SourceCompanies []string `json:"source_companies"`
+}
+
+func runRethread(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	emails, err := db.SearchEmails(ctx, database.EmailSearchCriteria{})
+	if err != nil {
+		return fmt.Errorf("failed to load emails: %w", err)
+	}
+
+	groups, err := findRethreadGroups(ctx, db, emails)
+	if err != nil {
+		return err
+	}
+
+	if len(groups) == 0 {
+		fmt.Println("No conversations to rethread - threading already matches conversation grouping.")
+		return nil
+	}
+
+	if !rethreadApply {
+		if outputFmt == "json" {
+			return output.JSON(groups)
+		}
+		fmt.Printf("Found %d conversation group(s) that JWZ threading would merge (preview only, use --apply to perform):\n\n", len(groups))
+		for _, g := range groups {
+			fmt.Printf("  Into: %s (%s)\n", g.TargetCompany, g.TargetID)
+			for i, id := range g.SourceIDs {
+				fmt.Printf("    From: %s (%s)\n", g.SourceCompanies[i], id)
+			}
+		}
+		return nil
+	}
+
+	var merged []database.MergeResult
+	for _, g := range groups {
+		for _, sourceID := range g.SourceIDs {
+			result, err := db.MergeConversations(ctx, g.TargetID, sourceID)
+			if err != nil {
+				return fmt.Errorf("failed to merge %s into %s: %w", sourceID, g.TargetID, err)
+			}
+			merged = append(merged, *result)
+		}
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(merged)
+	}
+	fmt.Printf("Merged %d conversation(s) across %d thread(s).\n", len(merged), len(groups))
+	return nil
+}
+
+// findRethreadGroups threads emails via JWZ and, for each resulting thread
+// root, reports the set of distinct conversations its messages currently
+// belong to - the target is the conversation with the earliest message, so
+// merges fold later, wrongly-split conversations into the original one.
+func findRethreadGroups(ctx context.Context, db *database.DB, emails []database.Email) ([]rethreadGroup, error) {
+	convByMessageID := make(map[string]string, len(emails))
+	msgs := make([]threading.Message, 0, len(emails))
+
+	for _, e := range emails {
+		if e.MessageID == nil || *e.MessageID == "" {
+			continue
+		}
+		convByMessageID[*e.MessageID] = e.ConversationID
+
+		refs, err := e.GetReferences()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse references for email %s: %w", e.ID, err)
+		}
+
+		subject := ""
+		if e.Subject != nil {
+			subject = *e.Subject
+		}
+		inReplyTo := ""
+		if e.InReplyTo != nil {
+			inReplyTo = *e.InReplyTo
+		}
+
+		msgs = append(msgs, threading.Message{
+			ID:         *e.MessageID,
+			InReplyTo:  inReplyTo,
+			References: refs,
+			Subject:    subject,
+			Date:       e.Date,
+		})
+	}
+
+	roots := threading.BuildThreads(msgs)
+
+	var groups []rethreadGroup
+	for _, root := range roots {
+		convIDs := collectConversationIDs(root, convByMessageID)
+		if len(convIDs) < 2 {
+			continue
+		}
+
+		convs := make([]*database.Conversation, 0, len(convIDs))
+		for _, id := range convIDs {
+			c, err := db.GetConversation(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if c != nil {
+				convs = append(convs, c)
+			}
+		}
+		if len(convs) < 2 {
+			continue
+		}
+
+		sort.Slice(convs, func(i, j int) bool {
+			return convs[i].CreatedAt.Before(convs[j].CreatedAt)
+		})
+
+		target := convs[0]
+		group := rethreadGroup{TargetID: target.ID, TargetCompany: target.Company}
+		for _, source := range convs[1:] {
+			group.SourceIDs = append(group.SourceIDs, source.ID)
+			group.SourceCompanies = append(group.SourceCompanies, source.Company)
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// collectConversationIDs walks c and its descendants, returning the
+// distinct conversation IDs their messages currently belong to.
+func collectConversationIDs(c *threading.Container, convByMessageID map[string]string) []string {
+	seen := make(map[string]bool)
+	var walk func(*threading.Container)
+	walk = func(c *threading.Container) {
+		if c.Message != nil {
+			if convID, ok := convByMessageID[c.Message.ID]; ok {
+				seen[convID] = true
+			}
+		}
+		for _, child := range c.Children {
+			walk(child)
+		}
+	}
+	walk(c)
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -52,7 +52,7 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "",
 		"config file (default: ~/.config/jobsearch/config.toml)")
 	rootCmd.PersistentFlags().StringVarP(&outputFmt, "output", "o", "table",
-		"output format (table, json)")
+		"output format (table, json, csv, yaml, ndjson)")
 
 	// Add subcommands
 	rootCmd.AddCommand(versionCmd)
@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+)
+
+// rulesCmd is a parallel subcommand to filtersCmd, using the same
+// list/approve/reject/export UX for [[notify.rules]] entries - "approve"
+// and "reject" there toggle database.NotifyRule.Active rather than
+// promoting an ai_suggested filter, since every rule is user-defined up
+// front rather than learned.
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Manage notification rules",
+	Long: `Manage the [[notify.rules]] entries notify.RuleEngine evaluates against
+every new or changed conversation.
+
+Use subcommands to:
+  - list: View every configured rule and whether it's active
+  - approve: Re-activate a rejected rule
+  - reject: Deactivate a rule without removing it from config.toml
+  - export: Export rule definitions to add to config.toml`,
+}
+
+var rulesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all configured notification rules",
+	RunE:  runRulesList,
+}
+
+var rulesApproveCmd = &cobra.Command{
+	Use:   "approve <rule-name>",
+	Short: "Re-activate a rejected rule",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRulesApprove,
+}
+
+var rulesRejectCmd = &cobra.Command{
+	Use:   "reject <rule-name>",
+	Short: "Deactivate a rule without removing it from config.toml",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRulesReject,
+}
+
+var rulesExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export configured rules for config.toml",
+	RunE:  runRulesExport,
+}
+
+func init() {
+	filtersCmd.AddCommand(rulesCmd)
+	rulesCmd.AddCommand(rulesListCmd)
+	rulesCmd.AddCommand(rulesApproveCmd)
+	rulesCmd.AddCommand(rulesRejectCmd)
+	rulesCmd.AddCommand(rulesExportCmd)
+}
+
+// loadRuleState syncs cfg's configured rules into db (creating new rows as
+// active) and returns their runtime state keyed by name.
+func loadRuleState(ctx context.Context, cfg *config.Config, db *database.DB) (map[string]database.NotifyRule, error) {
+	for _, entry := range cfg.Notify.Rules {
+		if err := db.UpsertNotifyRule(ctx, entry.Name, entry.Condition); err != nil {
+			return nil, fmt.Errorf("failed to sync rule %q: %w", entry.Name, err)
+		}
+	}
+
+	rows, err := db.ListNotifyRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules: %w", err)
+	}
+
+	state := make(map[string]database.NotifyRule, len(rows))
+	for _, r := range rows {
+		state[r.Name] = r
+	}
+	return state, nil
+}
+
+func runRulesList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	state, err := loadRuleState(ctx, cfg, db)
+	if err != nil {
+		return err
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(state)
+	}
+
+	if len(cfg.Notify.Rules) == 0 {
+		fmt.Println("No notification rules configured. Add one under [[notify.rules]] in config.toml.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCONDITION\tNOTIFIERS\tTHROTTLE\tACTIVE\tLAST_FIRED")
+	for _, entry := range cfg.Notify.Rules {
+		lastFired := "-"
+		active := true
+		if r, ok := state[entry.Name]; ok {
+			active = r.Active
+			if r.LastFiredAt != nil {
+				lastFired = r.LastFiredAt.Format("2006-01-02 15:04")
+			}
+		}
+		notifiers := "all"
+		if len(entry.Notifiers) > 0 {
+			notifiers = strings.Join(entry.Notifiers, ",")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\n", entry.Name, entry.Condition, notifiers, entry.Throttle, active, lastFired)
+	}
+	w.Flush()
+
+	return nil
+}
+
+func runRulesApprove(cmd *cobra.Command, args []string) error {
+	return setRuleActive(cmd, args[0], true)
+}
+
+func runRulesReject(cmd *cobra.Command, args []string) error {
+	return setRuleActive(cmd, args[0], false)
+}
+
+func setRuleActive(cmd *cobra.Command, name string, active bool) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	if _, err := loadRuleState(ctx, cfg, db); err != nil {
+		return err
+	}
+
+	if err := db.SetNotifyRuleActive(ctx, name, active); err != nil {
+		return fmt.Errorf("failed to update rule: %w", err)
+	}
+
+	if active {
+		fmt.Printf("Approved rule: %s\n", name)
+	} else {
+		fmt.Printf("Rejected rule: %s (still defined in config.toml; re-run 'jobsearch filters rules approve %s' to re-enable)\n", name, name)
+	}
+
+	return nil
+}
+
+func runRulesExport(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	if len(cfg.Notify.Rules) == 0 {
+		fmt.Println("No notification rules configured yet.")
+		return nil
+	}
+
+	fmt.Println("# Add these to your config.toml [notify] section:")
+	fmt.Println()
+	for _, entry := range cfg.Notify.Rules {
+		fmt.Println("[[notify.rules]]")
+		fmt.Printf("name = %q\n", entry.Name)
+		fmt.Printf("condition = %q\n", entry.Condition)
+		if len(entry.Notifiers) > 0 {
+			fmt.Printf("notifiers = [%s]\n", quoteJoin(entry.Notifiers))
+		}
+		if entry.Throttle != "" {
+			fmt.Printf("throttle = %q\n", entry.Throttle)
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func quoteJoin(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
@@ -15,15 +17,48 @@ var searchCmd = &cobra.Command{
 	Short: "Search conversations",
 	Long: `Search across all conversations by company, recruiter, position, or email subject.
 
+The query supports a field:value mini-language (company:, domain:, from:,
+recruiter:, subject:, status:, label:, direction:, layer:, after:, before:,
+stale:, archived:) mixed with bare full-text words, combined with the
+boolean keywords AND/OR/NOT and parenthesized groups - the same parsing
+the search_conversations MCP tool uses. Quote a value that contains
+spaces, e.g. subject:"senior engineer". status: accepts a "|"-separated
+list to match any of several values; label: takes a single label.
+
+Short flags mirror aerc's search command, for terms that are easier to pass
+as a flag than as a field:value token; they're ANDed onto the parsed query.
+There's no -u/--unread (Gmail's UNREAD label isn't synced to this database)
+and no -c/--cc (Cc addresses aren't stored) - both are left unimplemented
+rather than faked.
+
 Examples:
   jobsearch search stripe
   jobsearch search "senior engineer"
-  jobsearch search recruiting`,
+  jobsearch search company:acme after:2024-01-01 waiting
+  jobsearch search "status:active OR status:waiting_on_them"
+  jobsearch search stale:>30d NOT label:interview
+  jobsearch search -f recruiter.com -t jobs+ -d 2024-01-01..2024-03-01
+  jobsearch search --save active-recruiters status:waiting_on_them`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: runSearch,
 }
 
+var searchSaveName string
+var searchSince string
+var searchFrom string
+var searchTo string
+var searchBody string
+var searchDateRange string
+
 func init() {
+	searchCmd.Flags().StringVar(&searchSaveName, "save", "", "Save this search's parsed criteria under the given name for later reuse")
+	searchCmd.Flags().StringVar(&searchSince, "since", "", "Only conversations active in the last duration, e.g. \"7d\" (shortcut for an after: term)")
+	searchCmd.Flags().StringVarP(&searchFrom, "from", "f", "", "Only conversations from this sender domain, e.g. \"recruiter.com\"")
+	searchCmd.Flags().StringVarP(&searchTo, "to", "t", "", `Only conversations addressed to this recipient (glob, e.g. "jobs+*")`)
+	searchCmd.Flags().StringVarP(&searchBody, "body", "b", "", "Only conversations whose subject contains this text (there's no indexed email body to search)")
+	searchCmd.Flags().StringVarP(&searchDateRange, "date-range", "d", "", `Only conversations active within this range, "after..before" (e.g. "2024-01-01..2024-03-01"); either side may be omitted`)
+	searchCmd.Flags().StringSlice("columns", nil,
+		`Override the column set for --format csv (e.g. --columns id,company,status)`)
 	rootCmd.AddCommand(searchCmd)
 }
 
@@ -44,8 +79,46 @@ func runSearch(cmd *cobra.Command, args []string) error {
 	}
 	defer db.Close()
 
+	crit := database.ParseQuery(query)
+
+	var extra []database.SearchCriteria
+	if searchSince != "" {
+		d, err := parseSearchSince(searchSince)
+		if err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+		since := time.Now().Add(-d)
+		extra = append(extra, database.SearchCriteria{Since: &since})
+	}
+	if searchFrom != "" {
+		extra = append(extra, database.SearchCriteria{Domain: &searchFrom})
+	}
+	if searchTo != "" {
+		extra = append(extra, database.SearchCriteria{To: &searchTo})
+	}
+	if searchBody != "" {
+		extra = append(extra, database.SearchCriteria{Subject: &searchBody})
+	}
+	if searchDateRange != "" {
+		rangeCrit, err := parseSearchDateRange(searchDateRange)
+		if err != nil {
+			return fmt.Errorf("invalid --date-range: %w", err)
+		}
+		extra = append(extra, rangeCrit)
+	}
+	if len(extra) > 0 {
+		crit = database.SearchCriteria{All: append([]database.SearchCriteria{crit}, extra...)}
+	}
+
+	if searchSaveName != "" {
+		if err := db.SaveSearch(ctx, searchSaveName, crit); err != nil {
+			return fmt.Errorf("failed to save search: %w", err)
+		}
+		fmt.Printf("Saved search %q\n", searchSaveName)
+	}
+
 	// Search
-	results, err := db.Search(ctx, query)
+	results, err := db.Query(ctx, crit)
 	if err != nil {
 		return fmt.Errorf("search failed: %w", err)
 	}
@@ -57,6 +130,50 @@ func runSearch(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Found %d conversation(s) matching: %s\n\n", len(results), query)
 
+	if columns, _ := cmd.Flags().GetStringSlice("columns"); len(columns) > 0 {
+		output.SetCSVColumns(columns)
+	}
+
 	// Output
 	return output.Output(outputFmt, results)
 }
+
+// parseSearchDateRange parses aerc's "after..before" date-range syntax
+// (either side optional), e.g. "2024-01-01..2024-03-01", "2024-01-01..",
+// or "..2024-03-01", into Since/Before bounds.
+func parseSearchDateRange(value string) (database.SearchCriteria, error) {
+	parts := strings.SplitN(value, "..", 2)
+	if len(parts) != 2 {
+		return database.SearchCriteria{}, fmt.Errorf(`expected "after..before", e.g. "2024-01-01..2024-03-01"`)
+	}
+
+	var crit database.SearchCriteria
+	if parts[0] != "" {
+		t, err := time.Parse("2006-01-02", parts[0])
+		if err != nil {
+			return crit, fmt.Errorf("invalid after date %q: %w", parts[0], err)
+		}
+		crit.Since = &t
+	}
+	if parts[1] != "" {
+		t, err := time.Parse("2006-01-02", parts[1])
+		if err != nil {
+			return crit, fmt.Errorf("invalid before date %q: %w", parts[1], err)
+		}
+		crit.Before = &t
+	}
+	return crit, nil
+}
+
+// parseSearchSince parses a suffix-duration like "7d" or "12h" for --since,
+// the same "d" extension over time.ParseDuration used by "#job snooze".
+func parseSearchSince(value string) (time.Duration, error) {
+	if days, isDays := strings.CutSuffix(value, "d"); isDays {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %s", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
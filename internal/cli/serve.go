@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/bounce"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/classifier"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/gmail"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/filter"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/inbound"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/mcp"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/reminder"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/transitions"
+)
+
+var serveListenAddr string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run the inbound-mail webhook/poll daemon alongside the MCP HTTP server",
+	Long: `Serve runs a long-lived daemon with a server-side entry point for new mail,
+instead of waiting for "jobsearch sync" to be run manually:
+
+  - POST /webhook/gmail accepts Gmail's Pub/Sub push notifications (set up
+    via "gmail.users.watch"), verifies the bearer token, and processes only
+    the messages newer than the stored historyId.
+  - A fallback poll loop re-checks the same way on [inbound] poll_interval,
+    for when no push subscription is configured or its watch has expired.
+  - Conversations that changed as a result emit events on an internal bus
+    that also drives the MCP server's notifications/conversations_changed
+    stream, so a connected assistant sees updates without re-polling tools.
+
+The MCP server shares this daemon's port: POST /rpc and GET /events behave
+exactly as they do under "jobsearch mcp --transport=http".`,
+	RunE: runServe,
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveListenAddr, "listen", "", "Address to listen on (overrides [inbound] listen)")
+	rootCmd.AddCommand(serveCmd)
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if err := cfg.EnsureDirectories(); err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	provider := gmail.New(cfg.Gmail.CredentialsPath, cfg.Gmail.TokenPath)
+	provider.SetAuthMode(cfg.Gmail.AuthMode)
+
+	fmt.Println("Authenticating with Gmail...")
+	if err := provider.Authenticate(ctx); err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	userEmail, err := provider.GetUserEmail(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+	fmt.Printf("Authenticated as: %s\n", userEmail)
+
+	f := filter.New(cfg.Filters)
+	loadLearnedFilters(ctx, db, f)
+
+	if cfgWatcher, err := config.NewWatcher(configPath); err != nil {
+		fmt.Printf("Warning: failed to watch config for changes: %v\n", err)
+	} else {
+		defer cfgWatcher.Close()
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case updated := <-cfgWatcher.Updates:
+					f.SetConfig(updated.Filters)
+					fmt.Println("Reloaded [filters] config")
+				case err := <-cfgWatcher.Errors:
+					fmt.Printf("Warning: config reload failed: %v\n", err)
+				}
+			}
+		}()
+	}
+
+	var classifierClient *classifier.Client
+	if classifierURL := cfg.ClassifierURL(); classifierURL != "" {
+		c := classifier.New(classifierURL)
+		c.SetCache(classifier.NewDBCache(db))
+		if c.IsRunning(ctx) {
+			classifierClient = c
+		}
+	}
+
+	if classifierClient != nil {
+		go runCachePruneLoop(ctx, classifierClient)
+	}
+
+	t := tracker.New(db, provider, f, classifierClient, cfg)
+
+	if transitionRules, err := transitions.BuildRules(cfg.Transitions); err != nil {
+		fmt.Printf("Warning: failed to configure status transition rules: %v\n", err)
+	} else {
+		t.SetTransitionRules(transitionRules)
+	}
+
+	if notifyRegistry, err := notify.BuildRegistry(cfg.Notify); err != nil {
+		fmt.Printf("Warning: failed to configure notifications: %v\n", err)
+	} else {
+		t.SetNotifier(notifyRegistry)
+		rules, err := notify.ActiveRules(ctx, db, cfg.Notify)
+		if err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+		t.SetRuleEngine(notify.NewRuleEngine(notifyRegistry, rules, notify.NewDBThrottleStore(db)))
+
+		if interval := cfg.Notify.SchedulerIntervalDuration(); interval > 0 {
+			scheduler := notify.NewScheduler(db, notifyRegistry, interval, cfg.Filters.DomainWhitelist)
+			go scheduler.Run(ctx)
+		}
+	}
+
+	if interval := cfg.Reminders.SweepIntervalDuration(); interval > 0 {
+		reminders := reminder.NewManager(db, interval)
+		reminders.RegisterSink(database.ReminderActionWebhook, reminder.NewWebhookSink())
+		if replySender, ok := interface{}(provider).(email.ReplySender); ok {
+			reminders.RegisterSink(database.ReminderActionDraftReply, reminder.NewDraftReplySink(db, cfg.Templates, replySender))
+		}
+		go reminders.Run(ctx)
+	}
+
+	bus := inbound.NewBus()
+	reg := inbound.NewRegistry()
+	reg.Register(inbound.NewGmailHandler(userEmail, db, provider, t, bus))
+
+	poller := inbound.NewPoller(reg, cfg.Inbound.PollDuration())
+	go poller.Run(ctx)
+
+	mcpServer := mcp.New(db, cfg)
+	mcpServer.SetBus(bus)
+	mcpServer.RunBackground(ctx)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/webhook/gmail", inbound.WebhookHandler(reg, cfg.Inbound.WebhookToken))
+	mux.Handle("/rpc", mcpServer.Handler())
+	mux.Handle("/events", mcpServer.Handler())
+
+	if cfg.Bounces.Enabled {
+		applyBounce := func(report *bounce.Report) error {
+			return t.ApplyWebhookBounce(ctx, report, time.Now())
+		}
+		mux.HandleFunc("/webhook/bounces/ses", bounce.WebhookHandler(cfg.Bounces.WebhookToken, bounce.ParseSES, applyBounce))
+		mux.HandleFunc("/webhook/bounces/sendgrid", bounce.WebhookHandler(cfg.Bounces.WebhookToken, bounce.ParseSendGrid, applyBounce))
+	}
+
+	addr := cfg.Inbound.Listen
+	if serveListenAddr != "" {
+		addr = serveListenAddr
+	}
+
+	httpServer := &http.Server{Addr: addr, Handler: mux}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	routes := "POST /webhook/gmail, POST /rpc, GET /events"
+	if cfg.Bounces.Enabled {
+		routes += ", POST /webhook/bounces/ses, POST /webhook/bounces/sendgrid"
+	}
+	fmt.Printf("Serving inbound webhook + MCP on %s (%s)\n", addr, routes)
+
+	select {
+	case <-ctx.Done():
+		return httpServer.Shutdown(context.Background())
+	case err := <-errCh:
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	}
+}
+
+// cachePruneInterval is how often runCachePruneLoop sweeps expired
+// classification cache entries while serve is running.
+const cachePruneInterval = 6 * time.Hour
+
+// runCachePruneLoop prunes c's expired classification cache entries once
+// per cachePruneInterval until ctx is canceled, the same loop shape as
+// notify.Scheduler.Run.
+func runCachePruneLoop(ctx context.Context, c *classifier.Client) {
+	ticker := time.NewTicker(cachePruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if n, err := c.PruneCache(ctx); err != nil {
+				fmt.Printf("Warning: classification cache prune failed: %v\n", err)
+			} else if n > 0 {
+				fmt.Printf("Pruned %d expired classification cache entries\n", n)
+			}
+		}
+	}
+}
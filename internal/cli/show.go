@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/exec"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/gmail"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
 )
 
@@ -22,12 +27,16 @@ The identifier can be:
 
 Examples:
   jobsearch show stripe
-  jobsearch show "Google Cloud"`,
+  jobsearch show "Google Cloud"
+  jobsearch show stripe --reply   # draft a reply to the latest email in $EDITOR and send it`,
 	Args: cobra.ExactArgs(1),
 	RunE: runShow,
 }
 
+var showReply bool
+
 func init() {
+	showCmd.Flags().BoolVar(&showReply, "reply", false, "Open $EDITOR to draft a reply to the latest email and send it")
 	rootCmd.AddCommand(showCmd)
 }
 
@@ -91,6 +100,10 @@ func runShow(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if showReply {
+		return runShowReply(ctx, cfg, conv, emails)
+	}
+
 	// Output
 	if outputFmt == "json" {
 		data := struct {
@@ -105,3 +118,111 @@ func runShow(cmd *cobra.Command, args []string) error {
 
 	return output.ConversationWithEmails(os.Stdout, conv, emails, userEmail)
 }
+
+// runShowReply opens $EDITOR on a blank draft, then sends it as a reply to
+// the conversation's latest email via the configured provider's
+// email.ReplySender, threaded with In-Reply-To/References like
+// tui.model.openInEditor does for reading - but here the edited file
+// becomes the outgoing message.
+func runShowReply(ctx context.Context, cfg *config.Config, conv *database.Conversation, emails []database.Email) error {
+	if len(emails) == 0 {
+		return fmt.Errorf("conversation has no emails to reply to")
+	}
+	latest := emails[len(emails)-1]
+
+	body, err := draftReplyInEditor(latest)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("reply aborted: draft was empty")
+	}
+
+	provider, err := newEmailProvider(cfg)
+	if err != nil {
+		return err
+	}
+	if gmailProvider, ok := provider.(*gmail.Provider); ok {
+		gmailProvider.SetAuthMode(cfg.Gmail.AuthMode)
+	}
+	if err := provider.Authenticate(ctx); err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	replySender, ok := provider.(email.ReplySender)
+	if !ok {
+		return fmt.Errorf("%s provider does not support sending replies", provider.Name())
+	}
+	return replySender.SendReply(ctx, conv.ID, body, emailFromDB(latest))
+}
+
+// draftReplyInEditor writes a quoted-reply template to a temp .eml file,
+// opens $EDITOR on it (falling back to vi), and returns the edited body
+// with the quoted header line and original text stripped back out.
+func draftReplyInEditor(latest database.Email) (string, error) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	f, err := os.CreateTemp("", "jobsearch-reply-*.eml")
+	if err != nil {
+		return "", fmt.Errorf("failed to create draft file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	fmt.Fprintf(f, "\n\nOn %s, %s wrote:\n", latest.Date.Format("Jan 2, 2006"), latest.FromAddress)
+	if latest.Snippet != nil {
+		for _, line := range strings.Split(*latest.Snippet, "\n") {
+			fmt.Fprintf(f, "> %s\n", line)
+		}
+	}
+	f.Close()
+
+	cmd := exec.Command(editor, f.Name())
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("editor exited with error: %w", err)
+	}
+
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read draft: %w", err)
+	}
+
+	before, _, _ := strings.Cut(string(data), "\nOn ")
+	return strings.TrimSpace(before), nil
+}
+
+// emailFromDB rebuilds just enough of an email.Email from a stored
+// database.Email for email.ReplySender.SendReply's threading headers.
+func emailFromDB(e database.Email) email.Email {
+	headers := make(map[string]string)
+	if e.MessageID != nil {
+		headers["Message-ID"] = "<" + *e.MessageID + ">"
+	}
+	if refs, err := e.GetReferences(); err == nil && len(refs) > 0 {
+		wrapped := make([]string, len(refs))
+		for i, r := range refs {
+			wrapped[i] = "<" + r + ">"
+		}
+		headers["References"] = strings.Join(wrapped, " ")
+	}
+
+	subject := ""
+	if e.Subject != nil {
+		subject = *e.Subject
+	}
+	fromName := ""
+	if e.FromName != nil {
+		fromName = *e.FromName
+	}
+
+	return email.Email{
+		ID:       e.GmailID,
+		ThreadID: e.ThreadID,
+		Subject:  subject,
+		From:     email.Address{Name: fromName, Email: e.FromAddress},
+		Headers:  headers,
+	}
+}
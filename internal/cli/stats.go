@@ -22,7 +22,10 @@ var statsCmd = &cobra.Command{
 Examples:
   jobsearch stats             # Overall stats
   jobsearch stats --since=7d  # Stats for last 7 days
-  jobsearch stats --detailed  # Detailed breakdown with charts`,
+  jobsearch stats --detailed  # Detailed breakdown with charts
+  jobsearch stats --funnel                      # Pipeline funnel (outreach -> ... -> offer/rejected)
+  jobsearch stats --funnel --compare-to=7d       # Funnel with week-over-week deltas
+  jobsearch stats --classification --eval        # Precision/recall/F1 against labels set via 'jobsearch label'`,
 	RunE: runStats,
 }
 
@@ -30,6 +33,9 @@ var (
 	statsSince          string
 	statsDetailed       bool
 	statsClassification bool
+	statsEval           bool
+	statsFunnel         bool
+	statsCompareTo      string
 )
 
 func init() {
@@ -37,6 +43,9 @@ func init() {
 	statsCmd.Flags().StringVar(&statsSince, "since", "", "Time period (e.g., 7d, 2w, 1m)")
 	statsCmd.Flags().BoolVar(&statsDetailed, "detailed", false, "Show detailed statistics with breakdowns")
 	statsCmd.Flags().BoolVar(&statsClassification, "classification", false, "Show classification quality metrics")
+	statsCmd.Flags().BoolVar(&statsEval, "eval", false, "With --classification, also score predictions against labels set via 'jobsearch label'")
+	statsCmd.Flags().BoolVar(&statsFunnel, "funnel", false, "Show the recruiting pipeline funnel (outreach, screen, onsite, offer, ...)")
+	statsCmd.Flags().StringVar(&statsCompareTo, "compare-to", "", "Also compute --funnel for the preceding window of this length (e.g. 7d) and show deltas")
 }
 
 func runStats(cmd *cobra.Command, args []string) error {
@@ -72,16 +81,47 @@ func runStats(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get stats: %w", err)
 	}
 
-	if !statsDetailed && !statsClassification {
+	if !statsDetailed && !statsClassification && !statsFunnel {
 		return output.Output(outputFmt, stats)
 	}
 
+	// Handle funnel-only mode
+	if statsFunnel && !statsDetailed {
+		funnel, prevFunnel, err := getFunnelStats(ctx, db, cfg, since)
+		if err != nil {
+			return fmt.Errorf("failed to get funnel stats: %w", err)
+		}
+
+		if outputFmt == "json" {
+			return output.JSON(struct {
+				Basic                  *database.Stats `json:"basic"`
+				PipelineFunnel         []StageStat     `json:"pipeline_funnel"`
+				PreviousPipelineFunnel []StageStat     `json:"previous_pipeline_funnel,omitempty"`
+			}{Basic: stats, PipelineFunnel: funnel, PreviousPipelineFunnel: prevFunnel})
+		}
+
+		fmt.Println("Job Search Statistics")
+		fmt.Println(strings.Repeat("=", 50))
+		fmt.Println()
+		fmt.Printf("  Total Conversations: %d\n", stats.TotalConversations)
+		fmt.Printf("  Total Emails:        %d\n", stats.TotalEmails)
+		fmt.Println()
+		printFunnel(funnel, prevFunnel)
+		return nil
+	}
+
 	// Handle classification-only mode
 	if statsClassification && !statsDetailed {
 		classificationMetrics, err := getClassificationMetrics(ctx, db, since)
 		if err != nil {
 			return fmt.Errorf("failed to get classification metrics: %w", err)
 		}
+		if statsEval {
+			classificationMetrics.Eval, err = getClassificationEval(ctx, db, since)
+			if err != nil {
+				return fmt.Errorf("failed to get classification eval: %w", err)
+			}
+		}
 
 		if outputFmt == "json" {
 			return output.JSON(classificationMetrics)
@@ -105,6 +145,13 @@ func runStats(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get detailed stats: %w", err)
 	}
 
+	if statsFunnel {
+		detailed.PipelineFunnel, detailed.PreviousPipelineFunnel, err = getFunnelStats(ctx, db, cfg, since)
+		if err != nil {
+			return fmt.Errorf("failed to get funnel stats: %w", err)
+		}
+	}
+
 	if outputFmt == "json" {
 		return output.JSON(detailed)
 	}
@@ -118,6 +165,12 @@ func runStats(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to get classification metrics: %w", err)
 		}
+		if statsEval {
+			classificationMetrics.Eval, err = getClassificationEval(ctx, db, since)
+			if err != nil {
+				return fmt.Errorf("failed to get classification eval: %w", err)
+			}
+		}
 		printClassificationMetrics(classificationMetrics)
 	}
 
@@ -126,11 +179,47 @@ func runStats(cmd *cobra.Command, args []string) error {
 
 // DetailedStats contains extended statistics
 type DetailedStats struct {
-	Basic           *database.Stats `json:"basic"`
-	ByStatus        map[string]int  `json:"by_status"`
-	ByCompany       []CompanyStat   `json:"by_company"`
-	RecentActivity  []ActivityStat  `json:"recent_activity"`
-	ResponseMetrics ResponseMetrics `json:"response_metrics"`
+	Basic                  *database.Stats `json:"basic"`
+	ByStatus               map[string]int  `json:"by_status"`
+	ByCompany              []CompanyStat   `json:"by_company"`
+	RecentActivity         []ActivityStat  `json:"recent_activity"`
+	ResponseMetrics        ResponseMetrics `json:"response_metrics"`
+	PipelineFunnel         []StageStat     `json:"pipeline_funnel,omitempty"`
+	PreviousPipelineFunnel []StageStat     `json:"previous_pipeline_funnel,omitempty"`
+}
+
+// getFunnelStats computes the pipeline funnel for the --since window (or
+// the last 30 days if none was given), and, if compareTo is set via
+// --compare-to, the same aggregation for the preceding window.
+func getFunnelStats(ctx context.Context, db *database.DB, cfg *config.Config, since *time.Time) (funnel, prevFunnel []StageStat, err error) {
+	curSince, prevSince, prevBefore, err := funnelWindow(since, statsCompareTo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	convs, err := funnelConversations(ctx, db, &curSince, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	funnel, err = buildFunnel(ctx, db, convs, cfg.Funnel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if prevSince == nil {
+		return funnel, nil, nil
+	}
+
+	prevConvs, err := funnelConversations(ctx, db, prevSince, prevBefore)
+	if err != nil {
+		return nil, nil, err
+	}
+	prevFunnel, err = buildFunnel(ctx, db, prevConvs, cfg.Funnel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return funnel, prevFunnel, nil
 }
 
 // CompanyStat shows statistics per company
@@ -299,6 +388,11 @@ func printDetailedStats(d *DetailedStats) {
 	} else {
 		fmt.Println("  No activity in the last 14 days")
 	}
+	fmt.Println()
+
+	if len(d.PipelineFunnel) > 0 {
+		printFunnel(d.PipelineFunnel, d.PreviousPipelineFunnel)
+	}
 }
 
 func statusToIcon(status string) string {
@@ -325,14 +419,22 @@ func truncate(s string, max int) string {
 
 // ClassificationMetricsReport contains classification quality metrics
 type ClassificationMetricsReport struct {
-	DailyMetrics       []database.ClassificationMetrics `json:"daily_metrics"`
-	TotalProcessed     int                              `json:"total_processed"`
-	TotalAutoIncluded  int                              `json:"total_auto_included"`
-	TotalValidated     int                              `json:"total_validated"`
-	TotalExcluded      int                              `json:"total_excluded"`
-	TotalFalsePositive int                              `json:"total_false_positives"`
-	AccuracyRate       float64                          `json:"accuracy_rate_percent"`
-	LearnedDomains     []string                         `json:"learned_domains"`
+	DailyMetrics      []database.ClassificationMetrics `json:"daily_metrics"`
+	TotalProcessed    int                              `json:"total_processed"`
+	TotalAutoIncluded int                              `json:"total_auto_included"`
+	TotalValidated    int                              `json:"total_validated"`
+	TotalExcluded     int                              `json:"total_excluded"`
+
+	// TotalFalsePositive/AccuracyRate are a rough proxy derived from how
+	// often the same day's FalsePositivesMarked count shows up next to
+	// validated emails. Deprecated: --eval computes a real precision/
+	// recall/F1 report from labeled emails (see ClassificationEval) and
+	// should be preferred over AccuracyRate.
+	TotalFalsePositive int     `json:"total_false_positives"`
+	AccuracyRate       float64 `json:"accuracy_rate_percent"`
+
+	LearnedDomains []string                     `json:"learned_domains"`
+	Eval           *database.ClassificationEval `json:"eval,omitempty"`
 }
 
 func getClassificationMetrics(ctx context.Context, db *database.DB, since *time.Time) (*ClassificationMetricsReport, error) {
@@ -377,6 +479,17 @@ func getClassificationMetrics(ctx context.Context, db *database.DB, since *time.
 	return report, nil
 }
 
+// getClassificationEval scores the pipeline's predictions against gold
+// labels set via "jobsearch label", defaulting to the same 30-day window
+// getClassificationMetrics uses when since is nil.
+func getClassificationEval(ctx context.Context, db *database.DB, since *time.Time) (*database.ClassificationEval, error) {
+	sinceTime := time.Now().AddDate(0, 0, -30)
+	if since != nil {
+		sinceTime = *since
+	}
+	return db.GetClassificationEval(ctx, sinceTime)
+}
+
 func printClassificationMetrics(r *ClassificationMetricsReport) {
 	fmt.Println()
 	fmt.Println("Classification Quality Metrics")
@@ -396,6 +509,19 @@ func printClassificationMetrics(r *ClassificationMetricsReport) {
 	}
 	fmt.Println()
 
+	// Eval (precision/recall/F1 against labeled emails, --eval only)
+	if r.Eval != nil {
+		fmt.Println("Evaluation Against Labels")
+		fmt.Println(strings.Repeat("-", 30))
+		fmt.Printf("  Labeled:             %d\n", r.Eval.Labeled)
+		fmt.Printf("  True Positives:      %d\n", r.Eval.TruePositives)
+		fmt.Printf("  False Positives:     %d\n", r.Eval.FalsePositives)
+		fmt.Printf("  Precision:           %.1f%%\n", r.Eval.Precision*100)
+		fmt.Printf("  Recall:              %.1f%% (no data on emails the filter discarded, so this is an upper bound)\n", r.Eval.Recall*100)
+		fmt.Printf("  F1:                  %.2f\n", r.Eval.F1)
+		fmt.Println()
+	}
+
 	// Learned domains
 	if len(r.LearnedDomains) > 0 {
 		fmt.Println("Learned Blocked Domains")
@@ -10,9 +10,13 @@ import (
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/classifier"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/gmail"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/filter"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/jobs"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/transitions"
 )
 
 var (
@@ -20,6 +24,10 @@ var (
 	syncFull       bool
 	syncNoClassify bool
 	syncBackground bool
+	syncDryRun     bool
+	syncAuthMode   string
+	syncVerbose    bool
+	syncQuery      string
 )
 
 var syncCmd = &cobra.Command{
@@ -35,7 +43,9 @@ Examples:
   jobsearch sync --days=60    # Fetch last 60 days
   jobsearch sync --full       # Full sync (ignore last sync time)
   jobsearch sync --no-classify # Skip LLM classification (faster, domain-filter only)
-  jobsearch sync --background  # Quick sync with background classification`,
+  jobsearch sync --background  # Quick sync with background classification
+  jobsearch sync --dry-run     # Show what the learner would suggest, without writing it
+  jobsearch sync --query 'from:@greenhouse.io -has:attachment' # Restrict what's fetched`,
 	RunE: runSync,
 }
 
@@ -45,6 +55,10 @@ func init() {
 	syncCmd.Flags().BoolVar(&syncFull, "full", false, "Ignore last sync time and fetch from scratch")
 	syncCmd.Flags().BoolVar(&syncNoClassify, "no-classify", false, "Skip LLM classification (faster, uses domain filtering only)")
 	syncCmd.Flags().BoolVar(&syncBackground, "background", false, "Quick sync: show results immediately, classify in background")
+	syncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "Preview learner suggestions without writing them")
+	syncCmd.Flags().StringVar(&syncAuthMode, "auth-mode", "", "OAuth flow to use for first-time auth: browser (default) or device (for headless/SSH/container use)")
+	syncCmd.Flags().BoolVar(&syncVerbose, "verbose", false, "Show why each Bayes-filtered email was excluded")
+	syncCmd.Flags().StringVar(&syncQuery, "query", "", "Aerc-style shorthand further restricting what's fetched (see email.ParseSearchShorthand)")
 }
 
 func runSync(cmd *cobra.Command, args []string) error {
@@ -68,11 +82,22 @@ func runSync(cmd *cobra.Command, args []string) error {
 	}
 	defer db.Close()
 
-	// Initialize Gmail provider
-	provider := gmail.New(cfg.Gmail.CredentialsPath, cfg.Gmail.TokenPath)
+	// Initialize the configured email provider
+	provider, err := newEmailProvider(cfg)
+	if err != nil {
+		return err
+	}
+
+	if gmailProvider, ok := provider.(*gmail.Provider); ok {
+		authMode := cfg.Gmail.AuthMode
+		if syncAuthMode != "" {
+			authMode = syncAuthMode
+		}
+		gmailProvider.SetAuthMode(authMode)
+	}
 
 	// Authenticate
-	fmt.Println("Authenticating with Gmail...")
+	fmt.Printf("Authenticating with %s...\n", provider.Name())
 	if err := provider.Authenticate(ctx); err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
@@ -93,6 +118,7 @@ func runSync(cmd *cobra.Command, args []string) error {
 	} else {
 		classifierURL := cfg.ClassifierURL()
 		classifierClient = classifier.New(classifierURL)
+		classifierClient.SetCache(classifier.NewDBCache(db))
 
 		if classifierClient.IsRunning(ctx) {
 			fmt.Printf("Classification service: connected (%s)\n", classifierURL)
@@ -106,14 +132,58 @@ func runSync(cmd *cobra.Command, args []string) error {
 	// Create tracker and run sync
 	t := tracker.New(db, provider, f, classifierClient, cfg)
 
+	if transitionRules, err := transitions.BuildRules(cfg.Transitions); err != nil {
+		fmt.Printf("Warning: failed to configure status transition rules: %v\n", err)
+	} else {
+		t.SetTransitionRules(transitionRules)
+	}
+
+	if reg, err := notify.BuildRegistry(cfg.Notify); err != nil {
+		fmt.Printf("Warning: failed to configure notifications: %v\n", err)
+	} else {
+		t.SetNotifier(reg)
+		rules, err := notify.ActiveRules(ctx, db, cfg.Notify)
+		if err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+		t.SetRuleEngine(notify.NewRuleEngine(reg, rules, notify.NewDBThrottleStore(db)))
+	}
+
 	// Build sync options
 	syncOpts := tracker.SyncOptions{
 		Days:               syncDays,
 		FullSync:           syncFull,
 		SkipClassification: syncNoClassify,
 		BackgroundClassify: syncBackground,
+		DryRun:             syncDryRun,
 	}
 
+	if syncQuery != "" {
+		extraCriteria, err := email.ParseSearchShorthand(syncQuery)
+		if err != nil {
+			return fmt.Errorf("invalid --query: %w", err)
+		}
+		syncOpts.ExtraCriteria = &extraCriteria
+	}
+
+	// Record this sync as a job before running it, so it's visible to
+	// anything polling jobsearch://jobs (e.g. an MCP server) even though
+	// the CLI executes it inline: the CLI is a one-shot process with no
+	// background worker of its own, so it enqueues a TypeSync job and then
+	// runs a single-job Pool against its own queue entry immediately,
+	// rather than leaving it queued for a daemon that isn't there.
+	queue := jobs.NewQueue(db)
+	job, err := queue.CreateJob(ctx, jobs.TypeSync, jobs.PriorityUserSync, time.Now(), syncOpts)
+	if err != nil {
+		return fmt.Errorf("failed to record sync job: %w", err)
+	}
+
+	var syncResult *tracker.SyncResult
+	pool := jobs.NewPool(queue, jobs.TypeSync, 1, func(ctx context.Context, _ *jobs.Job) error {
+		syncResult, err = t.SyncWithOptions(ctx, syncOpts)
+		return err
+	})
+
 	fmt.Println()
 	if syncDays > 0 {
 		fmt.Printf("Syncing emails (last %d days)...\n", syncDays)
@@ -205,14 +275,18 @@ func runSync(cmd *cobra.Command, args []string) error {
 		lastPhase = p.Phase
 	}
 
-	result, err := t.SyncWithOptions(ctx, syncOpts)
+	pool.RunOne(ctx)
 
 	// Clear progress line
 	terminal.ClearLine()
 
+	if syncResult == nil {
+		return fmt.Errorf("sync job %s did not run (queue contention)", job.ID)
+	}
 	if err != nil {
 		return fmt.Errorf("sync failed: %w", err)
 	}
+	result := syncResult
 
 	// Display results
 	fmt.Println()
@@ -222,9 +296,28 @@ func runSync(cmd *cobra.Command, args []string) error {
 	if result.EmailsClassified > 0 {
 		fmt.Printf("  Classified by LLM:     %d\n", result.EmailsClassified)
 	}
+	if classifierClient != nil {
+		if stats := classifierClient.GetCacheStats(ctx); stats.Hits > 0 || stats.Misses > 0 {
+			fmt.Printf("  Classification cache:  %d hit(s), %d miss(es)\n", stats.Hits, stats.Misses)
+		}
+	}
 	if result.EmailsPendingClassify > 0 {
 		fmt.Printf("  Pending classification: %d (run sync again to classify)\n", result.EmailsPendingClassify)
 	}
+	if result.EmailsBayesFiltered > 0 {
+		fmt.Printf("  Filtered by Bayes:     %d (skipped LLM)\n", result.EmailsBayesFiltered)
+		if syncVerbose {
+			for _, fe := range result.BayesExcluded {
+				fmt.Printf("    - %s: %s\n", fe.Email.From.Email, fe.Result.Reason)
+			}
+		}
+	}
+	if result.BouncesDetected > 0 {
+		fmt.Printf("  Bounces detected:      %d\n", result.BouncesDetected)
+	}
+	if result.CommandsApplied > 0 {
+		fmt.Printf("  Inbox commands applied: %d\n", result.CommandsApplied)
+	}
 	fmt.Printf("  New conversations:     %d\n", result.ConversationsNew)
 	fmt.Printf("  Updated conversations: %d\n", result.ConversationsUpdated)
 
@@ -234,6 +327,15 @@ func runSync(cmd *cobra.Command, args []string) error {
 		fmt.Println("  Run 'jobsearch sync' (without --no-classify or --background) to classify pending emails.")
 	}
 
+	if len(result.FiltersDemoted) > 0 {
+		fmt.Println()
+		fmt.Printf("Demoted %d filter(s) back to pending review (precision dropped below threshold):\n", len(result.FiltersDemoted))
+		for _, v := range result.FiltersDemoted {
+			fmt.Printf("  - %s\n", v)
+		}
+		fmt.Println("Run 'jobsearch filters review' to see them.")
+	}
+
 	if len(result.Errors) > 0 {
 		fmt.Println()
 		fmt.Printf("Warnings: %d\n", len(result.Errors))
@@ -269,6 +371,34 @@ func showPendingActions(ctx context.Context, db *database.DB) {
 	}
 	fmt.Println()
 	fmt.Println("Run 'jobsearch list --status=waiting_on_me' for details.")
+
+	showBouncedConversations(ctx, db)
+}
+
+// showBouncedConversations warns about conversations that just moved to
+// database.StatusBounced, so a user who thinks a recruiter went silent
+// learns their reply never arrived instead of waiting on a dead address.
+func showBouncedConversations(ctx context.Context, db *database.DB) {
+	status := database.StatusBounced
+	convs, err := db.ListConversations(ctx, database.ListOptions{
+		Status: &status,
+		Limit:  5,
+	})
+	if err != nil || len(convs) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Printf("Delivery failures detected (%d conversations):\n", len(convs))
+	for _, c := range convs {
+		recruiter := c.Company
+		if c.RecruiterName != nil && *c.RecruiterName != "" {
+			recruiter = *c.RecruiterName + " @ " + c.Company
+		}
+		fmt.Printf("  - %s\n", recruiter)
+	}
+	fmt.Println()
+	fmt.Println("Run 'jobsearch list --status=bounced' for details.")
 }
 
 // loadLearnedFilters loads confirmed filters from the database and adds them to the filter
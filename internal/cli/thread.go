@@ -53,12 +53,18 @@ func runThread(cmd *cobra.Command, args []string) error {
 	}
 	defer db.Close()
 
-	// Initialize Gmail provider
-	provider := gmail.New(cfg.Gmail.CredentialsPath, cfg.Gmail.TokenPath)
+	// Initialize the configured email provider
+	provider, err := newEmailProvider(cfg)
+	if err != nil {
+		return err
+	}
+	if gmailProvider, ok := provider.(*gmail.Provider); ok {
+		gmailProvider.SetAuthMode(cfg.Gmail.AuthMode)
+	}
 
 	// Check if already authenticated
 	if !provider.IsAuthenticated() {
-		fmt.Println("Authenticating with Gmail...")
+		fmt.Printf("Authenticating with %s...\n", provider.Name())
 		if err := provider.Authenticate(ctx); err != nil {
 			return fmt.Errorf("authentication failed: %w", err)
 		}
@@ -73,7 +79,23 @@ func runThread(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Fetching thread for '%s'...\n\n", identifier)
 	}
 
-	thread, err := t.FetchThread(ctx, identifier)
+	fetchOpts := tracker.FetchThreadOptions{}
+	if outputFmt != "json" {
+		terminal := NewTerminal()
+		fetchOpts.Progress = func(p tracker.Progress) {
+			if p.Phase != tracker.PhaseFetchingEmails || p.Total == 0 {
+				return
+			}
+			terminal.ClearLine()
+			fmt.Printf("Downloading: %d/%d emails (%d%%)", p.Current, p.Total, p.Percentage())
+			if p.Current == p.Total {
+				fmt.Println()
+			}
+			terminal.Flush()
+		}
+	}
+
+	thread, err := t.FetchThreadWithOptions(ctx, identifier, fetchOpts)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/classifier"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/gmail"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/filter"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tui"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Interactive terminal UI for browsing conversations",
+	Long: `Open a full-screen, three-pane terminal UI: a status-grouped
+conversation list on the left, the message thread for the selected
+conversation in the middle, and detected action items on the right.
+
+Keybindings:
+  j/k   move selection
+  /     search (full-text, same as 'jobsearch list --search')
+  a     archive the selected conversation
+  m     merge the selected conversation into another (prompts for target)
+  e     open the latest email in the thread in $EDITOR
+  s     trigger a background sync job and watch its progress
+  q     quit`,
+	RunE: runTUI,
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+}
+
+func runTUI(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	return tui.Run(ctx, db, tuiSyncFunc(cfg, db))
+}
+
+// tuiSyncFunc builds the same Gmail provider / classifier / filter /
+// tracker pipeline runSync in sync.go uses, so a sync triggered with 's'
+// in the TUI behaves like `jobsearch sync` - just reporting progress
+// through the callback instead of printing to the terminal directly.
+func tuiSyncFunc(cfg *config.Config, db *database.DB) tui.SyncFunc {
+	return func(ctx context.Context, progress func(tracker.Progress)) (*tracker.SyncResult, error) {
+		provider, err := newEmailProvider(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if gmailProvider, ok := provider.(*gmail.Provider); ok {
+			gmailProvider.SetAuthMode(cfg.Gmail.AuthMode)
+		}
+
+		if err := provider.Authenticate(ctx); err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+
+		f := filter.New(cfg.Filters)
+		loadLearnedFilters(ctx, db, f)
+
+		var classifierClient *classifier.Client
+		c := classifier.New(cfg.ClassifierURL())
+		c.SetCache(classifier.NewDBCache(db))
+		if c.IsRunning(ctx) {
+			classifierClient = c
+		}
+
+		t := tracker.New(db, provider, f, classifierClient, cfg)
+		return t.SyncWithOptions(ctx, tracker.SyncOptions{
+			Progress: progress,
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+)
+
+var unmarkSpamCmd = &cobra.Command{
+	Use:   "unmark-spam <company-or-id>",
+	Short: "Reverse a mistaken mark-spam",
+	Long: `Reverse a conversation previously marked by 'jobsearch mark-spam'.
+
+This command:
+1. Trains the Bayes classifier on this conversation's first email as good
+2. Reopens the conversation
+3. Unarchives the conversation
+
+It does not remove the domain from the learned blacklist - if the domain
+itself should stop being auto-excluded, demote or delete its filter with
+'jobsearch filters' instead.
+
+Arguments can be:
+  - Company name (case-insensitive, partial match)
+  - Conversation ID
+
+Examples:
+  jobsearch unmark-spam "Walmart"
+  jobsearch unmark-spam abc123`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUnmarkSpam,
+}
+
+// UnmarkSpamResult contains the result of reversing a mark-spam.
+type UnmarkSpamResult struct {
+	ConversationID string `json:"conversation_id"`
+	Company        string `json:"company"`
+	Unarchived     bool   `json:"unarchived"`
+}
+
+func init() {
+	rootCmd.AddCommand(unmarkSpamCmd)
+}
+
+func runUnmarkSpam(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	identifier := args[0]
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	conv, err := findConversationIncludingArchived(ctx, db, identifier)
+	if err != nil {
+		return fmt.Errorf("failed to find conversation: %w", err)
+	}
+	if conv == nil {
+		return fmt.Errorf("conversation not found: %s", identifier)
+	}
+
+	t := tracker.New(db, nil, nil, nil, cfg)
+	if err := t.LearnConversation(ctx, conv.ID, database.BayesClassGood); err != nil {
+		return fmt.Errorf("failed to train classifier: %w", err)
+	}
+
+	conv.Status = database.StatusActive
+	if err := db.UpdateConversation(ctx, conv); err != nil {
+		return fmt.Errorf("failed to reopen conversation: %w", err)
+	}
+
+	if _, err := db.UnarchiveConversation(ctx, conv.ID); err != nil {
+		return fmt.Errorf("failed to unarchive conversation: %w", err)
+	}
+
+	result := &UnmarkSpamResult{
+		ConversationID: conv.ID,
+		Company:        conv.Company,
+		Unarchived:     true,
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(result)
+	}
+
+	fmt.Printf("Unmarked as spam: %s\n", result.Company)
+	fmt.Println("  Bayes classifier trained as good")
+	fmt.Println("  Conversation reopened and unarchived")
+	return nil
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/output"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+)
+
+var unsubscribeCmd = &cobra.Command{
+	Use:   "unsubscribe <company-or-id>",
+	Short: "Opt a recruiter out of future contact",
+	Long: `Opt the recruiter behind a conversation out of future contact.
+
+This command:
+1. Records the recruiter's address so future emails from them are
+   excluded regardless of classification, starting with the next sync
+2. Clears any digest events already queued for the conversation
+3. Closes the conversation
+
+Arguments can be:
+  - Company name (case-insensitive, partial match)
+  - Conversation ID
+
+Examples:
+  jobsearch unsubscribe "Acme Recruiting"
+  jobsearch unsubscribe abc123`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUnsubscribe,
+}
+
+// UnsubscribeResult contains the result of opting a recruiter out
+type UnsubscribeResult struct {
+	ConversationID string `json:"conversation_id"`
+	Company        string `json:"company"`
+	RecruiterEmail string `json:"recruiter_email"`
+}
+
+func init() {
+	rootCmd.AddCommand(unsubscribeCmd)
+}
+
+func runUnsubscribe(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	identifier := args[0]
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	conv, err := findConversationIncludingArchived(ctx, db, identifier)
+	if err != nil {
+		return fmt.Errorf("failed to find conversation: %w", err)
+	}
+	if conv == nil {
+		return fmt.Errorf("conversation not found: %s", identifier)
+	}
+
+	t := tracker.New(db, nil, nil, nil, cfg)
+	if err := t.OptOutRecruiter(ctx, conv.ID); err != nil {
+		return fmt.Errorf("failed to opt out recruiter: %w", err)
+	}
+
+	result := &UnsubscribeResult{
+		ConversationID: conv.ID,
+		Company:        conv.Company,
+	}
+	if conv.RecruiterEmail != nil {
+		result.RecruiterEmail = *conv.RecruiterEmail
+	}
+
+	if outputFmt == "json" {
+		return output.JSON(result)
+	}
+
+	fmt.Printf("Opted out %s (%s).\n", result.RecruiterEmail, result.Company)
+	fmt.Println("Conversation closed; future emails from this address will be excluded.")
+	return nil
+}
@@ -0,0 +1,59 @@
+// Package concurrency provides small bounded-parallelism helpers for fanning
+// slow per-item calls (e.g. provider round-trips) out across a worker pool
+// instead of awaiting them one at a time.
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ForEachJob calls fn(ctx, i) for every i in [0, n), using at most workers
+// goroutines at a time (workers <= 0 or workers > n runs all n jobs at
+// once). It blocks until every dispatched job has returned, then joins
+// their errors with errors.Join (nil if none failed). The first error
+// cancels the context passed to not-yet-started jobs so dispatch stops
+// early, modeled on dskit's concurrency.ForEachJob.
+func ForEachJob(ctx context.Context, n, workers int, fn func(ctx context.Context, i int) error) error {
+	if n == 0 {
+		return nil
+	}
+	if workers <= 0 || workers > n {
+		workers = n
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan int)
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := fn(jobCtx, i); err != nil {
+					errs[i] = err
+					cancel()
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		select {
+		case jobs <- i:
+		case <-jobCtx.Done():
+			close(jobs)
+			wg.Wait()
+			return errors.Join(errs...)
+		}
+	}
+	close(jobs)
+
+	wg.Wait()
+	return errors.Join(errs...)
+}
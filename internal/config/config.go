@@ -1,17 +1,39 @@
 package config
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Config represents the application configuration
 type Config struct {
-	Gmail      GmailConfig      `toml:"gmail"`
-	Database   DatabaseConfig   `toml:"database"`
-	LLM        LLMConfig        `toml:"llm"`
-	Classifier ClassifierConfig `toml:"classifier"`
-	Filters    FilterConfig     `toml:"filters"`
-	Tracking   TrackingConfig   `toml:"tracking"`
-	Privacy    PrivacyConfig    `toml:"privacy"`
-	MCP        MCPConfig        `toml:"mcp"`
+	// Provider selects which email.Provider "jobsearch sync" authenticates
+	// against: "gmail" (default) or "imap". JMAP has no factory wiring yet
+	// and is constructed directly where it's already used.
+	Provider    string            `toml:"provider"`
+	Gmail       GmailConfig       `toml:"gmail"`
+	IMAP        IMAPConfig        `toml:"imap"`
+	Database    DatabaseConfig    `toml:"database"`
+	LLM         LLMConfig         `toml:"llm"`
+	Classifier  ClassifierConfig  `toml:"classifier"`
+	Filters     FilterConfig      `toml:"filters"`
+	Transitions TransitionsConfig `toml:"transitions"`
+	Learning    LearningConfig    `toml:"learning"`
+	Tracking    TrackingConfig    `toml:"tracking"`
+	Sync        SyncConfig        `toml:"sync"`
+	Privacy     PrivacyConfig     `toml:"privacy"`
+	MCP         MCPConfig         `toml:"mcp"`
+	Reply       ReplyConfig       `toml:"reply"`
+	Digest      DigestConfig      `toml:"digest"`
+	Notify      NotifyConfig      `toml:"notify"`
+	Funnel      FunnelConfig      `toml:"funnel"`
+	Inbound     InboundConfig     `toml:"inbound"`
+	JMAP        JMAPConfig        `toml:"jmap"`
+	Templates   TemplatesConfig   `toml:"templates"`
+	Bounces     BouncesConfig     `toml:"bounces"`
+	Reminders   RemindersConfig   `toml:"reminders"`
 }
 
 // GmailConfig contains Gmail-specific settings
@@ -19,6 +41,40 @@ type GmailConfig struct {
 	CredentialsPath string `toml:"credentials_path"`
 	TokenPath       string `toml:"token_path"`
 	MaxResults      int    `toml:"max_results"`
+	// AuthMode selects how the initial OAuth token is obtained: "browser"
+	// (default) runs the local-server redirect flow, "device" uses Google's
+	// device authorization flow for headless/SSH/container use.
+	AuthMode string `toml:"auth_mode"`
+}
+
+// JMAPConfig contains settings for the jmap.Provider, an alternative to
+// Gmail for JMAP-native accounts (Fastmail, Stalwart) or corporate
+// IMAP-via-JMAP proxies.
+type JMAPConfig struct {
+	// SessionURL is the server's JMAP session endpoint, e.g.
+	// "https://api.fastmail.com/jmap/session".
+	SessionURL string `toml:"session_url"`
+	// TokenPath is a file holding a server-issued API token.
+	TokenPath string `toml:"token_path"`
+}
+
+// IMAPConfig contains settings for the imap.Provider, a second alternative
+// to Gmail for any IMAP4rev1 server (Fastmail, mox, Proton Bridge,
+// self-hosted/corporate mailboxes).
+type IMAPConfig struct {
+	Host string `toml:"host"`
+	Port int    `toml:"port"`
+	// UseTLS selects implicit TLS (the common port-993 setup) over plain
+	// IMAP; there's no STARTTLS support, matching how GmailConfig has no
+	// plaintext fallback either.
+	UseTLS   bool   `toml:"use_tls"`
+	Username string `toml:"username"`
+	// PasswordPath is a file holding the account password (or an
+	// app-specific password), mirroring GmailConfig.TokenPath/
+	// JMAPConfig.TokenPath.
+	PasswordPath string `toml:"password_path"`
+	// Mailbox is the folder synced, e.g. "INBOX".
+	Mailbox string `toml:"mailbox"`
 }
 
 // DatabaseConfig contains database settings
@@ -48,13 +104,29 @@ type OpenAIConfig struct {
 
 // ClassifierConfig contains classification service settings
 type ClassifierConfig struct {
-	Host string `toml:"host"`
-	Port int    `toml:"port"`
+	Host    string      `toml:"host"`
+	Port    int         `toml:"port"`
+	Backend string      `toml:"backend"` // "chain", "llm", or "bayes"
+	Bayes   BayesConfig `toml:"bayes"`
 }
 
-// URL returns the full classifier service URL
+// URL returns the full classifier service URL. Host may or may not
+// include a scheme ("localhost" and "http://localhost" both work); a
+// missing one defaults to "http://".
 func (c ClassifierConfig) URL() string {
-	return c.Host + ":" + string(rune(c.Port))
+	host := c.Host
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+	return host + ":" + strconv.Itoa(c.Port)
+}
+
+// BayesConfig controls the pure-Go Bayesian classifier backend, used alone
+// (backend = "bayes") or as a pre-filter ahead of the LLM (backend = "chain")
+type BayesConfig struct {
+	LowCutoff  float64 `toml:"low_cutoff"`  // below this score, drop as junk without consulting the LLM
+	HighCutoff float64 `toml:"high_cutoff"` // above this score, accept without consulting the LLM
+	DBPath     string  `toml:"db_path"`     // database holding the trained bayes_tokens table
 }
 
 // FilterConfig contains email filtering rules
@@ -66,9 +138,73 @@ type FilterConfig struct {
 	BodyKeywords     []string `toml:"body_keywords"`
 }
 
+// TransitionsConfig declares content-based conversation status
+// transitions: each rule is a regular expression matched against an
+// inbound email's subject and snippet, and Tracker moves the conversation
+// to the highest-confidence matching rule's Status (see
+// internal/transitions.Evaluate). It lives alongside FilterConfig since
+// both are user-tunable pattern lists that steer the tracker without a
+// code change - FilterConfig's BodyKeywords answers "is this email
+// job-related at all", this answers "what did the reply say".
+type TransitionsConfig struct {
+	Rules []TransitionRule `toml:"rules"`
+}
+
+// TransitionRule matches Pattern - a case-insensitive regular expression -
+// against an inbound email's subject and snippet. Status must name one of
+// database's existing ConversationStatus values; this system doesn't
+// introduce new statuses like "rejected" or "ghosted", since the tracker
+// already has StatusClosed and StatusStale covering those cases.
+type TransitionRule struct {
+	Name       string  `toml:"name"`
+	Pattern    string  `toml:"pattern"`
+	Status     string  `toml:"status"`
+	Confidence float64 `toml:"confidence"`
+}
+
+// LearningConfig controls when a staged (ai_suggested) learned filter is
+// automatically promoted to ai_confirmed, and when a confirmed one is
+// demoted back after its precision drops.
+type LearningConfig struct {
+	MinSupport           int     `toml:"min_support"`
+	MaxFalsePositiveRate float64 `toml:"max_false_positive_rate"`
+	// AutoApproveThreshold is the minimum confidence*precision score a
+	// staged filter must clear to be promoted, on top of MinSupport and
+	// MaxFalsePositiveRate. Filters with no recorded Confidence are treated
+	// as failing this gate, since there's no signal to multiply against.
+	AutoApproveThreshold float64 `toml:"auto_approve_threshold"`
+	// DemoteBelowPrecision is the floor a confirmed filter's precision
+	// (LearnedFilter.Precision) can fall to before a review pass demotes
+	// it back to ai_suggested.
+	DemoteBelowPrecision float64 `toml:"demote_below_precision"`
+	// CleanRunRequired is how many consecutive supporting emails since the
+	// last false positive (LearnedFilter.SupportSinceFP) a staged filter
+	// needs before promotion, independent of its all-time SupportCount.
+	CleanRunRequired int `toml:"clean_run_required"`
+	// BayesAutoFilterThreshold is the P(spam) score (1 - BayesClassifier
+	// score) above which sync auto-excludes an uncertain email without
+	// spending an LLM call. Scores below this but still above 0.5 are sent
+	// to the LLM as usual, with the score surfaced for review.
+	BayesAutoFilterThreshold float64 `toml:"bayes_auto_filter_threshold"`
+}
+
 // TrackingConfig contains conversation tracking settings
 type TrackingConfig struct {
 	StaleAfterDays int `toml:"stale_after_days"`
+	// FollowUpSLADays is how many days after a conversation's last activity
+	// a "waiting_on_me" follow-up is due, used by "jobsearch export
+	// --format=ics" to compute each reminder's DTSTART.
+	FollowUpSLADays int `toml:"follow_up_sla_days"`
+	// HardBounceLimit is how many hard bounces to the same recruiter
+	// address Tracker.HandleBounce tolerates before marking the
+	// conversation database.StatusBounced and giving up on follow-ups.
+	HardBounceLimit int `toml:"hard_bounce_limit"`
+	// Timezone is the IANA zone name (e.g. "America/New_York") used to
+	// interpret a calendar invite's floating times - RFC 5545 allows a
+	// VEVENT to specify a time with no UTC offset or TZID at all, which is
+	// meant to be read in whatever zone the reader considers "local".
+	// Empty means the machine's local zone.
+	Timezone string `toml:"timezone"`
 }
 
 // StaleDuration returns the stale threshold as a duration
@@ -76,6 +212,32 @@ func (t TrackingConfig) StaleDuration() time.Duration {
 	return time.Duration(t.StaleAfterDays) * 24 * time.Hour
 }
 
+// Location resolves Timezone to a *time.Location, falling back to the
+// machine's local zone if Timezone is unset or unrecognized.
+func (t TrackingConfig) Location() *time.Location {
+	if t.Timezone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(t.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// SyncConfig contains settings for fetching mail from the provider
+type SyncConfig struct {
+	// FetchConcurrency caps how many provider.GetEmail calls
+	// tracker.FetchThread dispatches in parallel. Keep this modest - it's
+	// capped to avoid tripping Gmail's per-user QPS limits.
+	FetchConcurrency int `toml:"fetch_concurrency"`
+}
+
+// FollowUpSLA returns the follow-up SLA as a duration.
+func (t TrackingConfig) FollowUpSLA() time.Duration {
+	return time.Duration(t.FollowUpSLADays) * 24 * time.Hour
+}
+
 // PrivacyConfig contains privacy-related settings
 type PrivacyConfig struct {
 	StoreEmailBody    bool   `toml:"store_email_body"`
@@ -85,16 +247,248 @@ type PrivacyConfig struct {
 // MCPConfig contains MCP server settings
 type MCPConfig struct {
 	Enabled   bool   `toml:"enabled"`
-	Transport string `toml:"transport"`
+	Transport string `toml:"transport"` // "stdio" (default) or "http"
+	// Listen is the address the HTTP transport binds to (e.g. "localhost:8643").
+	// If empty, it falls back to "[classifier] host:port" with the port
+	// incremented by one, so it doesn't collide with the classifier service.
+	Listen string `toml:"listen"`
+}
+
+// InboundConfig controls "jobsearch serve", the webhook/poll subsystem that
+// ingests new mail without waiting for a manual "jobsearch sync".
+type InboundConfig struct {
+	// Listen is the address the webhook/poll HTTP server binds to.
+	Listen string `toml:"listen"`
+	// WebhookToken is the bearer token Pub/Sub's push subscription is
+	// configured to send; requests without it are rejected. Leave empty
+	// only behind a private network with no public Pub/Sub push.
+	WebhookToken string `toml:"webhook_token"`
+	// PollInterval is how often the fallback poll loop re-checks each
+	// registered address, as a Go duration string (e.g. "2m").
+	PollInterval string `toml:"poll_interval"`
+}
+
+// PollDuration returns PollInterval parsed as a time.Duration, falling
+// back to 2 minutes if it's unset or invalid.
+func (i InboundConfig) PollDuration() time.Duration {
+	if d, err := time.ParseDuration(i.PollInterval); err == nil && d > 0 {
+		return d
+	}
+	return 2 * time.Minute
+}
+
+// BouncesConfig controls "jobsearch serve"'s SES/SendGrid bounce webhook
+// endpoints, the out-of-band counterpart to bounce.Detect's inbox-scanned
+// DSNs for senders who route outbound mail through a transactional
+// provider instead of Gmail.
+type BouncesConfig struct {
+	// Enabled mounts the /webhook/bounces/ses and /webhook/bounces/sendgrid
+	// endpoints on "jobsearch serve"'s HTTP server.
+	Enabled bool `toml:"enabled"`
+	// WebhookToken is the bearer token each provider's webhook is
+	// configured to send; requests without it are rejected. Leave empty
+	// only behind a private network with no public webhook delivery.
+	WebhookToken string `toml:"webhook_token"`
+}
+
+// RemindersConfig controls reminder.Manager, the scheduled follow-up
+// subsystem behind "jobsearch remind" and "jobsearch reminders".
+type RemindersConfig struct {
+	// SweepInterval is how often reminder.Manager checks for due
+	// reminders, as a Go duration string (e.g. "5m"). Empty disables the
+	// background sweep goroutine in "jobsearch serve" - reminders can
+	// still be created and listed, they just won't fire until a sweep
+	// runs.
+	SweepInterval string `toml:"sweep_interval"`
+	// WaitingOnThemThresholdDays is how many days a conversation can sit
+	// in waiting_on_them before Tracker.updateAllStatuses auto-creates a
+	// desktop reminder for it. Zero disables auto-creation.
+	WaitingOnThemThresholdDays int `toml:"waiting_on_them_threshold_days"`
+}
+
+// SweepIntervalDuration parses SweepInterval, treating an empty or
+// invalid value as disabled (zero duration).
+func (c RemindersConfig) SweepIntervalDuration() time.Duration {
+	d, _ := time.ParseDuration(c.SweepInterval)
+	return d
+}
+
+// ReplyConfig controls the "jobs+<token>@domain" reply-to address scheme
+// tracker.HandleIncomingMail uses to route command replies (e.g. "#job
+// interview") back to the conversation they target.
+type ReplyConfig struct {
+	Domain     string `toml:"domain"`      // domain used when generating reply-to addresses
+	SecretPath string `toml:"secret_path"` // file holding the HMAC signing key, created on first use
+}
+
+// TemplatesConfig points at on-disk overrides for templates.Render's
+// outbound reply templates - follow_up, nudge, decline, thank_you - each
+// with a plaintext and an HTML (or hand-rolled MJML, since templates.Render
+// never parses the markup) variant. A path left empty falls back to the
+// built-in default for that template, materialized there on first run by
+// templates.EnsureDefaults.
+type TemplatesConfig struct {
+	FollowUpText string `toml:"follow_up_text"`
+	FollowUpHTML string `toml:"follow_up_html"`
+	NudgeText    string `toml:"nudge_text"`
+	NudgeHTML    string `toml:"nudge_html"`
+	DeclineText  string `toml:"decline_text"`
+	DeclineHTML  string `toml:"decline_html"`
+	ThankYouText string `toml:"thank_you_text"`
+	ThankYouHTML string `toml:"thank_you_html"`
+}
+
+// DigestConfig controls batching of job-activity notifications (new
+// recruiters, stage transitions, stale reminders) into one combined email
+// via Tracker.RunDigest, instead of sending one per event.
+type DigestConfig struct {
+	Enabled  bool   `toml:"enabled"`
+	Interval string `toml:"interval"` // "immediate", or a time.ParseDuration string like "15m", "1h", "24h"
+}
+
+// IntervalDuration parses Interval, treating "immediate" as a zero duration
+// (drain the queue as soon as RunDigest is called).
+func (d DigestConfig) IntervalDuration() (time.Duration, error) {
+	if d.Interval == "immediate" {
+		return 0, nil
+	}
+	dur, err := time.ParseDuration(d.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("digest.interval must be \"immediate\" or a duration like \"1h\": %w", err)
+	}
+	return dur, nil
+}
+
+// FunnelConfig defines the recruiting pipeline stages "jobsearch stats
+// --funnel" infers conversations into, from regex patterns matched against
+// each email's subject and snippet. Stages are ordered earliest-first, so
+// a conversation's furthest reached stage is the highest index that
+// matched any of its emails.
+type FunnelConfig struct {
+	Stages []FunnelStage `toml:"stages"`
+}
+
+// FunnelStage is one stage of FunnelConfig.Stages.
+type FunnelStage struct {
+	Name     string   `toml:"name"`
+	Patterns []string `toml:"patterns"` // regexes, matched case-insensitively
+}
+
+// NotifyConfig configures the notify.Registry that Tracker fans
+// conversation lifecycle events (new conversation, status change, stale
+// reminder, learned false positive, detected bounce) out through.
+type NotifyConfig struct {
+	Workers   int             `toml:"workers"` // max concurrent deliveries per event, see notify.Registry
+	Notifiers []NotifierEntry `toml:"notifiers"`
+	// Rules are evaluated against every new/changed conversation by
+	// notify.RuleEngine; a matching rule fires notify.EventRuleMatched
+	// through the Notifiers it names (or every configured notifier, if it
+	// names none).
+	Rules []RuleEntry `toml:"rules"`
+	// Watchlist is the set of companies a `company in watchlist` rule
+	// condition matches against, matched case-insensitively.
+	Watchlist []string `toml:"watchlist"`
+	// SchedulerInterval controls how often notify.Scheduler sweeps the
+	// database for time-based events (stale threshold crossed, waiting-on-me
+	// reminder, new inbound from a whitelisted domain) that Tracker's inline
+	// notify.Event calls only catch on the next sync, as a Go duration
+	// string (e.g. "15m"). Empty disables the scheduler entirely.
+	SchedulerInterval string `toml:"scheduler_interval"`
+	// TemplatesDir points at a directory of user-overridable digest/alert
+	// templates (interview_scheduled, stale_conversation,
+	// rejection_detected, weekly_summary - see notify.EventTemplateKinds),
+	// each a "<kind>.txt" and "<kind>.html" pair. A kind with no file there
+	// falls back to the shipped default. Empty means every notifier just
+	// uses the shipped defaults - there's one pair per kind rather than a
+	// TemplatesConfig-style field per path, since the set of kinds can grow
+	// without a config schema change.
+	TemplatesDir string `toml:"templates_dir"`
+}
+
+// SchedulerIntervalDuration parses SchedulerInterval, treating an empty or
+// invalid value as disabled (zero duration).
+func (c NotifyConfig) SchedulerIntervalDuration() time.Duration {
+	d, _ := time.ParseDuration(c.SchedulerInterval)
+	return d
+}
+
+// RuleEntry configures one notify.Rule. Condition is a small fixed
+// vocabulary (see notify.Rule.Eval), not a general expression language:
+//
+//	stage == "waiting_on_them"
+//	company in watchlist
+//	days_since_last_reply > 14
+//	sender_domain in whitelist
+type RuleEntry struct {
+	Name      string   `toml:"name"`
+	Condition string   `toml:"condition"`
+	Notifiers []string `toml:"notifiers"` // notifier names to target; empty means every configured notifier
+	Throttle  string   `toml:"throttle"`  // minimum time between two fires, as a Go duration string (e.g. "24h"); empty means no throttling
+}
+
+// ThrottleDuration parses Throttle, treating an empty or invalid value as
+// no throttling at all.
+func (r RuleEntry) ThrottleDuration() time.Duration {
+	d, _ := time.ParseDuration(r.Throttle)
+	return d
+}
+
+// NotifierEntry configures one entry in notify.Registry. Exactly one of
+// SMTP/Slack/Webhook/Telegram is read, selected by Type; "log" reads none
+// of them.
+type NotifierEntry struct {
+	Name     string   `toml:"name"`
+	Type     string   `toml:"type"`     // "smtp", "slack", "webhook", "telegram", or "log"
+	Events   []string `toml:"events"`   // event types this notifier receives; empty means all
+	Template string   `toml:"template"` // optional override, see notify.TemplateFuncs
+
+	SMTP     SMTPNotifierConfig     `toml:"smtp"`
+	Slack    SlackNotifierConfig    `toml:"slack"`
+	Webhook  WebhookNotifierConfig  `toml:"webhook"`
+	Telegram TelegramNotifierConfig `toml:"telegram"`
+}
+
+// SMTPNotifierConfig configures a "smtp" NotifierEntry.
+type SMTPNotifierConfig struct {
+	Host     string `toml:"host"`
+	Port     int    `toml:"port"`
+	Username string `toml:"username"`
+	Password string `toml:"password"` // prefer an env var in real deployments; see ReplyConfig.SecretPath for the pattern this repo otherwise uses
+	From     string `toml:"from"`
+	To       string `toml:"to"`
+}
+
+// SlackNotifierConfig configures a "slack" NotifierEntry.
+type SlackNotifierConfig struct {
+	WebhookURL string `toml:"webhook_url"`
+}
+
+// WebhookNotifierConfig configures a "webhook" NotifierEntry.
+type WebhookNotifierConfig struct {
+	URL string `toml:"url"`
+}
+
+// TelegramNotifierConfig configures a "telegram" NotifierEntry.
+type TelegramNotifierConfig struct {
+	BotToken string `toml:"bot_token"`
+	ChatID   string `toml:"chat_id"`
 }
 
 // Default returns a Config with sensible defaults
 func Default() *Config {
 	return &Config{
+		Provider: "gmail",
 		Gmail: GmailConfig{
 			CredentialsPath: "~/.config/jobsearch/credentials.json",
 			TokenPath:       "~/.config/jobsearch/token.json",
 			MaxResults:      100,
+			AuthMode:        "browser",
+		},
+		IMAP: IMAPConfig{
+			Port:         993,
+			UseTLS:       true,
+			PasswordPath: "~/.config/jobsearch/imap_password",
+			Mailbox:      "INBOX",
 		},
 		Database: DatabaseConfig{
 			Path: "~/.local/share/jobsearch/jobsearch.db",
@@ -111,8 +505,14 @@ func Default() *Config {
 			},
 		},
 		Classifier: ClassifierConfig{
-			Host: "http://localhost",
-			Port: 8642,
+			Host:    "http://localhost",
+			Port:    8642,
+			Backend: "chain",
+			Bayes: BayesConfig{
+				LowCutoff:  0.3,
+				HighCutoff: 0.8,
+				DBPath:     "~/.local/share/jobsearch/jobsearch.db",
+			},
 		},
 		Filters: FilterConfig{
 			DomainWhitelist: []string{
@@ -148,8 +548,25 @@ func Default() *Config {
 				"experience",
 			},
 		},
+		Learning: LearningConfig{
+			MinSupport:               3,
+			MaxFalsePositiveRate:     0.2,
+			AutoApproveThreshold:     0.85,
+			DemoteBelowPrecision:     0.5,
+			CleanRunRequired:         3,
+			BayesAutoFilterThreshold: 0.9,
+		},
 		Tracking: TrackingConfig{
-			StaleAfterDays: 7,
+			StaleAfterDays:  7,
+			FollowUpSLADays: 3,
+			HardBounceLimit: 1,
+		},
+		Reminders: RemindersConfig{
+			SweepInterval:              "5m",
+			WaitingOnThemThresholdDays: 7,
+		},
+		Sync: SyncConfig{
+			FetchConcurrency: 4,
 		},
 		Privacy: PrivacyConfig{
 			StoreEmailBody:    false,
@@ -159,5 +576,259 @@ func Default() *Config {
 			Enabled:   true,
 			Transport: "stdio",
 		},
+		Reply: ReplyConfig{
+			Domain:     "jobs.example.com",
+			SecretPath: "~/.config/jobsearch/reply.key",
+		},
+		Digest: DigestConfig{
+			Enabled:  false,
+			Interval: "immediate",
+		},
+		Notify: NotifyConfig{
+			Workers: 4,
+		},
+		Inbound: InboundConfig{
+			Listen:       "localhost:8080",
+			PollInterval: "2m",
+		},
+		Templates: TemplatesConfig{
+			FollowUpText: "~/.config/jobsearch/templates/follow_up.txt",
+			FollowUpHTML: "~/.config/jobsearch/templates/follow_up.html",
+			NudgeText:    "~/.config/jobsearch/templates/nudge.txt",
+			NudgeHTML:    "~/.config/jobsearch/templates/nudge.html",
+			DeclineText:  "~/.config/jobsearch/templates/decline.txt",
+			DeclineHTML:  "~/.config/jobsearch/templates/decline.html",
+			ThankYouText: "~/.config/jobsearch/templates/thank_you.txt",
+			ThankYouHTML: "~/.config/jobsearch/templates/thank_you.html",
+		},
+		Bounces: BouncesConfig{
+			Enabled: false,
+		},
+		Funnel: FunnelConfig{
+			Stages: []FunnelStage{
+				{Name: "outreach", Patterns: []string{`\b(reaching out|opportunity|interested in connecting|exciting role)\b`}},
+				{Name: "screen", Patterns: []string{`\b(phone screen|recruiter call|intro call|quick chat)\b`}},
+				{Name: "technical", Patterns: []string{`\b(technical interview|coding (interview|challenge)|take.home|tech screen)\b`}},
+				{Name: "onsite", Patterns: []string{`\b(onsite|on-site|final round|panel interview|virtual onsite)\b`}},
+				{Name: "offer", Patterns: []string{`\b(offer letter|pleased to offer|extend an offer|compensation package)\b`}},
+				{Name: "rejected", Patterns: []string{`\b(decided to move forward with other|not moving forward|unfortunately|other candidates)\b`}},
+				{Name: "ghosted", Patterns: []string{`\b(following up|checking in|any update|just circling back)\b`}},
+			},
+		},
+	}
+}
+
+// SetDefaults fills any zero-valued field in c from Default(), so a
+// partially-specified TOML file (e.g. one that only sets [gmail] and
+// [database]) still ends up with working values everywhere else. Slices
+// and nested structs are merged field by field, not replaced wholesale,
+// so setting just one entry of [filters] doesn't wipe out the rest.
+func (c *Config) SetDefaults() {
+	d := Default()
+
+	if c.Provider == "" {
+		c.Provider = d.Provider
+	}
+
+	if c.Gmail.CredentialsPath == "" {
+		c.Gmail.CredentialsPath = d.Gmail.CredentialsPath
+	}
+	if c.Gmail.TokenPath == "" {
+		c.Gmail.TokenPath = d.Gmail.TokenPath
+	}
+	if c.Gmail.MaxResults == 0 {
+		c.Gmail.MaxResults = d.Gmail.MaxResults
+	}
+	if c.Gmail.AuthMode == "" {
+		c.Gmail.AuthMode = d.Gmail.AuthMode
+	}
+
+	if c.IMAP.Port == 0 {
+		c.IMAP.Port = d.IMAP.Port
+	}
+	if c.IMAP.PasswordPath == "" {
+		c.IMAP.PasswordPath = d.IMAP.PasswordPath
+	}
+	if c.IMAP.Mailbox == "" {
+		c.IMAP.Mailbox = d.IMAP.Mailbox
+	}
+
+	if c.Database.Path == "" {
+		c.Database.Path = d.Database.Path
+	}
+
+	if c.LLM.Primary == "" {
+		c.LLM.Primary = d.LLM.Primary
+	}
+	if c.LLM.Fallback == "" {
+		c.LLM.Fallback = d.LLM.Fallback
+	}
+	if c.LLM.Ollama.Model == "" {
+		c.LLM.Ollama.Model = d.LLM.Ollama.Model
+	}
+	if c.LLM.Ollama.Host == "" {
+		c.LLM.Ollama.Host = d.LLM.Ollama.Host
+	}
+	if c.LLM.OpenAI.Model == "" {
+		c.LLM.OpenAI.Model = d.LLM.OpenAI.Model
+	}
+
+	if c.Classifier.Host == "" {
+		c.Classifier.Host = d.Classifier.Host
+	}
+	if c.Classifier.Port == 0 {
+		c.Classifier.Port = d.Classifier.Port
+	}
+	if c.Classifier.Backend == "" {
+		c.Classifier.Backend = d.Classifier.Backend
+	}
+	if c.Classifier.Bayes.LowCutoff == 0 {
+		c.Classifier.Bayes.LowCutoff = d.Classifier.Bayes.LowCutoff
+	}
+	if c.Classifier.Bayes.HighCutoff == 0 {
+		c.Classifier.Bayes.HighCutoff = d.Classifier.Bayes.HighCutoff
+	}
+	if c.Classifier.Bayes.DBPath == "" {
+		c.Classifier.Bayes.DBPath = d.Classifier.Bayes.DBPath
+	}
+
+	if len(c.Filters.DomainWhitelist) == 0 {
+		c.Filters.DomainWhitelist = d.Filters.DomainWhitelist
+	}
+	if len(c.Filters.DomainBlacklist) == 0 {
+		c.Filters.DomainBlacklist = d.Filters.DomainBlacklist
+	}
+	if len(c.Filters.SubjectBlacklist) == 0 {
+		c.Filters.SubjectBlacklist = d.Filters.SubjectBlacklist
+	}
+	if len(c.Filters.SubjectKeywords) == 0 {
+		c.Filters.SubjectKeywords = d.Filters.SubjectKeywords
+	}
+	if len(c.Filters.BodyKeywords) == 0 {
+		c.Filters.BodyKeywords = d.Filters.BodyKeywords
+	}
+
+	if c.Learning.MinSupport == 0 {
+		c.Learning.MinSupport = d.Learning.MinSupport
+	}
+	if c.Learning.MaxFalsePositiveRate == 0 {
+		c.Learning.MaxFalsePositiveRate = d.Learning.MaxFalsePositiveRate
+	}
+	if c.Learning.AutoApproveThreshold == 0 {
+		c.Learning.AutoApproveThreshold = d.Learning.AutoApproveThreshold
+	}
+	if c.Learning.DemoteBelowPrecision == 0 {
+		c.Learning.DemoteBelowPrecision = d.Learning.DemoteBelowPrecision
+	}
+	if c.Learning.CleanRunRequired == 0 {
+		c.Learning.CleanRunRequired = d.Learning.CleanRunRequired
+	}
+	if c.Learning.BayesAutoFilterThreshold == 0 {
+		c.Learning.BayesAutoFilterThreshold = d.Learning.BayesAutoFilterThreshold
+	}
+
+	if c.Tracking.StaleAfterDays == 0 {
+		c.Tracking.StaleAfterDays = d.Tracking.StaleAfterDays
+	}
+	if c.Tracking.FollowUpSLADays == 0 {
+		c.Tracking.FollowUpSLADays = d.Tracking.FollowUpSLADays
+	}
+	if c.Tracking.HardBounceLimit == 0 {
+		c.Tracking.HardBounceLimit = d.Tracking.HardBounceLimit
+	}
+
+	if c.Sync.FetchConcurrency == 0 {
+		c.Sync.FetchConcurrency = d.Sync.FetchConcurrency
+	}
+
+	if c.Reminders.SweepInterval == "" {
+		c.Reminders.SweepInterval = d.Reminders.SweepInterval
+	}
+	if c.Reminders.WaitingOnThemThresholdDays == 0 {
+		c.Reminders.WaitingOnThemThresholdDays = d.Reminders.WaitingOnThemThresholdDays
+	}
+
+	if c.Privacy.EncryptionKeyPath == "" {
+		c.Privacy.EncryptionKeyPath = d.Privacy.EncryptionKeyPath
+	}
+
+	if c.MCP.Transport == "" {
+		c.MCP.Transport = d.MCP.Transport
+	}
+
+	if c.Reply.Domain == "" {
+		c.Reply.Domain = d.Reply.Domain
+	}
+	if c.Reply.SecretPath == "" {
+		c.Reply.SecretPath = d.Reply.SecretPath
+	}
+
+	if c.Digest.Interval == "" {
+		c.Digest.Interval = d.Digest.Interval
+	}
+
+	if c.Notify.Workers == 0 {
+		c.Notify.Workers = d.Notify.Workers
+	}
+
+	if c.Inbound.Listen == "" {
+		c.Inbound.Listen = d.Inbound.Listen
+	}
+	if c.Inbound.PollInterval == "" {
+		c.Inbound.PollInterval = d.Inbound.PollInterval
+	}
+
+	if c.Templates.FollowUpText == "" {
+		c.Templates.FollowUpText = d.Templates.FollowUpText
+	}
+	if c.Templates.FollowUpHTML == "" {
+		c.Templates.FollowUpHTML = d.Templates.FollowUpHTML
+	}
+	if c.Templates.NudgeText == "" {
+		c.Templates.NudgeText = d.Templates.NudgeText
+	}
+	if c.Templates.NudgeHTML == "" {
+		c.Templates.NudgeHTML = d.Templates.NudgeHTML
+	}
+	if c.Templates.DeclineText == "" {
+		c.Templates.DeclineText = d.Templates.DeclineText
+	}
+	if c.Templates.DeclineHTML == "" {
+		c.Templates.DeclineHTML = d.Templates.DeclineHTML
+	}
+	if c.Templates.ThankYouText == "" {
+		c.Templates.ThankYouText = d.Templates.ThankYouText
+	}
+	if c.Templates.ThankYouHTML == "" {
+		c.Templates.ThankYouHTML = d.Templates.ThankYouHTML
+	}
+
+	if len(c.Funnel.Stages) == 0 {
+		c.Funnel.Stages = d.Funnel.Stages
+	}
+}
+
+// Redacted returns a copy of c with notifier secrets (SMTP passwords, Slack
+// and webhook URLs, which often embed a token, and Telegram bot tokens)
+// replaced by "REDACTED", so the config can be safely bundled into a
+// backup archive.
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	redacted.Notify.Notifiers = make([]NotifierEntry, len(c.Notify.Notifiers))
+	for i, n := range c.Notify.Notifiers {
+		if n.SMTP.Password != "" {
+			n.SMTP.Password = "REDACTED"
+		}
+		if n.Slack.WebhookURL != "" {
+			n.Slack.WebhookURL = "REDACTED"
+		}
+		if n.Webhook.URL != "" {
+			n.Webhook.URL = "REDACTED"
+		}
+		if n.Telegram.BotToken != "" {
+			n.Telegram.BotToken = "REDACTED"
+		}
+		redacted.Notify.Notifiers[i] = n
 	}
+	return &redacted
 }
@@ -21,9 +21,25 @@ func TestDefault(t *testing.T) {
 		t.Errorf("expected Port=8642, got %d", cfg.Classifier.Port)
 	}
 
+	if cfg.Classifier.Backend != "chain" {
+		t.Errorf("expected Backend=chain, got %s", cfg.Classifier.Backend)
+	}
+
+	if cfg.Classifier.Bayes.LowCutoff != 0.3 {
+		t.Errorf("expected Bayes.LowCutoff=0.3, got %v", cfg.Classifier.Bayes.LowCutoff)
+	}
+
+	if cfg.Classifier.Bayes.HighCutoff != 0.8 {
+		t.Errorf("expected Bayes.HighCutoff=0.8, got %v", cfg.Classifier.Bayes.HighCutoff)
+	}
+
 	if cfg.Tracking.StaleAfterDays != 7 {
 		t.Errorf("expected StaleAfterDays=7, got %d", cfg.Tracking.StaleAfterDays)
 	}
+
+	if cfg.Reply.Domain == "" {
+		t.Error("expected Reply.Domain to have a default value")
+	}
 }
 
 func TestValidate(t *testing.T) {
@@ -58,11 +74,47 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid classifier backend",
+			modify: func(c *Config) {
+				c.Classifier.Backend = "invalid"
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid bayes cutoffs",
+			modify: func(c *Config) {
+				c.Classifier.Bayes.LowCutoff = 0.9
+				c.Classifier.Bayes.HighCutoff = 0.2
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid mcp transport",
+			modify: func(c *Config) {
+				c.MCP.Transport = "websocket"
+			},
+			wantErr: true,
+		},
+		{
+			name: "http mcp transport is valid",
 			modify: func(c *Config) {
 				c.MCP.Transport = "http"
 			},
+			wantErr: false,
+		},
+		{
+			name: "missing reply domain",
+			modify: func(c *Config) {
+				c.Reply.Domain = ""
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid digest interval",
+			modify: func(c *Config) {
+				c.Digest.Interval = "not-a-duration"
+			},
 			wantErr: true,
 		},
 	}
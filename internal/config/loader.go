@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/pelletier/go-toml/v2"
@@ -28,10 +29,11 @@ func Load(path string) (*Config, error) {
 	}
 
 	// Parse TOML
-	cfg := Default()
+	cfg := &Config{}
 	if err := toml.Unmarshal(data, cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
+	cfg.SetDefaults()
 
 	// Expand paths in config
 	if err := cfg.expandPaths(); err != nil {
@@ -39,8 +41,8 @@ func Load(path string) (*Config, error) {
 	}
 
 	// Validate
-	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid config: %w", err)
+	if errs := cfg.Validate(); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid config: %w", errors.Join(errs...))
 	}
 
 	return cfg, nil
@@ -94,11 +96,36 @@ func (c *Config) expandPaths() error {
 		return err
 	}
 
+	c.Classifier.Bayes.DBPath, err = expandPath(c.Classifier.Bayes.DBPath)
+	if err != nil {
+		return err
+	}
+
+	c.Reply.SecretPath, err = expandPath(c.Reply.SecretPath)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range []*string{
+		&c.Templates.FollowUpText, &c.Templates.FollowUpHTML,
+		&c.Templates.NudgeText, &c.Templates.NudgeHTML,
+		&c.Templates.DeclineText, &c.Templates.DeclineHTML,
+		&c.Templates.ThankYouText, &c.Templates.ThankYouHTML,
+	} {
+		*p, err = expandPath(*p)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// Validate checks that the configuration is valid
-func (c *Config) Validate() error {
+// Validate checks that the configuration is valid, returning every issue
+// found (a bad path, an out-of-range threshold, an unknown LLM provider
+// name, ...) rather than stopping at the first one, so "jobsearch config
+// init"-adjacent tooling can report them all in one pass.
+func (c *Config) Validate() []error {
 	var errs []error
 
 	// Gmail validation
@@ -111,6 +138,9 @@ func (c *Config) Validate() error {
 	if c.Gmail.MaxResults < 1 || c.Gmail.MaxResults > 5000 {
 		errs = append(errs, errors.New("gmail.max_results must be between 1 and 5000"))
 	}
+	if c.Gmail.AuthMode != "" && c.Gmail.AuthMode != "browser" && c.Gmail.AuthMode != "device" {
+		errs = append(errs, fmt.Errorf("gmail.auth_mode must be 'browser' or 'device', got '%s'", c.Gmail.AuthMode))
+	}
 
 	// Database validation
 	if c.Database.Path == "" {
@@ -130,22 +160,91 @@ func (c *Config) Validate() error {
 	if c.Classifier.Port < 1 || c.Classifier.Port > 65535 {
 		errs = append(errs, errors.New("classifier.port must be between 1 and 65535"))
 	}
+	validBackends := map[string]bool{"chain": true, "llm": true, "bayes": true}
+	if !validBackends[c.Classifier.Backend] {
+		errs = append(errs, fmt.Errorf("classifier.backend must be 'chain', 'llm', or 'bayes', got '%s'", c.Classifier.Backend))
+	}
+	if c.Classifier.Backend == "chain" || c.Classifier.Backend == "bayes" {
+		if c.Classifier.Bayes.LowCutoff < 0 || c.Classifier.Bayes.LowCutoff > 1 {
+			errs = append(errs, errors.New("classifier.bayes.low_cutoff must be between 0 and 1"))
+		}
+		if c.Classifier.Bayes.HighCutoff < 0 || c.Classifier.Bayes.HighCutoff > 1 {
+			errs = append(errs, errors.New("classifier.bayes.high_cutoff must be between 0 and 1"))
+		}
+		if c.Classifier.Bayes.LowCutoff >= c.Classifier.Bayes.HighCutoff {
+			errs = append(errs, errors.New("classifier.bayes.low_cutoff must be less than classifier.bayes.high_cutoff"))
+		}
+	}
+
+	// Learning validation
+	if c.Learning.MinSupport < 1 {
+		errs = append(errs, errors.New("learning.min_support must be at least 1"))
+	}
+	if c.Learning.MaxFalsePositiveRate < 0 || c.Learning.MaxFalsePositiveRate > 1 {
+		errs = append(errs, errors.New("learning.max_false_positive_rate must be between 0 and 1"))
+	}
 
 	// Tracking validation
 	if c.Tracking.StaleAfterDays < 1 {
 		errs = append(errs, errors.New("tracking.stale_after_days must be at least 1"))
 	}
+	if c.Tracking.FollowUpSLADays < 1 {
+		errs = append(errs, errors.New("tracking.follow_up_sla_days must be at least 1"))
+	}
+	if c.Tracking.HardBounceLimit < 1 {
+		errs = append(errs, errors.New("tracking.hard_bounce_limit must be at least 1"))
+	}
+
+	// Sync validation
+	if c.Sync.FetchConcurrency < 1 || c.Sync.FetchConcurrency > 16 {
+		errs = append(errs, errors.New("sync.fetch_concurrency must be between 1 and 16"))
+	}
 
 	// MCP validation
-	if c.MCP.Transport != "stdio" {
-		errs = append(errs, fmt.Errorf("mcp.transport must be 'stdio', got '%s'", c.MCP.Transport))
+	if c.MCP.Transport != "stdio" && c.MCP.Transport != "http" {
+		errs = append(errs, fmt.Errorf("mcp.transport must be 'stdio' or 'http', got '%s'", c.MCP.Transport))
 	}
 
-	if len(errs) > 0 {
-		return errors.Join(errs...)
+	// Reply validation
+	if c.Reply.Domain == "" {
+		errs = append(errs, errors.New("reply.domain is required"))
+	}
+	if c.Reply.SecretPath == "" {
+		errs = append(errs, errors.New("reply.secret_path is required"))
 	}
 
-	return nil
+	// Digest validation
+	if _, err := c.Digest.IntervalDuration(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Notify validation
+	if c.Notify.Workers < 1 {
+		errs = append(errs, errors.New("notify.workers must be at least 1"))
+	}
+	validNotifierTypes := map[string]bool{"smtp": true, "slack": true, "webhook": true, "telegram": true, "log": true}
+	for _, n := range c.Notify.Notifiers {
+		if n.Name == "" {
+			errs = append(errs, errors.New("notify.notifiers entries must have a name"))
+		}
+		if !validNotifierTypes[n.Type] {
+			errs = append(errs, fmt.Errorf("notify.notifiers[%q].type must be 'smtp', 'slack', 'webhook', 'telegram', or 'log', got '%s'", n.Name, n.Type))
+		}
+	}
+
+	// Funnel validation
+	for _, s := range c.Funnel.Stages {
+		if s.Name == "" {
+			errs = append(errs, errors.New("funnel.stages entries must have a name"))
+		}
+		for _, p := range s.Patterns {
+			if _, err := regexp.Compile(p); err != nil {
+				errs = append(errs, fmt.Errorf("funnel.stages[%q] has an invalid pattern %q: %w", s.Name, p, err))
+			}
+		}
+	}
+
+	return errs
 }
 
 // ClassifierURL returns the full URL for the classifier service
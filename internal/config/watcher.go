@@ -0,0 +1,87 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watcher re-parses a config file whenever it changes on disk and pushes
+// the reloaded Config through Updates, so a long-running daemon (see
+// "jobsearch serve") can pick up edits without restarting. Editors often
+// replace a file on save rather than writing in place (vim, some IDE
+// autosaves), which surfaces as the watched path disappearing rather than
+// a Write event - Watcher re-adds the watch after every write, create or
+// rename event so it keeps following the same path regardless.
+type Watcher struct {
+	path    string
+	watcher *fsnotify.Watcher
+	Updates chan *Config
+	Errors  chan error
+}
+
+// NewWatcher starts watching path for changes and returns a Watcher whose
+// Updates channel receives a freshly loaded Config after each one.
+// Load errors (a saved-but-invalid edit) are sent to Errors instead,
+// leaving the last good Config as the caller's current one. Call Close
+// when done.
+func NewWatcher(path string) (*Watcher, error) {
+	expandedPath, err := expandPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand config path: %w", err)
+	}
+
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start config watcher: %w", err)
+	}
+	if err := fw.Add(expandedPath); err != nil {
+		fw.Close()
+		return nil, fmt.Errorf("failed to watch %s: %w", expandedPath, err)
+	}
+
+	w := &Watcher{
+		path:    expandedPath,
+		watcher: fw,
+		Updates: make(chan *Config, 1),
+		Errors:  make(chan error, 1),
+	}
+	go w.run()
+
+	return w, nil
+}
+
+func (w *Watcher) run() {
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+
+			// Re-add in case the file was replaced rather than written
+			// in place; a no-op if it's still the same inode.
+			w.watcher.Add(w.path)
+
+			cfg, err := Load(w.path)
+			if err != nil {
+				w.Errors <- err
+				continue
+			}
+			w.Updates <- cfg
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			w.Errors <- err
+		}
+	}
+}
+
+// Close stops the watcher and releases its underlying file handle.
+func (w *Watcher) Close() error {
+	return w.watcher.Close()
+}
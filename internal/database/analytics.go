@@ -0,0 +1,338 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// AnalyticsOptions controls the window and breadth of GetAnalytics.
+type AnalyticsOptions struct {
+	Since        *time.Time
+	TopCompanies int // number of companies to return in TopCompanies; defaults to 10
+}
+
+// ResponseTimeStats summarizes how long it takes to get a reply in one
+// direction, e.g. "outbound" rows are replies the user sent to something
+// received, and "inbound" rows are replies received to something the user
+// sent.
+type ResponseTimeStats struct {
+	Direction   string  `json:"direction"`
+	MedianHours float64 `json:"median_hours"`
+	P90Hours    float64 `json:"p90_hours"`
+	SampleSize  int     `json:"sample_size"`
+}
+
+// WeekCount is a single point in a weekly time series, bucketed by
+// strftime('%Y-%W', ...) - ISO-ish year-week pairs like "2026-30".
+type WeekCount struct {
+	Week  string `json:"week"`
+	Count int    `json:"count"`
+}
+
+// CompanyVolume is one company's email volume, for the top-N ranking.
+type CompanyVolume struct {
+	Company    string `json:"company"`
+	EmailCount int    `json:"email_count"`
+}
+
+// FunnelStage is the count of emails at one classification layer (e.g.
+// "whitelist", "keyword", "llm", "bayes"). The emails table has no explicit
+// recruiting-pipeline stage column (outreach/screen/onsite/offer), so this
+// is derived from the classification layer the filtering pipeline assigned
+// each email, per the request that drove this - it's a proxy funnel, not a
+// guarantee those labels map onto actual interview stages.
+type FunnelStage struct {
+	Classification string `json:"classification"`
+	Count          int    `json:"count"`
+}
+
+// WeekStaleRate is the fraction of conversations active in a given week that
+// had gone stale by the time GetAnalytics ran.
+type WeekStaleRate struct {
+	Week      string  `json:"week"`
+	StaleRate float64 `json:"stale_rate"`
+	Total     int     `json:"total"`
+}
+
+// Analytics is a richer report than Stats: response-time distributions,
+// a conversations-per-week time series, top companies by volume, a
+// classification funnel, and a per-week stale rate - enough to answer
+// "how's my job search going" rather than just current totals.
+type Analytics struct {
+	ResponseTimes        []ResponseTimeStats `json:"response_times"`
+	ConversationsPerWeek []WeekCount         `json:"conversations_per_week"`
+	TopCompanies         []CompanyVolume     `json:"top_companies"`
+	Funnel               []FunnelStage       `json:"funnel"`
+	StaleRatePerWeek     []WeekStaleRate     `json:"stale_rate_per_week"`
+}
+
+// GetAnalytics computes the funnel/time-series report described on
+// Analytics. Aggregation happens in SQL where SQLite can do it set-based
+// (window functions for response time flips, GROUP BY for the rest);
+// medians and p90s are finished off in Go since SQLite has no built-in
+// percentile function.
+func (db *DB) GetAnalytics(ctx context.Context, opts AnalyticsOptions) (*Analytics, error) {
+	topCompanies := opts.TopCompanies
+	if topCompanies <= 0 {
+		topCompanies = 10
+	}
+
+	a := &Analytics{}
+
+	responseTimes, err := db.responseTimesByDirection(ctx, opts.Since)
+	if err != nil {
+		return nil, err
+	}
+	a.ResponseTimes = responseTimes
+
+	perWeek, err := db.conversationsPerWeek(ctx, opts.Since)
+	if err != nil {
+		return nil, err
+	}
+	a.ConversationsPerWeek = perWeek
+
+	companies, err := db.topCompaniesByVolume(ctx, opts.Since, topCompanies)
+	if err != nil {
+		return nil, err
+	}
+	a.TopCompanies = companies
+
+	funnel, err := db.classificationFunnel(ctx, opts.Since)
+	if err != nil {
+		return nil, err
+	}
+	a.Funnel = funnel
+
+	staleRates, err := db.staleRatePerWeek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.StaleRatePerWeek = staleRates
+
+	return a, nil
+}
+
+// responseTimesByDirection finds every consecutive pair of emails in a
+// conversation (ordered by date) whose direction flips, using LAG as a
+// set-based equivalent of tracker.ComputeResponseTime's pairwise scan, then
+// buckets the resulting gaps by the direction of the reply.
+func (db *DB) responseTimesByDirection(ctx context.Context, since *time.Time) ([]ResponseTimeStats, error) {
+	query := `
+		WITH ordered AS (
+			SELECT
+				e.conversation_id,
+				e.direction,
+				e.date,
+				LAG(e.direction) OVER (PARTITION BY e.conversation_id ORDER BY e.date) AS prev_direction,
+				LAG(e.date) OVER (PARTITION BY e.conversation_id ORDER BY e.date) AS prev_date
+			FROM emails e
+			%s
+		)
+		SELECT direction, (julianday(date) - julianday(prev_date)) * 24.0 AS hours
+		FROM ordered
+		WHERE prev_direction IS NOT NULL AND prev_direction != direction
+	`
+
+	whereClause := ""
+	args := []interface{}{}
+	if since != nil {
+		whereClause = "WHERE e.date >= ?"
+		args = append(args, *since)
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(query, whereClause), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hoursByDirection := map[string][]float64{}
+	for rows.Next() {
+		var direction string
+		var hours float64
+		if err := rows.Scan(&direction, &hours); err != nil {
+			return nil, err
+		}
+		hoursByDirection[direction] = append(hoursByDirection[direction], hours)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	directions := make([]string, 0, len(hoursByDirection))
+	for d := range hoursByDirection {
+		directions = append(directions, d)
+	}
+	sort.Strings(directions)
+
+	stats := make([]ResponseTimeStats, 0, len(directions))
+	for _, d := range directions {
+		hours := hoursByDirection[d]
+		sort.Float64s(hours)
+		stats = append(stats, ResponseTimeStats{
+			Direction:   d,
+			MedianHours: percentile(hours, 0.5),
+			P90Hours:    percentile(hours, 0.9),
+			SampleSize:  len(hours),
+		})
+	}
+	return stats, nil
+}
+
+// percentile returns the p-th percentile (0-1) of a pre-sorted slice using
+// linear interpolation between the two closest ranks. Returns 0 for an
+// empty slice.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	idx := p * float64(len(sorted)-1)
+	lo := int(math.Floor(idx))
+	hi := int(math.Ceil(idx))
+	if lo == hi {
+		return sorted[lo]
+	}
+	frac := idx - float64(lo)
+	return sorted[lo] + frac*(sorted[hi]-sorted[lo])
+}
+
+func (db *DB) conversationsPerWeek(ctx context.Context, since *time.Time) ([]WeekCount, error) {
+	query := `
+		SELECT strftime('%Y-%W', last_activity_at) AS week, COUNT(*) AS count
+		FROM conversations
+		%s
+		GROUP BY week
+		ORDER BY week
+	`
+	whereClause := ""
+	args := []interface{}{}
+	if since != nil {
+		whereClause = "WHERE last_activity_at >= ?"
+		args = append(args, *since)
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(query, whereClause), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []WeekCount
+	for rows.Next() {
+		var wc WeekCount
+		if err := rows.Scan(&wc.Week, &wc.Count); err != nil {
+			return nil, err
+		}
+		result = append(result, wc)
+	}
+	return result, rows.Err()
+}
+
+func (db *DB) topCompaniesByVolume(ctx context.Context, since *time.Time, limit int) ([]CompanyVolume, error) {
+	query := `
+		SELECT c.company, COUNT(e.id) AS email_count
+		FROM emails e
+		JOIN conversations c ON c.id = e.conversation_id
+		%s
+		GROUP BY c.company
+		ORDER BY email_count DESC
+		LIMIT ?
+	`
+	whereClause := ""
+	args := []interface{}{}
+	if since != nil {
+		whereClause = "WHERE e.date >= ?"
+		args = append(args, *since)
+	}
+	args = append(args, limit)
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(query, whereClause), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []CompanyVolume
+	for rows.Next() {
+		var cv CompanyVolume
+		if err := rows.Scan(&cv.Company, &cv.EmailCount); err != nil {
+			return nil, err
+		}
+		result = append(result, cv)
+	}
+	return result, rows.Err()
+}
+
+func (db *DB) classificationFunnel(ctx context.Context, since *time.Time) ([]FunnelStage, error) {
+	query := `
+		SELECT COALESCE(classification, 'unclassified') AS classification, COUNT(*) AS count
+		FROM emails
+		%s
+		GROUP BY classification
+		ORDER BY count DESC
+	`
+	whereClause := ""
+	args := []interface{}{}
+	if since != nil {
+		whereClause = "WHERE date >= ?"
+		args = append(args, *since)
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(query, whereClause), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []FunnelStage
+	for rows.Next() {
+		var fs FunnelStage
+		if err := rows.Scan(&fs.Classification, &fs.Count); err != nil {
+			return nil, err
+		}
+		result = append(result, fs)
+	}
+	return result, rows.Err()
+}
+
+// staleRatePerWeek buckets conversations by the week of their last activity
+// and reports what fraction had gone stale by the time this query ran. It
+// intentionally ignores the since filter other aggregates take: a
+// conversation's week bucket is fixed by last_activity_at, so filtering it
+// out would just drop old weeks from the series rather than change their rate.
+func (db *DB) staleRatePerWeek(ctx context.Context) ([]WeekStaleRate, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT
+			strftime('%Y-%W', last_activity_at) AS week,
+			SUM(CASE WHEN status = 'stale' THEN 1 ELSE 0 END) AS stale_count,
+			COUNT(*) AS total
+		FROM conversations
+		GROUP BY week
+		ORDER BY week
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []WeekStaleRate
+	for rows.Next() {
+		var week string
+		var staleCount, total int
+		if err := rows.Scan(&week, &staleCount, &total); err != nil {
+			return nil, err
+		}
+		rate := 0.0
+		if total > 0 {
+			rate = float64(staleCount) / float64(total)
+		}
+		result = append(result, WeekStaleRate{Week: week, StaleRate: rate, Total: total})
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,281 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// batchChunkSize bounds how many rows CreateEmailsBatch/UpsertConversationsBatch/
+// GetEmailsByGmailIDs put in a single statement. SQLite's default
+// SQLITE_MAX_VARIABLE_NUMBER is 999 bound parameters per statement; emails
+// are the widest row at 20 columns, so 45 rows per chunk stays comfortably
+// under that for both tables.
+const batchChunkSize = 45
+
+// CreateEmailsBatch inserts emails in chunks of batchChunkSize, each chunk
+// as a single multi-row INSERT wrapped in its own transaction, for the
+// initial Gmail backfill where inserting one email at a time (CreateEmail)
+// is the dominant cost. A gmail_id already present is left untouched
+// (ON CONFLICT DO NOTHING) so a batch can be safely retried after a partial
+// failure.
+func (db *DB) CreateEmailsBatch(ctx context.Context, emails []*Email) error {
+	now := time.Now()
+	for _, e := range emails {
+		if e.ID == "" {
+			e.ID = uuid.New().String()
+		}
+		e.CreatedAt = now
+	}
+
+	for _, chunk := range chunkEmails(emails, batchChunkSize) {
+		if err := db.insertEmailChunk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DB) insertEmailChunk(ctx context.Context, emails []*Email) error {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	var placeholders []string
+	var args []interface{}
+	for _, e := range emails {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		args = append(args,
+			e.ID, e.ConversationID, e.GmailID, e.ThreadID, NullString(e.Subject),
+			e.FromAddress, NullString(e.FromName), NullString(e.ToAddress),
+			e.Date, e.Direction, NullString(e.Snippet), e.BodyStored, NullString(e.BodyEncrypted),
+			NullString(e.Classification), NullFloat64(e.Confidence), NullString(e.ExtractedData),
+			NullString(e.MessageID), NullString(e.InReplyTo), NullString(e.References), e.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO emails (
+			id, conversation_id, gmail_id, thread_id, subject, from_address, from_name,
+			to_address, date, direction, snippet, body_stored, body_encrypted,
+			classification, confidence, extracted_data, message_id, in_reply_to,
+			references_json, created_at
+		) VALUES %s
+		ON CONFLICT(gmail_id) DO NOTHING
+	`, strings.Join(placeholders, ", "))
+
+	return db.Transaction(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, query, args...)
+		return err
+	})
+}
+
+// chunkEmails splits emails into slices of at most size, preserving order.
+func chunkEmails(emails []*Email, size int) [][]*Email {
+	var chunks [][]*Email
+	for size < len(emails) {
+		emails, chunks = emails[size:], append(chunks, emails[0:size:size])
+	}
+	return append(chunks, emails)
+}
+
+// UpsertConversationsBatch inserts or updates conversations in chunks of
+// batchChunkSize, each chunk as a single multi-row INSERT ... ON CONFLICT(id)
+// DO UPDATE wrapped in its own transaction - the batch counterpart to
+// CreateConversation/UpdateConversation for a bulk sync where most
+// conversations already exist and just need their activity refreshed.
+func (db *DB) UpsertConversationsBatch(ctx context.Context, convs []*Conversation) error {
+	now := time.Now()
+	for _, c := range convs {
+		if c.ID == "" {
+			c.ID = uuid.New().String()
+			c.CreatedAt = now
+		}
+		c.UpdatedAt = now
+	}
+
+	for _, chunk := range chunkConversations(convs, batchChunkSize) {
+		if err := db.upsertConversationChunk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DB) upsertConversationChunk(ctx context.Context, convs []*Conversation) error {
+	if len(convs) == 0 {
+		return nil
+	}
+
+	var placeholders []string
+	var args []interface{}
+	for _, c := range convs {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		args = append(args,
+			c.ID, c.Company, NullString(c.Position), NullString(c.RecruiterName),
+			NullString(c.RecruiterEmail), c.Direction, c.Status,
+			c.LastActivityAt, c.EmailCount, c.CreatedAt, c.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO conversations (
+			id, company, position, recruiter_name, recruiter_email,
+			direction, status, last_activity_at, email_count, created_at, updated_at
+		) VALUES %s
+		ON CONFLICT(id) DO UPDATE SET
+			company = excluded.company,
+			position = excluded.position,
+			recruiter_name = excluded.recruiter_name,
+			recruiter_email = excluded.recruiter_email,
+			direction = excluded.direction,
+			status = excluded.status,
+			last_activity_at = excluded.last_activity_at,
+			email_count = excluded.email_count,
+			updated_at = excluded.updated_at
+	`, strings.Join(placeholders, ", "))
+
+	return db.Transaction(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, query, args...)
+		return err
+	})
+}
+
+func chunkConversations(convs []*Conversation, size int) [][]*Conversation {
+	var chunks [][]*Conversation
+	for size < len(convs) {
+		convs, chunks = convs[size:], append(chunks, convs[0:size:size])
+	}
+	return append(chunks, convs)
+}
+
+// GetEmailsByGmailIDs looks up emails by their Gmail message IDs in chunks
+// of batchChunkSize, expanding each chunk into its own "IN (?, ?, ...)"
+// query (the sqlx.In pattern, done by hand since this repo doesn't use
+// sqlx), and returns them keyed by gmail_id so a backfill can check which
+// of a page of Gmail IDs are already present without one round-trip per ID.
+func (db *DB) GetEmailsByGmailIDs(ctx context.Context, ids []string) (map[string]*Email, error) {
+	result := make(map[string]*Email, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	for _, chunk := range chunkStrings(ids, batchChunkSize) {
+		placeholders := make([]string, len(chunk))
+		args := make([]interface{}, len(chunk))
+		for i, id := range chunk {
+			placeholders[i] = "?"
+			args[i] = id
+		}
+
+		rows, err := db.QueryContext(ctx, fmt.Sprintf(`
+			SELECT id, conversation_id, gmail_id, thread_id, subject, from_address, from_name,
+			       to_address, date, direction, snippet, body_stored, body_encrypted,
+			       classification, confidence, extracted_data, message_id, in_reply_to,
+			       references_json, created_at
+			FROM emails WHERE gmail_id IN (%s)
+		`, strings.Join(placeholders, ",")), args...)
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			e := &Email{}
+			var subject, fromName, toAddress, snippet, bodyEncrypted, classification, extractedData sql.NullString
+			var messageID, inReplyTo, references sql.NullString
+			var confidence sql.NullFloat64
+
+			if err := rows.Scan(
+				&e.ID, &e.ConversationID, &e.GmailID, &e.ThreadID, &subject, &e.FromAddress, &fromName,
+				&toAddress, &e.Date, &e.Direction, &snippet, &e.BodyStored, &bodyEncrypted,
+				&classification, &confidence, &extractedData, &messageID, &inReplyTo, &references, &e.CreatedAt,
+			); err != nil {
+				rows.Close()
+				return nil, err
+			}
+
+			e.Subject = StringPtr(subject)
+			e.FromName = StringPtr(fromName)
+			e.ToAddress = StringPtr(toAddress)
+			e.Snippet = StringPtr(snippet)
+			e.BodyEncrypted = StringPtr(bodyEncrypted)
+			e.Classification = StringPtr(classification)
+			e.Confidence = Float64Ptr(confidence)
+			e.ExtractedData = StringPtr(extractedData)
+			e.MessageID = StringPtr(messageID)
+			e.InReplyTo = StringPtr(inReplyTo)
+			e.References = StringPtr(references)
+			result[e.GmailID] = e
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+	}
+
+	return result, nil
+}
+
+func chunkStrings(ids []string, size int) [][]string {
+	var chunks [][]string
+	for size < len(ids) {
+		ids, chunks = ids[size:], append(chunks, ids[0:size:size])
+	}
+	return append(chunks, ids)
+}
+
+// RecordEmailAtomic stores e, increments conv's email count, and (if
+// updateActivity) bumps conv's last_activity_at, all inside a single
+// transaction - the atomic counterpart to the separate
+// CreateEmail/IncrementEmailCount/UpdateConversation calls tracker.go used
+// to make independently, which could leave the database half-written if a
+// later call in the sequence failed.
+func (db *DB) RecordEmailAtomic(ctx context.Context, e *Email, conv *Conversation, updateActivity bool) error {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	e.CreatedAt = time.Now()
+	conv.UpdatedAt = time.Now()
+
+	return db.Transaction(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO emails (
+				id, conversation_id, gmail_id, thread_id, subject, from_address, from_name,
+				to_address, date, direction, snippet, body_stored, body_encrypted,
+				classification, confidence, extracted_data, message_id, in_reply_to,
+				references_json, created_at
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`,
+			e.ID, e.ConversationID, e.GmailID, e.ThreadID, NullString(e.Subject),
+			e.FromAddress, NullString(e.FromName), NullString(e.ToAddress),
+			e.Date, e.Direction, NullString(e.Snippet), e.BodyStored, NullString(e.BodyEncrypted),
+			NullString(e.Classification), NullFloat64(e.Confidence), NullString(e.ExtractedData),
+			NullString(e.MessageID), NullString(e.InReplyTo), NullString(e.References), e.CreatedAt,
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE conversations SET email_count = email_count + 1, updated_at = ?
+			WHERE id = ?
+		`, conv.UpdatedAt, conv.ID); err != nil {
+			return err
+		}
+		conv.EmailCount++
+
+		if updateActivity {
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE conversations SET last_activity_at = ?, updated_at = ? WHERE id = ?
+			`, conv.LastActivityAt, conv.UpdatedAt, conv.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
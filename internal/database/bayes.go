@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// BayesClass constants identify the two Naive Bayes training classes
+const (
+	BayesClassGood = "good"
+	BayesClassJunk = "junk"
+)
+
+// IncrementBayesToken increments the count for a token in the given class,
+// inserting the row if it doesn't exist yet.
+func (db *DB) IncrementBayesToken(ctx context.Context, token, class string) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO bayes_tokens (token, class, count)
+		VALUES (?, ?, 1)
+		ON CONFLICT(token, class) DO UPDATE SET count = count + 1
+	`, token, class)
+	return err
+}
+
+// BayesTokenCounts holds the good/junk counts for a single token
+type BayesTokenCounts struct {
+	Good int
+	Junk int
+}
+
+// GetBayesTokenCounts retrieves good/junk counts for a set of tokens in one query
+func (db *DB) GetBayesTokenCounts(ctx context.Context, tokens []string) (map[string]BayesTokenCounts, error) {
+	counts := make(map[string]BayesTokenCounts, len(tokens))
+	if len(tokens) == 0 {
+		return counts, nil
+	}
+
+	args := make([]interface{}, len(tokens))
+	placeholders := make([]string, len(tokens))
+	for i, tok := range tokens {
+		args[i] = tok
+		placeholders[i] = "?"
+	}
+
+	query := "SELECT token, class, count FROM bayes_tokens WHERE token IN (" + strings.Join(placeholders, ",") + ")"
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var token, class string
+		var count int
+		if err := rows.Scan(&token, &class, &count); err != nil {
+			return nil, err
+		}
+		c := counts[token]
+		switch class {
+		case BayesClassGood:
+			c.Good = count
+		case BayesClassJunk:
+			c.Junk = count
+		}
+		counts[token] = c
+	}
+
+	return counts, rows.Err()
+}
+
+// GetBayesTokenTotal returns the sum of token counts for a class (total token occurrences, not distinct tokens)
+func (db *DB) GetBayesTokenTotal(ctx context.Context, class string) (int, error) {
+	var total sql.NullInt64
+	err := db.QueryRowContext(ctx, `SELECT SUM(count) FROM bayes_tokens WHERE class = ?`, class).Scan(&total)
+	if err != nil {
+		return 0, err
+	}
+	return int(total.Int64), nil
+}
+
+// GetBayesVocabSize returns the number of distinct tokens seen across both classes
+func (db *DB) GetBayesVocabSize(ctx context.Context) (int, error) {
+	var size int
+	err := db.QueryRowContext(ctx, `SELECT COUNT(DISTINCT token) FROM bayes_tokens`).Scan(&size)
+	return size, err
+}
+
+// BayesStats summarizes the trained classifier's size, for "jobsearch bayes stats".
+type BayesStats struct {
+	GoodMessages int
+	JunkMessages int
+	VocabSize    int
+}
+
+// GetBayesStats returns the trained message counts per class and the total
+// distinct token vocabulary, for "jobsearch bayes stats".
+func (db *DB) GetBayesStats(ctx context.Context) (*BayesStats, error) {
+	good, err := db.GetBayesClassTotal(ctx, BayesClassGood)
+	if err != nil {
+		return nil, err
+	}
+	junk, err := db.GetBayesClassTotal(ctx, BayesClassJunk)
+	if err != nil {
+		return nil, err
+	}
+	vocab, err := db.GetBayesVocabSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &BayesStats{GoodMessages: good.MessageCount, JunkMessages: junk.MessageCount, VocabSize: vocab}, nil
+}
+
+// ResetBayesClassifier deletes all trained token counts and class totals,
+// returning the classifier to its untrained state, for "jobsearch bayes reset".
+func (db *DB) ResetBayesClassifier(ctx context.Context) error {
+	return db.Transaction(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM bayes_tokens`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM bayes_class_totals`); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// BayesClassTotal holds the trained-message count for a class
+type BayesClassTotal struct {
+	MessageCount int
+}
+
+// GetBayesClassTotal retrieves the training totals for a class, if any
+func (db *DB) GetBayesClassTotal(ctx context.Context, class string) (*BayesClassTotal, error) {
+	t := &BayesClassTotal{}
+	err := db.QueryRowContext(ctx, `
+		SELECT message_count FROM bayes_class_totals WHERE class = ?
+	`, class).Scan(&t.MessageCount)
+	if err == sql.ErrNoRows {
+		return &BayesClassTotal{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// SaveBayesClassTotal upserts the trained-message count for a class
+func (db *DB) SaveBayesClassTotal(ctx context.Context, class string, messageCount int) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO bayes_class_totals (class, message_count)
+		VALUES (?, ?)
+		ON CONFLICT(class) DO UPDATE SET message_count = excluded.message_count
+	`, class, messageCount)
+	return err
+}
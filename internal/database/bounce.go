@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+// CreateBounce records a parsed delivery-status notification against the
+// conversation whose outbound email it reports failing for.
+func (db *DB) CreateBounce(ctx context.Context, b *Bounce) error {
+	if b.ID == "" {
+		b.ID = uuid.New().String()
+	}
+
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO bounces (id, conversation_id, recipient, type, diagnostic, received_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, b.ID, b.ConversationID, b.Recipient, b.Type, NullString(b.Diagnostic), b.ReceivedAt)
+	return err
+}
+
+// CountHardBounces returns how many hard bounces have been recorded for
+// recipient across all conversations, so Tracker.HandleBounce can decide
+// when an address has bounced enough times to be considered dead.
+func (db *DB) CountHardBounces(ctx context.Context, recipient string) (int, error) {
+	var count int
+	err := db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM bounces WHERE recipient = ? AND type = 'hard'
+	`, recipient).Scan(&count)
+	return count, err
+}
+
+// ListBounces returns every bounce recorded against conversationID, oldest
+// first, for displaying a conversation's delivery-failure history.
+func (db *DB) ListBounces(ctx context.Context, conversationID string) ([]Bounce, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, conversation_id, recipient, type, diagnostic, received_at
+		FROM bounces WHERE conversation_id = ? ORDER BY received_at ASC
+	`, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bounces []Bounce
+	for rows.Next() {
+		var b Bounce
+		var diagnostic sql.NullString
+		if err := rows.Scan(&b.ID, &b.ConversationID, &b.Recipient, &b.Type, &diagnostic, &b.ReceivedAt); err != nil {
+			return nil, err
+		}
+		b.Diagnostic = StringPtr(diagnostic)
+		bounces = append(bounces, b)
+	}
+	return bounces, rows.Err()
+}
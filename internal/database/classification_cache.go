@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// GetClassificationCacheEntry returns the raw JSON response cached under
+// key and when it was stored, or found=false if there's no entry (or the
+// caller should treat it as such - callers apply their own TTL against
+// createdAt rather than this method pruning silently). The response is
+// left as an opaque string so this package doesn't need to import
+// classifier.ClassifyResponse.
+func (db *DB) GetClassificationCacheEntry(ctx context.Context, key string) (response string, createdAt time.Time, found bool, err error) {
+	err = db.QueryRowContext(ctx, `
+		SELECT response, created_at FROM classification_cache WHERE cache_key = ?
+	`, key).Scan(&response, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", time.Time{}, false, nil
+	}
+	if err != nil {
+		return "", time.Time{}, false, err
+	}
+	return response, createdAt, true, nil
+}
+
+// SetClassificationCacheEntry upserts the cached response for key.
+func (db *DB) SetClassificationCacheEntry(ctx context.Context, key, response string) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO classification_cache (cache_key, response, created_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(cache_key) DO UPDATE SET response = excluded.response, created_at = excluded.created_at
+	`, key, response, time.Now())
+	return err
+}
+
+// CountClassificationCacheEntries returns the number of cached entries,
+// for "jobsearch cache stats".
+func (db *DB) CountClassificationCacheEntries(ctx context.Context) (int, error) {
+	var count int
+	err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM classification_cache`).Scan(&count)
+	return count, err
+}
+
+// ClearClassificationCache deletes every cached entry, for
+// "jobsearch cache clear".
+func (db *DB) ClearClassificationCache(ctx context.Context) error {
+	_, err := db.ExecContext(ctx, `DELETE FROM classification_cache`)
+	return err
+}
+
+// PruneClassificationCache deletes entries older than before, returning how
+// many were removed, for "jobsearch cache prune" and the background sweep.
+func (db *DB) PruneClassificationCache(ctx context.Context, before time.Time) (int64, error) {
+	result, err := db.ExecContext(ctx, `DELETE FROM classification_cache WHERE created_at < ?`, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,25 @@
+package database
+
+import "context"
+
+// MarkSeen records key as seen within scope and reports whether this is the
+// first time - an exact replacement for the HyperLogLog estimate-delta
+// idempotency check bayes.go's Train and learner.go's recordSupport used to
+// each reimplement, which missed roughly 1 in 7 genuinely new keys (a new
+// item landing in an already-populated HLL bucket with an equal-or-lower
+// register never moves the cardinality estimate). Callers scope keys by
+// what they're deduping - e.g. "bayes:good" or a filter row's ID - so the
+// same key can be independently "new" in different scopes.
+func (db *DB) MarkSeen(ctx context.Context, scope, key string) (isNew bool, err error) {
+	result, err := db.ExecContext(ctx, `
+		INSERT OR IGNORE INTO dedup_seen (scope, key) VALUES (?, ?)
+	`, scope, key)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailCommandApplied reports whether messageID has already had a
+// "#jobsearch ..." directive applied from it, so Tracker.applyInboxCommand
+// can skip re-applying one a resync refetches.
+func (db *DB) EmailCommandApplied(ctx context.Context, messageID string) (bool, error) {
+	var count int
+	err := db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM email_commands WHERE message_id = ?
+	`, messageID).Scan(&count)
+	return count > 0, err
+}
+
+// CreateEmailCommand records that a "#jobsearch ..." directive from
+// c.MessageID was applied to c.ConversationID.
+func (db *DB) CreateEmailCommand(ctx context.Context, c *EmailCommand) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	if c.AppliedAt.IsZero() {
+		c.AppliedAt = time.Now()
+	}
+
+	var arg *string
+	if c.Arg != "" {
+		arg = &c.Arg
+	}
+
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO email_commands (id, message_id, conversation_id, command, arg, applied_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, c.ID, c.MessageID, c.ConversationID, c.Command, NullString(arg), c.AppliedAt)
+	return err
+}
+
+// ListEmailCommands returns every command applied to conversationID, oldest
+// first, for displaying a conversation's inbox-triage history.
+func (db *DB) ListEmailCommands(ctx context.Context, conversationID string) ([]EmailCommand, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, message_id, conversation_id, command, arg, applied_at
+		FROM email_commands WHERE conversation_id = ? ORDER BY applied_at ASC
+	`, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var commands []EmailCommand
+	for rows.Next() {
+		var c EmailCommand
+		var arg sql.NullString
+		if err := rows.Scan(&c.ID, &c.MessageID, &c.ConversationID, &c.Command, &arg, &c.AppliedAt); err != nil {
+			return nil, err
+		}
+		c.Arg = arg.String
+		commands = append(commands, c)
+	}
+	return commands, rows.Err()
+}
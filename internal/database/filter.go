@@ -0,0 +1,373 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ConversationOrderBy selects ListConversations/ListEmails' sort column and
+// direction. The zero value is OrderByActivityDesc, matching every
+// existing caller's prior hardcoded "ORDER BY last_activity_at DESC".
+type ConversationOrderBy string
+
+const (
+	OrderByActivityDesc ConversationOrderBy = "activity_desc"
+	OrderByActivityAsc  ConversationOrderBy = "activity_asc"
+	OrderByCreatedDesc  ConversationOrderBy = "created_desc"
+	OrderByCreatedAsc   ConversationOrderBy = "created_asc"
+)
+
+// columnAndDirection maps an OrderBy value to the column/direction pair
+// ORDER BY and the keyset cursor comparison both need.
+func (o ConversationOrderBy) columnAndDirection() (column, direction string) {
+	switch o {
+	case OrderByActivityAsc:
+		return "last_activity_at", "ASC"
+	case OrderByCreatedDesc:
+		return "created_at", "DESC"
+	case OrderByCreatedAsc:
+		return "created_at", "ASC"
+	default:
+		return "last_activity_at", "DESC"
+	}
+}
+
+// ConversationFilter is a composable predicate for ListConversations,
+// replacing the one-field-per-predicate growth of ListOptions: new filters
+// are new fields here rather than a new parameter and a new query branch.
+// Cursor-based pagination (AfterID/AfterActivity) is preferred over
+// Limit/Offset for paging through a large result set, since OFFSET still
+// has to scan and discard every preceding row.
+type ConversationFilter struct {
+	Statuses          []ConversationStatus
+	Direction         *Direction
+	Companies         []string // glob patterns, OR-ed together (see globToLike)
+	RecruiterEmails   []string // glob patterns over recruiter_email, OR-ed together
+	HasClassification *string  // joined email's classification layer, e.g. "whitelist"
+	ConfidenceGTE     *float64 // joined email's confidence >= this
+	SubjectContains   []string // joined email's subject LIKE any of these, case-insensitive
+	DateAfter         *time.Time
+	DateBefore        *time.Time
+	ThreadIDs         []string // joined email's thread_id IN (...)
+	BouncedOnly       bool
+
+	// HasAttachment is accepted for API compatibility with the filter DSL
+	// this type is part of, but always evaluates to "no match" when set to
+	// true: the emails table has no attachment metadata (no attachments
+	// table, no has_attachment column), so there's nothing to filter on
+	// yet. A false value is a no-op, same as leaving it unset.
+	HasAttachment *bool
+
+	IncludeArchived bool
+
+	OrderBy       ConversationOrderBy
+	Limit         int
+	AfterID       string     // keyset cursor: only rows after this ID in OrderBy's ordering
+	AfterActivity *time.Time // keyset cursor: the OrderBy column's value at AfterID
+}
+
+// SQL builds filter's WHERE clause (without the leading "WHERE") and bound
+// args, for use against "conversations c LEFT JOIN emails e ON c.id =
+// e.conversation_id" - the same join ListConversations, Query, and
+// FullTextSearch already use, so HasClassification/ConfidenceGTE/
+// SubjectContains/ThreadIDs (which all filter on the joined email) work the
+// same way crit.Layer/MinConfidence/FullText already do in SearchCriteria.
+func (f ConversationFilter) SQL() (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	if !f.IncludeArchived {
+		clauses = append(clauses, "c.archived = 0")
+	}
+	if len(f.Statuses) > 0 {
+		placeholders := make([]string, len(f.Statuses))
+		for i, s := range f.Statuses {
+			placeholders[i] = "?"
+			args = append(args, s)
+		}
+		clauses = append(clauses, "c.status IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if f.Direction != nil {
+		clauses = append(clauses, "c.direction = ?")
+		args = append(args, *f.Direction)
+	}
+	if f.BouncedOnly {
+		clauses = append(clauses, "c.bounced = 1")
+	}
+	if len(f.Companies) > 0 {
+		var or []string
+		for _, pattern := range f.Companies {
+			or = append(or, "LOWER(c.company) LIKE LOWER(?)")
+			args = append(args, globToLike(pattern))
+		}
+		clauses = append(clauses, "("+strings.Join(or, " OR ")+")")
+	}
+	if len(f.RecruiterEmails) > 0 {
+		var or []string
+		for _, pattern := range f.RecruiterEmails {
+			or = append(or, "LOWER(c.recruiter_email) LIKE LOWER(?)")
+			args = append(args, globToLike(pattern))
+		}
+		clauses = append(clauses, "("+strings.Join(or, " OR ")+")")
+	}
+	if f.HasClassification != nil {
+		clauses = append(clauses, "e.classification = ?")
+		args = append(args, *f.HasClassification)
+	}
+	if f.ConfidenceGTE != nil {
+		clauses = append(clauses, "e.confidence >= ?")
+		args = append(args, *f.ConfidenceGTE)
+	}
+	if len(f.SubjectContains) > 0 {
+		var or []string
+		for _, term := range f.SubjectContains {
+			or = append(or, "LOWER(e.subject) LIKE ?")
+			args = append(args, "%"+strings.ToLower(term)+"%")
+		}
+		clauses = append(clauses, "("+strings.Join(or, " OR ")+")")
+	}
+	if f.DateAfter != nil {
+		clauses = append(clauses, "c.last_activity_at >= ?")
+		args = append(args, *f.DateAfter)
+	}
+	if f.DateBefore != nil {
+		clauses = append(clauses, "c.last_activity_at <= ?")
+		args = append(args, *f.DateBefore)
+	}
+	if len(f.ThreadIDs) > 0 {
+		placeholders := make([]string, len(f.ThreadIDs))
+		for i, id := range f.ThreadIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		clauses = append(clauses, "e.thread_id IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if f.HasAttachment != nil && *f.HasAttachment {
+		clauses = append(clauses, "0") // no attachment metadata tracked; never matches
+	}
+
+	if f.AfterID != "" && f.AfterActivity != nil {
+		column, direction := f.OrderBy.columnAndDirection()
+		op := "<"
+		if direction == "ASC" {
+			op = ">"
+		}
+		clauses = append(clauses, "(c."+column+", c.id) "+op+" (?, ?)")
+		args = append(args, *f.AfterActivity, f.AfterID)
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+// orderClause returns the ORDER BY clause (without the words "ORDER BY")
+// matching f.OrderBy, tie-broken by id so keyset pagination has a total
+// order to page through.
+func (f ConversationFilter) orderClause() string {
+	column, direction := f.OrderBy.columnAndDirection()
+	return "c." + column + " " + direction + ", c.id " + direction
+}
+
+// QueryConversations retrieves conversations matching filter, newest
+// activity first by default (see ConversationFilter.OrderBy), using keyset
+// pagination when filter.AfterID/AfterActivity are set or falling back to
+// filter.Limit alone for the first page. It's ConversationFilter's entry
+// point, kept separate from the existing ListOptions-based
+// ListConversations (whose many call sites across cli/mcp this doesn't
+// touch) rather than replacing it outright - the same relationship
+// FullTextSearch has to the older Search.
+func (db *DB) QueryConversations(ctx context.Context, filter ConversationFilter) ([]Conversation, error) {
+	query := `
+		SELECT DISTINCT c.id, c.company, c.position, c.recruiter_name, c.recruiter_email,
+		       c.direction, c.status, c.last_activity_at, c.email_count, c.archived, c.review_suggested, c.snoozed_until,
+		       c.bounced, c.bounce_type, c.bounce_address, c.created_at, c.updated_at
+		FROM conversations c
+		LEFT JOIN emails e ON c.id = e.conversation_id
+	`
+	where, args := filter.SQL()
+	if where != "" {
+		query += " WHERE " + where
+	}
+	query += " ORDER BY " + filter.orderClause()
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var conversations []Conversation
+	for rows.Next() {
+		c, err := scanConversationRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		conversations = append(conversations, c)
+	}
+	return conversations, rows.Err()
+}
+
+// MessageFilter is ConversationFilter's counterpart for ListEmails: a
+// composable predicate over the emails table instead of conversations.
+type MessageFilter struct {
+	ConversationID  *string
+	Directions      []Direction
+	Layers          []string // classification layer, OR-ed together
+	ConfidenceGTE   *float64
+	SubjectContains []string
+	DateAfter       *time.Time
+	DateBefore      *time.Time
+	ThreadIDs       []string
+
+	OrderBy       ConversationOrderBy // only OrderByActivityAsc/Desc are meaningful here (mapped to "date")
+	Limit         int
+	AfterID       string
+	AfterActivity *time.Time
+}
+
+// SQL builds filter's WHERE clause (without the leading "WHERE") and bound
+// args, for use against the emails table directly (no join, unlike
+// ConversationFilter).
+func (f MessageFilter) SQL() (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	if f.ConversationID != nil {
+		clauses = append(clauses, "conversation_id = ?")
+		args = append(args, *f.ConversationID)
+	}
+	if len(f.Directions) > 0 {
+		placeholders := make([]string, len(f.Directions))
+		for i, d := range f.Directions {
+			placeholders[i] = "?"
+			args = append(args, d)
+		}
+		clauses = append(clauses, "direction IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if len(f.Layers) > 0 {
+		placeholders := make([]string, len(f.Layers))
+		for i, l := range f.Layers {
+			placeholders[i] = "?"
+			args = append(args, l)
+		}
+		clauses = append(clauses, "classification IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if f.ConfidenceGTE != nil {
+		clauses = append(clauses, "confidence >= ?")
+		args = append(args, *f.ConfidenceGTE)
+	}
+	if len(f.SubjectContains) > 0 {
+		var or []string
+		for _, term := range f.SubjectContains {
+			or = append(or, "LOWER(subject) LIKE ?")
+			args = append(args, "%"+strings.ToLower(term)+"%")
+		}
+		clauses = append(clauses, "("+strings.Join(or, " OR ")+")")
+	}
+	if f.DateAfter != nil {
+		clauses = append(clauses, "date >= ?")
+		args = append(args, *f.DateAfter)
+	}
+	if f.DateBefore != nil {
+		clauses = append(clauses, "date <= ?")
+		args = append(args, *f.DateBefore)
+	}
+	if len(f.ThreadIDs) > 0 {
+		placeholders := make([]string, len(f.ThreadIDs))
+		for i, id := range f.ThreadIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		clauses = append(clauses, "thread_id IN ("+strings.Join(placeholders, ",")+")")
+	}
+
+	if f.AfterID != "" && f.AfterActivity != nil {
+		op := "<"
+		if f.OrderBy == OrderByActivityAsc {
+			op = ">"
+		}
+		clauses = append(clauses, "(date, id) "+op+" (?, ?)")
+		args = append(args, *f.AfterActivity, f.AfterID)
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+func (f MessageFilter) orderClause() string {
+	direction := "DESC"
+	if f.OrderBy == OrderByActivityAsc {
+		direction = "ASC"
+	}
+	return "date " + direction + ", id " + direction
+}
+
+// ListEmails retrieves emails matching filter - the message-level
+// counterpart to ListConversations, for MCP tools and CLI commands that
+// need arbitrary email predicates without a dedicated method per query
+// shape. SearchEmails(EmailSearchCriteria) remains the richer text-search
+// entry point; ListEmails is for simple structural filtering with cursor
+// pagination.
+func (db *DB) ListEmails(ctx context.Context, filter MessageFilter) ([]Email, error) {
+	query := `
+		SELECT id, conversation_id, gmail_id, thread_id, subject, from_address, from_name,
+		       to_address, date, direction, snippet, body_stored, body_encrypted,
+		       classification, confidence, extracted_data, message_id, in_reply_to,
+		       references_json, created_at
+		FROM emails
+	`
+	where, args := filter.SQL()
+	if where != "" {
+		query += " WHERE " + where
+	}
+	query += " ORDER BY " + filter.orderClause()
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []Email
+	for rows.Next() {
+		e := Email{}
+		var subject, fromName, toAddress, snippet, bodyEncrypted, classification, extractedData sql.NullString
+		var messageID, inReplyTo, references sql.NullString
+		var confidence sql.NullFloat64
+
+		if err := rows.Scan(
+			&e.ID, &e.ConversationID, &e.GmailID, &e.ThreadID, &subject, &e.FromAddress, &fromName,
+			&toAddress, &e.Date, &e.Direction, &snippet, &e.BodyStored, &bodyEncrypted,
+			&classification, &confidence, &extractedData, &messageID, &inReplyTo, &references, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		e.Subject = StringPtr(subject)
+		e.FromName = StringPtr(fromName)
+		e.ToAddress = StringPtr(toAddress)
+		e.Snippet = StringPtr(snippet)
+		e.BodyEncrypted = StringPtr(bodyEncrypted)
+		e.Classification = StringPtr(classification)
+		e.Confidence = Float64Ptr(confidence)
+		e.ExtractedData = StringPtr(extractedData)
+		e.MessageID = StringPtr(messageID)
+		e.InReplyTo = StringPtr(inReplyTo)
+		e.References = StringPtr(references)
+		emails = append(emails, e)
+	}
+	return emails, rows.Err()
+}
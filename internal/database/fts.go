@@ -0,0 +1,390 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// EnsureFTS best-effort bootstraps SQLite FTS5 virtual tables for full-text
+// search and sets db.fts accordingly. It's deliberately NOT a versioned
+// migration: the migrator (see migrator.go) aborts Open() entirely if any
+// migration fails, but mattn/go-sqlite3 only compiles the fts5 module in
+// behind the sqlite_fts5 build tag, so "no such module: fts5" is an expected
+// outcome on a binary built without it, not a corruption to fail loudly on.
+// Search falls back to a LIKE-based scan whenever db.fts is false.
+//
+// emails_fts mirrors subject, snippet, from_name, and from_address -
+// extracted_data is intentionally left out (it's a JSON blob, not prose) and
+// so is the email body: emails.body_encrypted holds ciphertext, not
+// plaintext, and indexing it in an FTS5 table would defeat the point of
+// encrypting it. conversations_fts mirrors company, position,
+// recruiter_name, and recruiter_email.
+func (db *DB) EnsureFTS(ctx context.Context) {
+	if err := db.createFTS(ctx); err != nil {
+		log.Printf("full-text search unavailable, falling back to LIKE-based search: %v", err)
+		db.fts = false
+		return
+	}
+	db.fts = true
+
+	if err := db.backfillFTS(ctx); err != nil {
+		log.Printf("full-text search backfill failed: %v", err)
+	}
+}
+
+func (db *DB) createFTS(ctx context.Context) error {
+	stmts := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS emails_fts USING fts5(
+			subject, snippet, from_name, from_address,
+			content='emails', content_rowid='rowid'
+		)`,
+		`CREATE TRIGGER IF NOT EXISTS emails_fts_ai AFTER INSERT ON emails BEGIN
+			INSERT INTO emails_fts(rowid, subject, snippet, from_name, from_address)
+			VALUES (new.rowid, new.subject, new.snippet, new.from_name, new.from_address);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS emails_fts_ad AFTER DELETE ON emails BEGIN
+			INSERT INTO emails_fts(emails_fts, rowid, subject, snippet, from_name, from_address)
+			VALUES ('delete', old.rowid, old.subject, old.snippet, old.from_name, old.from_address);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS emails_fts_au AFTER UPDATE ON emails BEGIN
+			INSERT INTO emails_fts(emails_fts, rowid, subject, snippet, from_name, from_address)
+			VALUES ('delete', old.rowid, old.subject, old.snippet, old.from_name, old.from_address);
+			INSERT INTO emails_fts(rowid, subject, snippet, from_name, from_address)
+			VALUES (new.rowid, new.subject, new.snippet, new.from_name, new.from_address);
+		END`,
+
+		`CREATE VIRTUAL TABLE IF NOT EXISTS conversations_fts USING fts5(
+			company, position, recruiter_name, recruiter_email,
+			content='conversations', content_rowid='rowid'
+		)`,
+		`CREATE TRIGGER IF NOT EXISTS conversations_fts_ai AFTER INSERT ON conversations BEGIN
+			INSERT INTO conversations_fts(rowid, company, position, recruiter_name, recruiter_email)
+			VALUES (new.rowid, new.company, new.position, new.recruiter_name, new.recruiter_email);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS conversations_fts_ad AFTER DELETE ON conversations BEGIN
+			INSERT INTO conversations_fts(conversations_fts, rowid, company, position, recruiter_name, recruiter_email)
+			VALUES ('delete', old.rowid, old.company, old.position, old.recruiter_name, old.recruiter_email);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS conversations_fts_au AFTER UPDATE ON conversations BEGIN
+			INSERT INTO conversations_fts(conversations_fts, rowid, company, position, recruiter_name, recruiter_email)
+			VALUES ('delete', old.rowid, old.company, old.position, old.recruiter_name, old.recruiter_email);
+			INSERT INTO conversations_fts(rowid, company, position, recruiter_name, recruiter_email)
+			VALUES (new.rowid, new.company, new.position, new.recruiter_name, new.recruiter_email);
+		END`,
+	}
+
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillFTS populates emails_fts/conversations_fts from existing rows the
+// first time FTS5 becomes available on a database that already has data -
+// the triggers installed by createFTS only cover writes from this point
+// forward. It's safe to call on every Open(): the emails_fts/conversations_fts
+// IS-EMPTY check makes it a no-op once the backfill has already happened.
+func (db *DB) backfillFTS(ctx context.Context) error {
+	var emailCount int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM emails_fts").Scan(&emailCount); err != nil {
+		return fmt.Errorf("checking emails_fts backfill state: %w", err)
+	}
+	if emailCount == 0 {
+		if _, err := db.ExecContext(ctx, `
+			INSERT INTO emails_fts(rowid, subject, snippet, from_name, from_address)
+			SELECT rowid, subject, snippet, from_name, from_address FROM emails
+		`); err != nil {
+			return fmt.Errorf("backfilling emails_fts: %w", err)
+		}
+	}
+
+	var convCount int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM conversations_fts").Scan(&convCount); err != nil {
+		return fmt.Errorf("checking conversations_fts backfill state: %w", err)
+	}
+	if convCount == 0 {
+		if _, err := db.ExecContext(ctx, `
+			INSERT INTO conversations_fts(rowid, company, position, recruiter_name, recruiter_email)
+			SELECT rowid, company, position, recruiter_name, recruiter_email FROM conversations
+		`); err != nil {
+			return fmt.Errorf("backfilling conversations_fts: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SearchOptions configures FullTextSearch. Query is an FTS5 MATCH string:
+// bare terms are AND-ed together, "quoted phrases" match adjacent words, and
+// column filters (e.g. "company:acme", "subject:offer") restrict a term to
+// one indexed column - see createFTS for which columns each virtual table
+// exposes. A column filter naming a column that only exists on one of the
+// two tables (e.g. "company:" on emails_fts) simply finds no matches from
+// the other table rather than erroring the whole search.
+type SearchOptions struct {
+	Query string
+
+	Status          *ConversationStatus
+	StatusIn        []ConversationStatus
+	Since           *time.Time
+	Before          *time.Time
+	IncludeArchived bool
+
+	Limit  int
+	Offset int
+}
+
+// SearchResult is one conversation match from FullTextSearch.
+type SearchResult struct {
+	Conversation
+	Rank    float64 // bm25() score; lower is more relevant
+	Snippet string  // snippet() excerpt from whichever side matched, with <b>...</b> highlighting
+}
+
+// ErrFTSUnavailable is returned by FullTextSearch when this process's
+// sqlite3 driver wasn't compiled with the fts5 module. Callers should fall
+// back to Search, which does this automatically.
+var ErrFTSUnavailable = fmt.Errorf("full-text search is unavailable: sqlite3 driver was not built with fts5")
+
+// ftsMatch is one MATCH hit against either fts table, keyed by conversation
+// ID so results from both tables can be merged and deduplicated.
+type ftsMatch struct {
+	convID  string
+	rank    float64
+	snippet string
+}
+
+// FullTextSearch runs opts.Query against emails_fts and conversations_fts
+// and returns the matching conversations ranked by BM25 (best first), with
+// opts' date/status/archived filters and pagination applied afterward - the
+// FTS5-backed replacement for the LIKE-based Search, with filters Search
+// never had and ranking LIKE can't do at all.
+func (db *DB) FullTextSearch(ctx context.Context, opts SearchOptions) ([]SearchResult, error) {
+	if !db.fts {
+		return nil, ErrFTSUnavailable
+	}
+	if opts.Query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	convMatches, convErr := db.ftsTableMatches(ctx, `
+		SELECT c.id, bm25(conversations_fts), snippet(conversations_fts, -1, '<b>', '</b>', '...', 10)
+		FROM conversations_fts
+		JOIN conversations c ON c.rowid = conversations_fts.rowid
+		WHERE conversations_fts MATCH ?
+	`, opts.Query)
+
+	emailMatches, emailErr := db.ftsTableMatches(ctx, `
+		SELECT e.conversation_id, bm25(emails_fts), snippet(emails_fts, -1, '<b>', '</b>', '...', 10)
+		FROM emails_fts
+		JOIN emails e ON e.rowid = emails_fts.rowid
+		WHERE emails_fts MATCH ?
+	`, opts.Query)
+
+	if convErr != nil && emailErr != nil {
+		return nil, fmt.Errorf("fts5 query failed: %w", convErr)
+	}
+
+	best := make(map[string]ftsMatch)
+	for _, m := range convMatches {
+		if existing, ok := best[m.convID]; !ok || m.rank < existing.rank {
+			best[m.convID] = m
+		}
+	}
+	for _, m := range emailMatches {
+		if existing, ok := best[m.convID]; !ok || m.rank < existing.rank {
+			best[m.convID] = m
+		}
+	}
+	if len(best) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(best))
+	for id := range best {
+		ids = append(ids, id)
+	}
+
+	crit := SearchCriteria{
+		Status:          opts.Status,
+		StatusIn:        opts.StatusIn,
+		Since:           opts.Since,
+		Before:          opts.Before,
+		IncludeArchived: opts.IncludeArchived,
+		IDIn:            ids,
+	}
+	where, args := buildCriteriaClause(crit)
+
+	query := `
+		SELECT DISTINCT c.id, c.company, c.position, c.recruiter_name, c.recruiter_email,
+		       c.direction, c.status, c.last_activity_at, c.email_count, c.archived, c.review_suggested, c.snoozed_until,
+		       c.bounced, c.bounce_type, c.bounce_address, c.created_at, c.updated_at
+		FROM conversations c
+		LEFT JOIN emails e ON c.id = e.conversation_id
+		WHERE ` + where
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		c := Conversation{}
+		var position, recruiterName, recruiterEmail, bounceType, bounceAddress sql.NullString
+		var snoozedUntil sql.NullTime
+
+		if err := rows.Scan(
+			&c.ID, &c.Company, &position, &recruiterName, &recruiterEmail,
+			&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.Archived, &c.ReviewSuggested, &snoozedUntil,
+			&c.Bounced, &bounceType, &bounceAddress, &c.CreatedAt, &c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		c.Position = StringPtr(position)
+		c.RecruiterName = StringPtr(recruiterName)
+		c.RecruiterEmail = StringPtr(recruiterEmail)
+		c.BounceType = StringPtr(bounceType)
+		c.BounceAddress = StringPtr(bounceAddress)
+		if snoozedUntil.Valid {
+			c.SnoozedUntil = &snoozedUntil.Time
+		}
+
+		m := best[c.ID]
+		results = append(results, SearchResult{Conversation: c, Rank: m.rank, Snippet: m.snippet})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Rank < results[j].Rank })
+
+	if opts.Offset > 0 || opts.Limit > 0 {
+		if opts.Offset >= len(results) {
+			return []SearchResult{}, nil
+		}
+		end := len(results)
+		if opts.Limit > 0 && opts.Offset+opts.Limit < end {
+			end = opts.Offset + opts.Limit
+		}
+		results = results[opts.Offset:end]
+	}
+
+	return results, nil
+}
+
+// RebuildFTS drops and recreates emails_fts/conversations_fts and
+// repopulates them from the emails/conversations tables, for 'jobsearch
+// reindex' and the reindex_search MCP tool. Normal operation never needs
+// this - createFTS's triggers keep the tables in sync with every
+// insert/update/delete - but a forced rebuild is useful after restoring a
+// backup taken before FTS5 was enabled, or if the fts5 sqlite3 module
+// becomes available on a binary that was previously built without it.
+func (db *DB) RebuildFTS(ctx context.Context) error {
+	if _, err := db.ExecContext(ctx, "DROP TABLE IF EXISTS emails_fts"); err != nil {
+		return fmt.Errorf("dropping emails_fts: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, "DROP TABLE IF EXISTS conversations_fts"); err != nil {
+		return fmt.Errorf("dropping conversations_fts: %w", err)
+	}
+	if err := db.createFTS(ctx); err != nil {
+		db.fts = false
+		return fmt.Errorf("recreating fts5 tables: %w", err)
+	}
+	db.fts = true
+	if err := db.backfillFTS(ctx); err != nil {
+		return fmt.Errorf("backfilling fts5 tables: %w", err)
+	}
+	return nil
+}
+
+// ftsPlan decides whether crit can be served by FullTextSearch (ranked,
+// FTS5-backed) instead of Query's own LIKE-based buildCriteriaClause scan:
+// it has to boil down to one or more bare full-text terms ANDed together -
+// what ParseQuery produces for bare words via All - optionally alongside
+// the handful of filters SearchOptions also understands (StatusIn, Since,
+// Before, IncludeArchived). Anything else - glob/field predicates
+// (Company, Subject, ...) that don't map onto an FTS5 column filter the
+// same way, Any/Not groups, confidence/layer filters - falls back to the
+// LIKE scan, same as FullTextSearch itself falling back on a query syntax
+// error.
+func ftsPlan(crit SearchCriteria) (SearchOptions, bool) {
+	if crit.Any != nil || crit.Not != nil {
+		return SearchOptions{}, false
+	}
+	if crit.ID != nil || len(crit.IDIn) > 0 || crit.Company != nil || crit.Domain != nil ||
+		crit.Recruiter != nil || crit.Position != nil || crit.To != nil || crit.Subject != nil ||
+		crit.Status != nil || crit.Direction != nil || crit.HasRecruiter || crit.StaleOnly ||
+		crit.BouncedOnly || crit.ReviewSuggested != nil || crit.MinEmailCount != nil ||
+		crit.MaxEmailCount != nil || crit.MinConfidence != nil || crit.MaxConfidence != nil ||
+		crit.Layer != nil || crit.HasClassification != nil || crit.ArchivedOnly {
+		return SearchOptions{}, false
+	}
+
+	var terms []string
+	if crit.FullText != nil {
+		terms = append(terms, *crit.FullText)
+	}
+	for _, sub := range crit.All {
+		subOpts, ok := ftsPlan(sub)
+		if !ok || subOpts.Query == "" {
+			return SearchOptions{}, false
+		}
+		terms = append(terms, subOpts.Query)
+		if subOpts.Since != nil {
+			crit.Since = subOpts.Since
+		}
+		if subOpts.Before != nil {
+			crit.Before = subOpts.Before
+		}
+		if len(subOpts.StatusIn) > 0 {
+			crit.StatusIn = subOpts.StatusIn
+		}
+		crit.IncludeArchived = crit.IncludeArchived || subOpts.IncludeArchived
+	}
+	if len(terms) == 0 {
+		return SearchOptions{}, false
+	}
+
+	return SearchOptions{
+		Query:           strings.Join(terms, " "),
+		StatusIn:        crit.StatusIn,
+		Since:           crit.Since,
+		Before:          crit.Before,
+		IncludeArchived: crit.IncludeArchived,
+		Limit:           crit.Limit,
+		Offset:          crit.Offset,
+	}, true
+}
+
+// ftsTableMatches runs an FTS5 MATCH query expected to yield (conversation
+// ID, bm25 rank, snippet) rows and collects them. A query error (e.g. a
+// column filter naming a column this table doesn't have) is returned to the
+// caller rather than swallowed here, since FullTextSearch treats the two
+// tables' errors independently.
+func (db *DB) ftsTableMatches(ctx context.Context, query string, args ...interface{}) ([]ftsMatch, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []ftsMatch
+	for rows.Next() {
+		var m ftsMatch
+		if err := rows.Scan(&m.convID, &m.rank, &m.snippet); err != nil {
+			return nil, err
+		}
+		matches = append(matches, m)
+	}
+	return matches, rows.Err()
+}
@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpsertInterview records iv against its conversation, keyed by
+// (conversation_id, uid): a first sighting of a UID inserts a new row,
+// while a later sighting (a reschedule with a higher Sequence, or a
+// cancellation) updates the existing one in place rather than creating a
+// duplicate. UpdatedAt is always refreshed; CreatedAt only on insert.
+func (db *DB) UpsertInterview(ctx context.Context, iv *Interview) error {
+	if iv.ID == "" {
+		iv.ID = uuid.New().String()
+	}
+
+	var interviewersJSON string
+	if len(iv.Interviewers) > 0 {
+		b, err := json.Marshal(iv.Interviewers)
+		if err != nil {
+			return err
+		}
+		interviewersJSON = string(b)
+	}
+
+	now := time.Now()
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO interviews (
+			id, conversation_id, uid, sequence, summary, location, video_url,
+			organizer, interviewers, start_time, end_time, all_day, status,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(conversation_id, uid) DO UPDATE SET
+			sequence      = excluded.sequence,
+			summary       = excluded.summary,
+			location      = excluded.location,
+			video_url     = excluded.video_url,
+			organizer     = excluded.organizer,
+			interviewers  = excluded.interviewers,
+			start_time    = excluded.start_time,
+			end_time      = excluded.end_time,
+			all_day       = excluded.all_day,
+			status        = excluded.status,
+			updated_at    = excluded.updated_at
+	`, iv.ID, iv.ConversationID, iv.UID, iv.Sequence, iv.Summary, NullString(iv.Location),
+		NullString(iv.VideoURL), NullString(iv.Organizer), NullString(strPtrOrNil(interviewersJSON)),
+		iv.StartTime, iv.EndTime, iv.AllDay, iv.Status, now, now)
+	return err
+}
+
+// strPtrOrNil returns nil for an empty string, so NullString stores a SQL
+// NULL rather than an empty-string interviewers column when there are none.
+func strPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// CancelInterview marks every scheduled interview with the given UID under
+// conversationID as cancelled, for a calendar METHOD=CANCEL that arrives
+// after the row already exists under a different flow than UpsertInterview
+// (e.g. a cancellation forwarded without the original invite's full
+// details).
+func (db *DB) CancelInterview(ctx context.Context, conversationID, uid string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE interviews SET status = 'cancelled', updated_at = ?
+		WHERE conversation_id = ? AND uid = ?
+	`, time.Now(), conversationID, uid)
+	return err
+}
+
+// ListInterviews returns every interview recorded against conversationID,
+// earliest start first.
+func (db *DB) ListInterviews(ctx context.Context, conversationID string) ([]Interview, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, conversation_id, uid, sequence, summary, location, video_url,
+		       organizer, interviewers, start_time, end_time, all_day, status,
+		       created_at, updated_at
+		FROM interviews WHERE conversation_id = ? ORDER BY start_time ASC
+	`, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanInterviews(rows)
+}
+
+// ListUpcomingInterviews returns every non-cancelled interview starting at
+// or after from, soonest first - the feed behind "jobsearch interviews
+// upcoming" and the upcoming_interviews MCP tool.
+func (db *DB) ListUpcomingInterviews(ctx context.Context, from time.Time) ([]Interview, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, conversation_id, uid, sequence, summary, location, video_url,
+		       organizer, interviewers, start_time, end_time, all_day, status,
+		       created_at, updated_at
+		FROM interviews WHERE status = 'scheduled' AND start_time >= ?
+		ORDER BY start_time ASC
+	`, from)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanInterviews(rows)
+}
+
+func scanInterviews(rows *sql.Rows) ([]Interview, error) {
+	var interviews []Interview
+	for rows.Next() {
+		var iv Interview
+		var location, videoURL, organizer, interviewersJSON sql.NullString
+		if err := rows.Scan(&iv.ID, &iv.ConversationID, &iv.UID, &iv.Sequence, &iv.Summary,
+			&location, &videoURL, &organizer, &interviewersJSON,
+			&iv.StartTime, &iv.EndTime, &iv.AllDay, &iv.Status, &iv.CreatedAt, &iv.UpdatedAt); err != nil {
+			return nil, err
+		}
+		iv.Location = StringPtr(location)
+		iv.VideoURL = StringPtr(videoURL)
+		iv.Organizer = StringPtr(organizer)
+		if interviewersJSON.Valid && interviewersJSON.String != "" {
+			_ = json.Unmarshal([]byte(interviewersJSON.String), &iv.Interviewers)
+		}
+		interviews = append(interviews, iv)
+	}
+	return interviews, rows.Err()
+}
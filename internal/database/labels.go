@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// UpsertClassificationLabel records (or overwrites) the gold label for one
+// email. Re-labeling an email - e.g. the user importing a CSV after having
+// hand-labeled a few - replaces the previous label rather than erroring.
+func (db *DB) UpsertClassificationLabel(ctx context.Context, l ClassificationLabel) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO classification_labels (email_id, gold_label, source, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(email_id) DO UPDATE SET
+			gold_label = excluded.gold_label,
+			source = excluded.source,
+			created_at = excluded.created_at
+	`, l.EmailID, l.GoldLabel, l.Source, l.CreatedAt)
+	return err
+}
+
+// GetClassificationLabel retrieves the gold label for an email, if any.
+func (db *DB) GetClassificationLabel(ctx context.Context, emailID string) (*ClassificationLabel, error) {
+	l := &ClassificationLabel{}
+	err := db.QueryRowContext(ctx, `
+		SELECT email_id, gold_label, source, created_at
+		FROM classification_labels WHERE email_id = ?
+	`, emailID).Scan(&l.EmailID, &l.GoldLabel, &l.Source, &l.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// GetClassificationMetrics returns one row per day (most recent first) for
+// every day since the given time that had at least one email processed.
+// AutoIncluded counts emails the whitelist/keyword layers let through
+// without LLM involvement; Validated counts emails the LLM classified.
+// FalsePositivesMarked counts gold labels of BayesClassJunk created that
+// day - see ClassificationMetrics for why Excluded is always 0.
+func (db *DB) GetClassificationMetrics(ctx context.Context, since time.Time) ([]ClassificationMetrics, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT
+			DATE(e.created_at) AS day,
+			COUNT(*) AS processed,
+			SUM(CASE WHEN e.classification IN ('whitelist', 'keyword') THEN 1 ELSE 0 END) AS auto_included,
+			SUM(CASE WHEN e.classification = 'llm' THEN 1 ELSE 0 END) AS validated,
+			COALESCE((
+				SELECT COUNT(*) FROM classification_labels cl
+				WHERE cl.gold_label = ? AND DATE(cl.created_at) = DATE(e.created_at)
+			), 0) AS false_positives_marked
+		FROM emails e
+		WHERE e.created_at >= ?
+		GROUP BY DATE(e.created_at)
+		ORDER BY day DESC
+	`, BayesClassJunk, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var metrics []ClassificationMetrics
+	for rows.Next() {
+		var m ClassificationMetrics
+		var day string
+		if err := rows.Scan(&day, &m.EmailsProcessed, &m.AutoIncluded, &m.Validated, &m.FalsePositivesMarked); err != nil {
+			return nil, err
+		}
+		m.Date, err = time.Parse("2006-01-02", day)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, m)
+	}
+
+	return metrics, rows.Err()
+}
+
+// GetClassificationEval joins every ClassificationLabel created since the
+// given time against the classification the pipeline assigned its email,
+// and scores the result. See ClassificationEval's doc comment for why
+// FalseNegatives is always 0.
+func (db *DB) GetClassificationEval(ctx context.Context, since time.Time) (*ClassificationEval, error) {
+	var truePositives, falsePositives sql.NullInt64
+	err := db.QueryRowContext(ctx, `
+		SELECT
+			SUM(CASE WHEN cl.gold_label = ? THEN 1 ELSE 0 END),
+			SUM(CASE WHEN cl.gold_label = ? THEN 1 ELSE 0 END)
+		FROM classification_labels cl
+		JOIN emails e ON e.id = cl.email_id
+		WHERE cl.created_at >= ?
+	`, BayesClassGood, BayesClassJunk, since).Scan(&truePositives, &falsePositives)
+	if err != nil {
+		return nil, err
+	}
+
+	eval := &ClassificationEval{
+		Labeled:        int(truePositives.Int64 + falsePositives.Int64),
+		TruePositives:  int(truePositives.Int64),
+		FalsePositives: int(falsePositives.Int64),
+	}
+	if eval.TruePositives+eval.FalsePositives > 0 {
+		eval.Precision = float64(eval.TruePositives) / float64(eval.TruePositives+eval.FalsePositives)
+	}
+	if eval.TruePositives+eval.FalseNegatives > 0 {
+		eval.Recall = float64(eval.TruePositives) / float64(eval.TruePositives+eval.FalseNegatives)
+	}
+	if eval.Precision+eval.Recall > 0 {
+		eval.F1 = 2 * eval.Precision * eval.Recall / (eval.Precision + eval.Recall)
+	}
+
+	return eval, nil
+}
@@ -0,0 +1,272 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// schemaMigrationsTable tracks which migrations have been applied, their
+// checksum (for drift detection against the embedded SQL), and when.
+const schemaMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    TEXT PRIMARY KEY,
+	name       TEXT NOT NULL,
+	checksum   TEXT NOT NULL,
+	applied_at DATETIME NOT NULL
+)`
+
+// Migration is one versioned, reversible schema change, loaded from a
+// <version>_<name>.up.sql/.down.sql pair under internal/database/migrations.
+type Migration struct {
+	Version  string
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// AppliedMigration is a row of the schema_migrations table.
+type AppliedMigration struct {
+	Version   string
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// MigrationStatus pairs an embedded migration with whether (and when) it
+// has been applied to this database, for `jobsearch db status`.
+type MigrationStatus struct {
+	Version   string     `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+var migrationFilePattern = regexp.MustCompile(`^([0-9]+[a-z]?)_(.+)\.(up|down)\.sql$`)
+
+// loadMigrations reads every migration pair embedded under migrations/,
+// sorted by version. Version strings sort correctly as plain strings
+// because shorter prefixes (e.g. "004") always precede longer ones that
+// share them (e.g. "004b") under byte-wise comparison.
+func loadMigrations() ([]Migration, error) {
+	entries, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	byVersion := make(map[string]*Migration)
+	for _, entry := range entries {
+		m := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		version, name, direction := m[1], m[2], m[3]
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: name}
+			byVersion[version] = mig
+		}
+
+		content, err := migrationFiles.ReadFile("migrations/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		switch direction {
+		case "up":
+			mig.Up = string(content)
+		case "down":
+			mig.Down = string(content)
+		}
+	}
+
+	versions := make([]string, 0, len(byVersion))
+	for v, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migration %s is missing its .up.sql file", v)
+		}
+		mig.Checksum = checksum(mig.Up)
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+
+	migrations := make([]Migration, len(versions))
+	for i, v := range versions {
+		migrations[i] = *byVersion[v]
+	}
+	return migrations, nil
+}
+
+func checksum(sql string) string {
+	sum := sha256.Sum256([]byte(sql))
+	return hex.EncodeToString(sum[:])
+}
+
+func (db *DB) appliedMigrations(ctx context.Context) (map[string]AppliedMigration, error) {
+	rows, err := db.QueryContext(ctx, `SELECT version, name, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[string]AppliedMigration)
+	for rows.Next() {
+		var a AppliedMigration
+		if err := rows.Scan(&a.Version, &a.Name, &a.Checksum, &a.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied[a.Version] = a
+	}
+	return applied, rows.Err()
+}
+
+// Migrate applies every pending migration up to and including `to`
+// (by version string), or every pending migration when to is "". Before
+// applying anything it refuses to proceed if an already-applied
+// migration's checksum no longer matches the embedded SQL, since that
+// means the migration file changed after being run against this database.
+func (db *DB) Migrate(ctx context.Context, to string) error {
+	if _, err := db.ExecContext(ctx, schemaMigrationsTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	for _, m := range migrations {
+		if a, ok := applied[m.Version]; ok && a.Checksum != m.Checksum {
+			return fmt.Errorf("migration %s_%s has changed since it was applied (checksum mismatch) - this database may be out of sync with the code", m.Version, m.Name)
+		}
+	}
+
+	for _, m := range migrations {
+		if to != "" && m.Version > to {
+			break
+		}
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+
+		if err := db.Transaction(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, m.Up); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO schema_migrations (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)`,
+				m.Version, m.Name, m.Checksum, time.Now(),
+			)
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to apply migration %s_%s: %w", m.Version, m.Name, err)
+		}
+
+		if to != "" && m.Version == to {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Rollback reverts the `steps` most recently applied migrations, in
+// reverse order, running each one's .down.sql.
+func (db *DB) Rollback(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive")
+	}
+
+	if _, err := db.ExecContext(ctx, schemaMigrationsTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+	byVersion := make(map[string]Migration, len(migrations))
+	for _, m := range migrations {
+		byVersion[m.Version] = m
+	}
+
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	var versions []string
+	for v := range applied {
+		versions = append(versions, v)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(versions)))
+	if steps > len(versions) {
+		steps = len(versions)
+	}
+
+	for _, version := range versions[:steps] {
+		m, ok := byVersion[version]
+		if !ok {
+			return fmt.Errorf("applied migration %s has no corresponding migration file to roll back", version)
+		}
+		if m.Down == "" {
+			return fmt.Errorf("migration %s_%s has no .down.sql and cannot be rolled back", m.Version, m.Name)
+		}
+
+		if err := db.Transaction(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, m.Down); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = ?`, m.Version)
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to roll back migration %s_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// MigrationStatus reports every embedded migration alongside whether (and
+// when) it has been applied to this database.
+func (db *DB) MigrationStatus(ctx context.Context) ([]MigrationStatus, error) {
+	if _, err := db.ExecContext(ctx, schemaMigrationsTable); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	statuses := make([]MigrationStatus, len(migrations))
+	for i, m := range migrations {
+		s := MigrationStatus{Version: m.Version, Name: m.Name}
+		if a, ok := applied[m.Version]; ok {
+			s.Applied = true
+			appliedAt := a.AppliedAt
+			s.AppliedAt = &appliedAt
+		}
+		statuses[i] = s
+	}
+	return statuses, nil
+}
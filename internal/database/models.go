@@ -15,6 +15,15 @@ const (
 	StatusWaitingOnThem ConversationStatus = "waiting_on_them"
 	StatusStale         ConversationStatus = "stale"
 	StatusClosed        ConversationStatus = "closed"
+	// StatusBounced marks a conversation whose recruiter address has
+	// produced enough hard bounces (config.Tracking.HardBounceLimit) that
+	// the tracker considers it dead and stops suggesting follow-ups.
+	StatusBounced ConversationStatus = "bounced"
+	// StatusInterviewScheduled marks a conversation with at least one
+	// non-cancelled Interview row, set when Tracker.handleInterviews sees a
+	// calendar invite and cleared back to StatusActive once every
+	// interview it knows about has passed or been cancelled.
+	StatusInterviewScheduled ConversationStatus = "interview_scheduled"
 )
 
 // Direction represents email direction
@@ -27,17 +36,132 @@ const (
 
 // Conversation represents a job search conversation thread
 type Conversation struct {
+	ID              string             `json:"id"`
+	Company         string             `json:"company"`
+	Position        *string            `json:"position,omitempty"`
+	RecruiterName   *string            `json:"recruiter_name,omitempty"`
+	RecruiterEmail  *string            `json:"recruiter_email,omitempty"`
+	Direction       Direction          `json:"direction"`
+	Status          ConversationStatus `json:"status"`
+	LastActivityAt  time.Time          `json:"last_activity_at"`
+	EmailCount      int                `json:"email_count"`
+	Archived        bool               `json:"archived"`
+	ReviewSuggested bool               `json:"review_suggested"`
+	SnoozedUntil    *time.Time         `json:"snoozed_until,omitempty"`
+	Bounced         bool               `json:"bounced"`
+	BounceType      *string            `json:"bounce_type,omitempty"`    // "hard" or "soft"
+	BounceAddress   *string            `json:"bounce_address,omitempty"` // recipient address that bounced
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+// Bounce is one parsed delivery-status notification (RFC 3464) detected
+// during sync and correlated back to the conversation whose outbound email
+// failed to deliver - the audit trail behind Conversation's bounced/
+// bounce_type/bounce_address summary fields.
+type Bounce struct {
+	ID             string    `json:"id"`
+	ConversationID string    `json:"conversation_id"`
+	Recipient      string    `json:"recipient"`
+	Type           string    `json:"type"` // "hard" or "soft"
+	Diagnostic     *string   `json:"diagnostic,omitempty"`
+	ReceivedAt     time.Time `json:"received_at"`
+}
+
+// Interview is one scheduled (or cancelled) interview detected from a
+// calendar invite attached to a conversation's email, via
+// internal/calendar and Tracker.handleInterviews. UID+ConversationID
+// uniquely identify it, so re-ingesting the same invite (or a reschedule
+// carrying a higher Sequence) updates the row in place instead of creating
+// a duplicate.
+type Interview struct {
+	ID             string    `json:"id"`
+	ConversationID string    `json:"conversation_id"`
+	UID            string    `json:"uid"`
+	Sequence       int       `json:"sequence"`
+	Summary        string    `json:"summary"`
+	Location       *string   `json:"location,omitempty"`
+	VideoURL       *string   `json:"video_url,omitempty"`
+	Organizer      *string   `json:"organizer,omitempty"`
+	Interviewers   []string  `json:"interviewers,omitempty"` // attendee emails, excluding Organizer
+	StartTime      time.Time `json:"start_time"`
+	EndTime        time.Time `json:"end_time"`
+	AllDay         bool      `json:"all_day"`
+	Status         string    `json:"status"` // "scheduled" or "cancelled"
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// ReminderAction identifies the sink a Reminder fires through - see
+// reminder.Manager.
+type ReminderAction string
+
+const (
+	ReminderActionDesktop    ReminderAction = "desktop"
+	ReminderActionDraftReply ReminderAction = "draft-reply"
+	ReminderActionWebhook    ReminderAction = "webhook"
+)
+
+// ReminderStatus tracks a Reminder through its lifecycle.
+type ReminderStatus string
+
+const (
+	ReminderStatusPending   ReminderStatus = "pending"
+	ReminderStatusFired     ReminderStatus = "fired"
+	ReminderStatusCancelled ReminderStatus = "cancelled"
+)
+
+// Reminder is a scheduled follow-up against a conversation, fired by
+// reminder.Manager's sweep once FireAt passes. Params is an opaque JSON
+// blob whose shape depends on Action (see reminder.Sink implementations);
+// the database package doesn't interpret it, the same way
+// classification_cache's response column is opaque to avoid a
+// database->reminder import.
+type Reminder struct {
+	ID             string         `json:"id"`
+	ConversationID string         `json:"conversation_id"`
+	FireAt         time.Time      `json:"fire_at"`
+	Action         ReminderAction `json:"action"`
+	Params         string         `json:"params,omitempty"`
+	Note           string         `json:"note,omitempty"`
+	// AnchorStatus, if set, is the status the conversation must still be
+	// in at fire time for the reminder to fire - see reminder.Manager.
+	AnchorStatus ConversationStatus `json:"anchor_status,omitempty"`
+	Status       ReminderStatus     `json:"status"`
+	CreatedAt    time.Time          `json:"created_at"`
+}
+
+// EmailCommand is a "#jobsearch ..." inbox directive (see
+// internal/email/commands) that Tracker.applyInboxCommand has already
+// applied to a conversation. It's keyed by the triggering Message-ID so a
+// resync that refetches the same self-reply doesn't apply it twice.
+type EmailCommand struct {
+	ID             string    `json:"id"`
+	MessageID      string    `json:"message_id"`
+	ConversationID string    `json:"conversation_id"`
+	Command        string    `json:"command"`
+	Arg            string    `json:"arg,omitempty"`
+	AppliedAt      time.Time `json:"applied_at"`
+}
+
+// StatusTransition is one content-based status change Tracker.
+// applyContentTransition applied from an inbound reply (see
+// internal/transitions), keyed by the triggering Message-ID so
+// "jobsearch feedback rollback-transition" can find and undo it.
+type StatusTransition struct {
 	ID             string             `json:"id"`
-	Company        string             `json:"company"`
-	Position       *string            `json:"position,omitempty"`
-	RecruiterName  *string            `json:"recruiter_name,omitempty"`
-	RecruiterEmail *string            `json:"recruiter_email,omitempty"`
-	Direction      Direction          `json:"direction"`
-	Status         ConversationStatus `json:"status"`
-	LastActivityAt time.Time          `json:"last_activity_at"`
-	EmailCount     int                `json:"email_count"`
-	CreatedAt      time.Time          `json:"created_at"`
-	UpdatedAt      time.Time          `json:"updated_at"`
+	ConversationID string             `json:"conversation_id"`
+	MessageID      string             `json:"message_id"`
+	RuleName       string             `json:"rule_name"`
+	FromStatus     ConversationStatus `json:"from_status"`
+	ToStatus       ConversationStatus `json:"to_status"`
+	Confidence     float64            `json:"confidence"`
+	AppliedAt      time.Time          `json:"applied_at"`
+}
+
+// IsSnoozed returns true if the conversation has a snooze in effect
+func (c *Conversation) IsSnoozed() bool {
+	return c.SnoozedUntil != nil && c.SnoozedUntil.After(time.Now())
 }
 
 // DaysSinceActivity returns the number of days since last activity
@@ -45,9 +169,10 @@ func (c *Conversation) DaysSinceActivity() int {
 	return int(time.Since(c.LastActivityAt).Hours() / 24)
 }
 
-// IsStale returns true if the conversation is older than the given days
+// IsStale returns true if the conversation is older than the given days and
+// not currently snoozed
 func (c *Conversation) IsStale(days int) bool {
-	return c.DaysSinceActivity() > days
+	return c.DaysSinceActivity() > days && !c.IsSnoozed()
 }
 
 // Email represents a single email message
@@ -68,6 +193,9 @@ type Email struct {
 	Classification *string   `json:"classification,omitempty"`
 	Confidence     *float64  `json:"confidence,omitempty"`
 	ExtractedData  *string   `json:"extracted_data,omitempty"`
+	MessageID      *string   `json:"message_id,omitempty"`
+	InReplyTo      *string   `json:"in_reply_to,omitempty"`
+	References     *string   `json:"-"` // JSON-encoded []string; use GetReferences
 	CreatedAt      time.Time `json:"created_at"`
 }
 
@@ -83,22 +211,161 @@ func (e *Email) GetExtractedData() (map[string]interface{}, error) {
 	return data, nil
 }
 
+// GetReferences parses the email's References chain (oldest ancestor
+// first), as recorded from the message's References header.
+func (e *Email) GetReferences() ([]string, error) {
+	if e.References == nil {
+		return nil, nil
+	}
+	var refs []string
+	if err := json.Unmarshal([]byte(*e.References), &refs); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
 // SyncState tracks the sync progress
 type SyncState struct {
-	ID              int        `json:"id"`
-	LastSyncAt      *time.Time `json:"last_sync_at,omitempty"`
-	LastHistoryID   *string    `json:"last_history_id,omitempty"`
-	EmailsProcessed int        `json:"emails_processed"`
+	ID         int        `json:"id"`
+	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	// LastHistoryID holds a state token whose format depends on Backend: a
+	// Gmail history ID, a JMAP account state string, etc. The sync loop
+	// that reads it already knows which backend it's running, so the two
+	// fields are never interpreted independently of each other.
+	LastHistoryID   *string `json:"last_history_id,omitempty"`
+	Backend         string  `json:"backend"`
+	EmailsProcessed int     `json:"emails_processed"`
 }
 
 // LearnedFilter represents a user or AI-learned filter
 type LearnedFilter struct {
-	ID         string    `json:"id"`
-	FilterType string    `json:"filter_type"`
-	Value      string    `json:"value"`
-	Source     string    `json:"source"`
-	Confidence *float64  `json:"confidence,omitempty"`
-	CreatedAt  time.Time `json:"created_at"`
+	ID                 string   `json:"id"`
+	FilterType         string   `json:"filter_type"`
+	Value              string   `json:"value"`
+	Source             string   `json:"source"`
+	Confidence         *float64 `json:"confidence,omitempty"`
+	FalsePositiveCount int      `json:"false_positive_count"`
+	SupportCount       int      `json:"support_count"`
+	// SupportSinceFP counts consecutive supporting emails since the last
+	// false positive, reset to 0 by IncrementFilterFalsePositive - a clean
+	// run length distinct from SupportCount's all-time total.
+	SupportSinceFP int        `json:"support_since_fp"`
+	LastSeen       *time.Time `json:"last_seen,omitempty"`
+	// MatchCount/LastMatchedAt are bumped by IncrementMatchCount each time
+	// this filter actually fires (distinct from SupportCount, which tracks
+	// evidence gathered while the filter was still ai_suggested), so a user
+	// can tell which confirmed filters are pulling their weight.
+	MatchCount    int        `json:"match_count"`
+	LastMatchedAt *time.Time `json:"last_matched_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// Precision estimates how trustworthy this filter's signal is from
+// downstream feedback: the fraction of supporting emails since its last
+// false positive that didn't themselves turn out to be false positives.
+// A filter with no support yet has no evidence either way, so Precision
+// returns 0 rather than 1 - shouldPromote's evidence_count gate keeps it
+// from being promoted on that alone.
+func (f *LearnedFilter) Precision() float64 {
+	if f.SupportCount == 0 {
+		return 0
+	}
+	return float64(f.SupportSinceFP) / float64(f.SupportCount)
+}
+
+// DigestQueueEntry records that a conversation had an event (new, stage
+// change, etc.) since the user's digest was last sent. digest.Run drains
+// these, grouped by conversation, into one combined email.
+type DigestQueueEntry struct {
+	ID             string    `json:"id"`
+	UserEmail      string    `json:"user_email"`
+	ConversationID string    `json:"conversation_id"`
+	EventType      string    `json:"event_type"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// OptOut records an address that should never reach a conversation again,
+// whether because the sender asked to be unsubscribed or the user opted a
+// recruiter out via Tracker.OptOutRecruiter.
+type OptOut struct {
+	Email          string    `json:"email"`
+	Source         string    `json:"source"` // "header", "body", or "command"
+	ConversationID *string   `json:"conversation_id,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// MailWatermark is the last processed cursor for one mail address, used by
+// internal/inbound to fetch only what's new since the last push or poll.
+type MailWatermark struct {
+	Address   string    `json:"address"`
+	Cursor    string    `json:"cursor"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NotifyRule is the runtime state for one notify.Rule: whether it's
+// currently active (an approve/reject lifecycle mirroring LearnedFilter)
+// and the last time it fired, so notify.RuleEngine can enforce each rule's
+// configured throttle across process restarts. The rule's Name, Condition,
+// Notifiers, and Throttle themselves live in config.NotifyConfig.Rules -
+// this table only tracks what can't be known from config alone.
+type NotifyRule struct {
+	Name        string     `json:"name"`
+	Condition   string     `json:"condition"`
+	Active      bool       `json:"active"`
+	LastFiredAt *time.Time `json:"last_fired_at,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ClassificationLabel is a gold label for one stored email, set via
+// "jobsearch label" independent of whatever the filter/LLM pipeline
+// predicted for it. GoldLabel is BayesClassGood or BayesClassJunk, reusing
+// the same vocabulary the Bayesian classifier trains on. "jobsearch stats
+// --classification --eval" joins these against Email.Classification to
+// score the pipeline's predictions.
+type ClassificationLabel struct {
+	EmailID   string    `json:"email_id"`
+	GoldLabel string    `json:"gold_label"`
+	Source    string    `json:"source"` // "user" or "imported"
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Label sources for ClassificationLabel.Source
+const (
+	LabelSourceUser     = "user"
+	LabelSourceImported = "imported"
+)
+
+// ClassificationMetrics is one day's worth of classification activity,
+// returned by DB.GetClassificationMetrics. Excluded is always 0: the filter
+// pipeline only ever persists emails it decided to include (see
+// filter.FilterIncluded), so an excluded email never reaches the emails
+// table and can't be counted here.
+type ClassificationMetrics struct {
+	Date                 time.Time `json:"date"`
+	EmailsProcessed      int       `json:"emails_processed"`
+	AutoIncluded         int       `json:"auto_included"`
+	Validated            int       `json:"validated"`
+	Excluded             int       `json:"excluded"`
+	FalsePositivesMarked int       `json:"false_positives_marked"`
+}
+
+// ClassificationEval is a precision/recall/F1 report computed by joining
+// gold ClassificationLabel rows against the classification the pipeline
+// actually assigned each labeled email. Because only included emails are
+// ever persisted (see ClassificationMetrics), every labeled email is, by
+// construction, a predicted-positive - this can surface false positives
+// (junk-labeled emails the pipeline let through) but not false negatives
+// (job emails the pipeline discarded before they reached the database).
+// FalseNegatives is therefore always 0 and Recall/F1 should be read with
+// that caveat.
+type ClassificationEval struct {
+	Labeled        int     `json:"labeled"`
+	TruePositives  int     `json:"true_positives"`  // gold=good, predicted=job
+	FalsePositives int     `json:"false_positives"` // gold=junk, predicted=job
+	FalseNegatives int     `json:"false_negatives"` // gold=good, predicted=not job - unreachable today, see type doc
+	Precision      float64 `json:"precision"`
+	Recall         float64 `json:"recall"`
+	F1             float64 `json:"f1"`
 }
 
 // Stats represents aggregate statistics
@@ -131,12 +398,95 @@ type CompanyGroup struct {
 
 // ListOptions contains options for listing conversations
 type ListOptions struct {
-	Status    *ConversationStatus
-	Direction *Direction
-	Since     *time.Time
-	Company   *string
-	Limit     int
-	Offset    int
+	Status          *ConversationStatus
+	Direction       *Direction
+	Since           *time.Time
+	Company         *string
+	BouncedOnly     bool
+	Limit           int
+	Offset          int
+	IncludeArchived bool
+}
+
+// SearchCriteria is the unified predicate for Query. It replaces the
+// company/ID/search-string resolution chain that list, archive, unarchive,
+// and bulk used to each implement separately - callers set only the fields
+// they need, and Query builds the matching WHERE clause.
+type SearchCriteria struct {
+	ID           *string  // exact conversation ID
+	IDIn         []string // c.id IN (...), for fanning a pre-computed ID set (e.g. FullTextSearch's matches) back through the usual filters
+	Company      *string  // glob pattern, e.g. "Stripe*" (* matches any run of characters)
+	Domain       *string  // sender/recruiter email domain, e.g. "recruiter.com"
+	Recruiter    *string  // glob pattern over recruiter name or email, e.g. "jane*"
+	Position     *string  // glob pattern over the conversation's position, e.g. "*Engineer*"
+	To           *string  // glob pattern over the joined email's to_address
+	Subject      *string  // glob pattern over the joined email's subject, distinct from FullText's broader match
+	Status       *ConversationStatus
+	StatusIn     []ConversationStatus // status IN (...), AND-ed with Status if both are set
+	Direction    *Direction
+	Since        *time.Time // last_activity_at >= Since
+	Before       *time.Time // last_activity_at <= Before
+	HasRecruiter bool       // only conversations with a non-empty recruiter email
+	StaleOnly    bool       // only conversations with status = stale
+	BouncedOnly  bool       // only conversations with the bounced health flag set
+
+	ReviewSuggested *bool // conversation.review_suggested = true/false
+	MinEmailCount   *int  // conversation.email_count >= MinEmailCount
+	MaxEmailCount   *int  // conversation.email_count <= MaxEmailCount
+
+	// MinConfidence/MaxConfidence/Layer/HasClassification filter on the
+	// conversation's joined emails (classification/confidence, as set by
+	// classifier.Client) rather than the conversation itself.
+	MinConfidence     *float64
+	MaxConfidence     *float64
+	Layer             *string // classification layer, e.g. "whitelist", "keyword", "llm"
+	HasClassification *bool
+
+	IncludeArchived bool    // include archived conversations (excluded by default)
+	ArchivedOnly    bool    // only archived conversations
+	FullText        *string // matches company/position/recruiter/subject, like Search
+
+	// All/Any/Not compose this criteria's flat fields (always AND-ed
+	// together) with nested groups: All is AND-ed in, Any is OR-ed
+	// together as a single group, and Not is negated. See
+	// buildCriteriaClause.
+	All []SearchCriteria
+	Any []SearchCriteria
+	Not *SearchCriteria
+
+	Limit  int
+	Offset int
+}
+
+// EmailSearchCriteria is the emails-table counterpart to SearchCriteria, for
+// searching within a conversation's messages (or across all of them) rather
+// than at the conversation level.
+type EmailSearchCriteria struct {
+	ConversationID *string
+	Direction      *Direction
+	Layer          *string // classification layer, e.g. "whitelist", "keyword", "llm"
+	MinConfidence  *float64
+	MaxConfidence  *float64
+	Since          *time.Time // date >= Since
+	Before         *time.Time // date <= Before
+	TextQuery      *string    // matches subject/snippet/extracted_data
+	Limit          int
+	Offset         int
+}
+
+// ArchiveResult is returned by ArchiveConversation and UnarchiveConversation
+type ArchiveResult struct {
+	ConversationID string `json:"conversation_id"`
+	Company        string `json:"company"`
+	Archived       bool   `json:"archived"`
+}
+
+// MergeResult is returned by MergeConversations
+type MergeResult struct {
+	TargetID    string `json:"target_id"`
+	SourceID    string `json:"source_id"`
+	EmailsMoved int    `json:"emails_moved"`
+	TotalEmails int    `json:"total_emails"`
 }
 
 // NullString is a helper to convert *string to sql.NullString
@@ -155,6 +505,14 @@ func NullFloat64(f *float64) sql.NullFloat64 {
 	return sql.NullFloat64{Float64: *f, Valid: true}
 }
 
+// nullTime is a helper to convert *time.Time to sql.NullTime
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
 // StringPtr converts sql.NullString to *string
 func StringPtr(ns sql.NullString) *string {
 	if !ns.Valid {
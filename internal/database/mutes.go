@@ -0,0 +1,38 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// IsMuted reports whether conversationID currently has an active
+// notification mute, set via the mute_notifications MCP tool. An expired
+// mute (until in the past) counts as not muted, the same semantics as
+// Conversation.IsSnoozed.
+func (db *DB) IsMuted(ctx context.Context, conversationID string) (bool, error) {
+	var until time.Time
+	err := db.QueryRowContext(ctx, `
+		SELECT until FROM notification_mutes WHERE conversation_id = ?
+	`, conversationID).Scan(&until)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return until.After(time.Now()), nil
+}
+
+// MuteNotifications silences notify.Scheduler and rule-matched delivery for
+// conversationID until the given time, overwriting any existing mute. A
+// past until effectively unmutes the conversation immediately.
+func (db *DB) MuteNotifications(ctx context.Context, conversationID string, until time.Time) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO notification_mutes (conversation_id, until, created_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(conversation_id) DO UPDATE SET until = excluded.until
+	`, conversationID, until, time.Now())
+	return err
+}
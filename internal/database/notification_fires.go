@@ -0,0 +1,32 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// GetNotificationFire returns the last time notify.Scheduler fired
+// eventType for conversationID, or the zero time if it never has.
+func (db *DB) GetNotificationFire(ctx context.Context, conversationID, eventType string) (time.Time, error) {
+	var firedAt time.Time
+	err := db.QueryRowContext(ctx, `
+		SELECT fired_at FROM notification_fires WHERE conversation_id = ? AND event_type = ?
+	`, conversationID, eventType).Scan(&firedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	return firedAt, err
+}
+
+// RecordNotificationFire records that notify.Scheduler fired eventType for
+// conversationID at t, overwriting any earlier record for the same pair.
+func (db *DB) RecordNotificationFire(ctx context.Context, conversationID, eventType string, t time.Time) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO notification_fires (conversation_id, event_type, fired_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(conversation_id, event_type) DO UPDATE SET fired_at = excluded.fired_at
+	`, conversationID, eventType, t)
+	return err
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// UpsertNotifyRule inserts or refreshes the runtime row for a configured
+// notify rule, called once per rule at startup so its condition stays in
+// sync with config.toml without disturbing an existing row's Active state
+// or LastFiredAt (a rule rejected via "jobsearch rules reject" stays
+// rejected across restarts even if its condition text changes).
+func (db *DB) UpsertNotifyRule(ctx context.Context, name, condition string) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO notify_rules (name, condition, active, updated_at)
+		VALUES (?, ?, 1, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			condition  = excluded.condition,
+			updated_at = excluded.updated_at
+	`, name, condition, time.Now())
+	return err
+}
+
+// GetNotifyRule retrieves the runtime state for one notify rule by name, or
+// nil if it hasn't been seen yet (UpsertNotifyRule hasn't run for it).
+func (db *DB) GetNotifyRule(ctx context.Context, name string) (*NotifyRule, error) {
+	r := &NotifyRule{}
+	var lastFired sql.NullTime
+
+	err := db.QueryRowContext(ctx, `
+		SELECT name, condition, active, last_fired_at, updated_at
+		FROM notify_rules WHERE name = ?
+	`, name).Scan(&r.Name, &r.Condition, &r.Active, &lastFired, &r.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if lastFired.Valid {
+		r.LastFiredAt = &lastFired.Time
+	}
+	return r, nil
+}
+
+// ListNotifyRules returns the runtime state of every known notify rule.
+func (db *DB) ListNotifyRules(ctx context.Context) ([]NotifyRule, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT name, condition, active, last_fired_at, updated_at
+		FROM notify_rules ORDER BY name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []NotifyRule
+	for rows.Next() {
+		var r NotifyRule
+		var lastFired sql.NullTime
+		if err := rows.Scan(&r.Name, &r.Condition, &r.Active, &lastFired, &r.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if lastFired.Valid {
+			r.LastFiredAt = &lastFired.Time
+		}
+		rules = append(rules, r)
+	}
+	return rules, rows.Err()
+}
+
+// SetNotifyRuleActive approves (active=true) or rejects (active=false) a
+// notify rule, mirroring ApproveLearnedFilter/DeleteLearnedFilter's
+// lifecycle - except rejecting a rule keeps the row (it's still defined in
+// config.toml) rather than deleting it, so a later "jobsearch rules
+// approve" can re-enable it without re-adding the config entry.
+func (db *DB) SetNotifyRuleActive(ctx context.Context, name string, active bool) error {
+	result, err := db.ExecContext(ctx, `
+		UPDATE notify_rules SET active = ?, updated_at = ? WHERE name = ?
+	`, active, time.Now(), name)
+	if err != nil {
+		return err
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("notify rule not found: %s", name)
+	}
+	return nil
+}
+
+// RecordNotifyRuleFired timestamps name's most recent fire, used to
+// enforce each rule's configured throttle.
+func (db *DB) RecordNotifyRuleFired(ctx context.Context, name string, at time.Time) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE notify_rules SET last_fired_at = ?, updated_at = ? WHERE name = ?
+	`, at, at, name)
+	return err
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -34,15 +35,18 @@ func (db *DB) CreateConversation(ctx context.Context, c *Conversation) error {
 // GetConversation retrieves a conversation by ID
 func (db *DB) GetConversation(ctx context.Context, id string) (*Conversation, error) {
 	c := &Conversation{}
-	var position, recruiterName, recruiterEmail sql.NullString
+	var position, recruiterName, recruiterEmail, bounceType, bounceAddress sql.NullString
+	var snoozedUntil sql.NullTime
 
 	err := db.QueryRowContext(ctx, `
 		SELECT id, company, position, recruiter_name, recruiter_email,
-		       direction, status, last_activity_at, email_count, created_at, updated_at
+		       direction, status, last_activity_at, email_count, archived, review_suggested, snoozed_until,
+		       bounced, bounce_type, bounce_address, created_at, updated_at
 		FROM conversations WHERE id = ?
 	`, id).Scan(
 		&c.ID, &c.Company, &position, &recruiterName, &recruiterEmail,
-		&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.CreatedAt, &c.UpdatedAt,
+		&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.Archived, &c.ReviewSuggested, &snoozedUntil,
+		&c.Bounced, &bounceType, &bounceAddress, &c.CreatedAt, &c.UpdatedAt,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -54,22 +58,30 @@ func (db *DB) GetConversation(ctx context.Context, id string) (*Conversation, er
 	c.Position = StringPtr(position)
 	c.RecruiterName = StringPtr(recruiterName)
 	c.RecruiterEmail = StringPtr(recruiterEmail)
+	c.BounceType = StringPtr(bounceType)
+	c.BounceAddress = StringPtr(bounceAddress)
+	if snoozedUntil.Valid {
+		c.SnoozedUntil = &snoozedUntil.Time
+	}
 	return c, nil
 }
 
 // GetConversationByCompany retrieves a conversation by company name (case-insensitive)
 func (db *DB) GetConversationByCompany(ctx context.Context, company string) (*Conversation, error) {
 	c := &Conversation{}
-	var position, recruiterName, recruiterEmail sql.NullString
+	var position, recruiterName, recruiterEmail, bounceType, bounceAddress sql.NullString
+	var snoozedUntil sql.NullTime
 
 	err := db.QueryRowContext(ctx, `
 		SELECT id, company, position, recruiter_name, recruiter_email,
-		       direction, status, last_activity_at, email_count, created_at, updated_at
+		       direction, status, last_activity_at, email_count, archived, review_suggested, snoozed_until,
+		       bounced, bounce_type, bounce_address, created_at, updated_at
 		FROM conversations WHERE LOWER(company) = LOWER(?)
 		ORDER BY last_activity_at DESC LIMIT 1
 	`, company).Scan(
 		&c.ID, &c.Company, &position, &recruiterName, &recruiterEmail,
-		&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.CreatedAt, &c.UpdatedAt,
+		&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.Archived, &c.ReviewSuggested, &snoozedUntil,
+		&c.Bounced, &bounceType, &bounceAddress, &c.CreatedAt, &c.UpdatedAt,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -81,6 +93,11 @@ func (db *DB) GetConversationByCompany(ctx context.Context, company string) (*Co
 	c.Position = StringPtr(position)
 	c.RecruiterName = StringPtr(recruiterName)
 	c.RecruiterEmail = StringPtr(recruiterEmail)
+	c.BounceType = StringPtr(bounceType)
+	c.BounceAddress = StringPtr(bounceAddress)
+	if snoozedUntil.Valid {
+		c.SnoozedUntil = &snoozedUntil.Time
+	}
 	return c, nil
 }
 
@@ -91,12 +108,14 @@ func (db *DB) UpdateConversation(ctx context.Context, c *Conversation) error {
 	result, err := db.ExecContext(ctx, `
 		UPDATE conversations SET
 			company = ?, position = ?, recruiter_name = ?, recruiter_email = ?,
-			direction = ?, status = ?, last_activity_at = ?, email_count = ?, updated_at = ?
+			direction = ?, status = ?, last_activity_at = ?, email_count = ?, snoozed_until = ?,
+			review_suggested = ?, bounced = ?, bounce_type = ?, bounce_address = ?, updated_at = ?
 		WHERE id = ?
 	`,
 		c.Company, NullString(c.Position), NullString(c.RecruiterName),
 		NullString(c.RecruiterEmail), c.Direction, c.Status,
-		c.LastActivityAt, c.EmailCount, c.UpdatedAt, c.ID,
+		c.LastActivityAt, c.EmailCount, nullTime(c.SnoozedUntil),
+		c.ReviewSuggested, c.Bounced, NullString(c.BounceType), NullString(c.BounceAddress), c.UpdatedAt, c.ID,
 	)
 	if err != nil {
 		return err
@@ -109,15 +128,107 @@ func (db *DB) UpdateConversation(ctx context.Context, c *Conversation) error {
 	return nil
 }
 
-// ListConversations retrieves conversations with optional filters
+// ListConversations retrieves conversations with optional filters. It
+// builds its WHERE clause from a ConversationFilter translated out of opts,
+// the same predicate builder QueryConversations uses, rather than an
+// ad-hoc clause per field.
 func (db *DB) ListConversations(ctx context.Context, opts ListOptions) ([]Conversation, error) {
+	query := `
+		SELECT c.id, c.company, c.position, c.recruiter_name, c.recruiter_email,
+		       c.direction, c.status, c.last_activity_at, c.email_count, c.archived, c.review_suggested, c.snoozed_until,
+		       c.bounced, c.bounce_type, c.bounce_address, c.created_at, c.updated_at
+		FROM conversations c
+	`
+
+	cf := ConversationFilter{
+		Direction:       opts.Direction,
+		DateAfter:       opts.Since,
+		BouncedOnly:     opts.BouncedOnly,
+		IncludeArchived: opts.IncludeArchived,
+	}
+	if opts.Status != nil {
+		cf.Statuses = []ConversationStatus{*opts.Status}
+	}
+	if opts.Company != nil {
+		cf.Companies = []string{*opts.Company}
+	}
+
+	where, args := cf.SQL()
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	query += " ORDER BY c.last_activity_at DESC"
+
+	if opts.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", opts.Limit)
+		if opts.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET %d", opts.Offset)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var conversations []Conversation
+	for rows.Next() {
+		c, err := scanConversationRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		conversations = append(conversations, c)
+	}
+
+	return conversations, rows.Err()
+}
+
+// scanConversationRow scans one row of the column list shared by
+// ListConversations and IterConversations into a Conversation.
+func scanConversationRow(rows *sql.Rows) (Conversation, error) {
+	c := Conversation{}
+	var position, recruiterName, recruiterEmail, bounceType, bounceAddress sql.NullString
+	var snoozedUntil sql.NullTime
+
+	if err := rows.Scan(
+		&c.ID, &c.Company, &position, &recruiterName, &recruiterEmail,
+		&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.Archived, &c.ReviewSuggested, &snoozedUntil,
+		&c.Bounced, &bounceType, &bounceAddress, &c.CreatedAt, &c.UpdatedAt,
+	); err != nil {
+		return c, err
+	}
+
+	c.Position = StringPtr(position)
+	c.RecruiterName = StringPtr(recruiterName)
+	c.RecruiterEmail = StringPtr(recruiterEmail)
+	c.BounceType = StringPtr(bounceType)
+	c.BounceAddress = StringPtr(bounceAddress)
+	if snoozedUntil.Valid {
+		c.SnoozedUntil = &snoozedUntil.Time
+	}
+	return c, nil
+}
+
+// IterConversations runs the same query as ListConversations but calls fn
+// once per matching conversation as rows are scanned, instead of
+// materializing the full result set - callers exporting tens of thousands
+// of conversations can stream them to an output writer without holding
+// them all in memory. Iteration stops and returns fn's error as soon as it
+// returns one.
+func (db *DB) IterConversations(ctx context.Context, opts ListOptions, fn func(Conversation) error) error {
 	query := `
 		SELECT id, company, position, recruiter_name, recruiter_email,
-		       direction, status, last_activity_at, email_count, created_at, updated_at
+		       direction, status, last_activity_at, email_count, archived, review_suggested, snoozed_until,
+		       bounced, bounce_type, bounce_address, created_at, updated_at
 		FROM conversations WHERE 1=1
 	`
 	args := []interface{}{}
 
+	if !opts.IncludeArchived {
+		query += " AND archived = 0"
+	}
 	if opts.Status != nil {
 		query += " AND status = ?"
 		args = append(args, *opts.Status)
@@ -134,6 +245,9 @@ func (db *DB) ListConversations(ctx context.Context, opts ListOptions) ([]Conver
 		query += " AND LOWER(company) LIKE LOWER(?)"
 		args = append(args, "%"+*opts.Company+"%")
 	}
+	if opts.BouncedOnly {
+		query += " AND bounced = 1"
+	}
 
 	query += " ORDER BY last_activity_at DESC"
 
@@ -146,29 +260,21 @@ func (db *DB) ListConversations(ctx context.Context, opts ListOptions) ([]Conver
 
 	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer rows.Close()
 
-	var conversations []Conversation
 	for rows.Next() {
-		c := Conversation{}
-		var position, recruiterName, recruiterEmail sql.NullString
-
-		if err := rows.Scan(
-			&c.ID, &c.Company, &position, &recruiterName, &recruiterEmail,
-			&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.CreatedAt, &c.UpdatedAt,
-		); err != nil {
-			return nil, err
+		c, err := scanConversationRow(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(c); err != nil {
+			return err
 		}
-
-		c.Position = StringPtr(position)
-		c.RecruiterName = StringPtr(recruiterName)
-		c.RecruiterEmail = StringPtr(recruiterEmail)
-		conversations = append(conversations, c)
 	}
 
-	return conversations, rows.Err()
+	return rows.Err()
 }
 
 // CreateEmail inserts a new email
@@ -182,32 +288,52 @@ func (db *DB) CreateEmail(ctx context.Context, e *Email) error {
 		INSERT INTO emails (
 			id, conversation_id, gmail_id, thread_id, subject, from_address, from_name,
 			to_address, date, direction, snippet, body_stored, body_encrypted,
-			classification, confidence, extracted_data, created_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			classification, confidence, extracted_data, message_id, in_reply_to,
+			references_json, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`,
 		e.ID, e.ConversationID, e.GmailID, e.ThreadID, NullString(e.Subject),
 		e.FromAddress, NullString(e.FromName), NullString(e.ToAddress),
 		e.Date, e.Direction, NullString(e.Snippet), e.BodyStored, NullString(e.BodyEncrypted),
-		NullString(e.Classification), NullFloat64(e.Confidence), NullString(e.ExtractedData), e.CreatedAt,
+		NullString(e.Classification), NullFloat64(e.Confidence), NullString(e.ExtractedData),
+		NullString(e.MessageID), NullString(e.InReplyTo), NullString(e.References), e.CreatedAt,
 	)
 	return err
 }
 
 // GetEmailByGmailID retrieves an email by Gmail ID
 func (db *DB) GetEmailByGmailID(ctx context.Context, gmailID string) (*Email, error) {
+	return db.getEmail(ctx, "gmail_id", gmailID)
+}
+
+// GetEmail retrieves an email by its internal ID
+func (db *DB) GetEmail(ctx context.Context, id string) (*Email, error) {
+	return db.getEmail(ctx, "id", id)
+}
+
+// GetEmailByMessageID retrieves an email by its Message-ID header, for
+// threading an incoming email's In-Reply-To/References chain back to the
+// conversation that already holds the ancestor message.
+func (db *DB) GetEmailByMessageID(ctx context.Context, messageID string) (*Email, error) {
+	return db.getEmail(ctx, "message_id", messageID)
+}
+
+func (db *DB) getEmail(ctx context.Context, column, value string) (*Email, error) {
 	e := &Email{}
 	var subject, fromName, toAddress, snippet, bodyEncrypted, classification, extractedData sql.NullString
+	var messageID, inReplyTo, references sql.NullString
 	var confidence sql.NullFloat64
 
-	err := db.QueryRowContext(ctx, `
+	err := db.QueryRowContext(ctx, fmt.Sprintf(`
 		SELECT id, conversation_id, gmail_id, thread_id, subject, from_address, from_name,
 		       to_address, date, direction, snippet, body_stored, body_encrypted,
-		       classification, confidence, extracted_data, created_at
-		FROM emails WHERE gmail_id = ?
-	`, gmailID).Scan(
+		       classification, confidence, extracted_data, message_id, in_reply_to,
+		       references_json, created_at
+		FROM emails WHERE %s = ?
+	`, column), value).Scan(
 		&e.ID, &e.ConversationID, &e.GmailID, &e.ThreadID, &subject, &e.FromAddress, &fromName,
 		&toAddress, &e.Date, &e.Direction, &snippet, &e.BodyStored, &bodyEncrypted,
-		&classification, &confidence, &extractedData, &e.CreatedAt,
+		&classification, &confidence, &extractedData, &messageID, &inReplyTo, &references, &e.CreatedAt,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -224,18 +350,91 @@ func (db *DB) GetEmailByGmailID(ctx context.Context, gmailID string) (*Email, er
 	e.Classification = StringPtr(classification)
 	e.Confidence = Float64Ptr(confidence)
 	e.ExtractedData = StringPtr(extractedData)
+	e.MessageID = StringPtr(messageID)
+	e.InReplyTo = StringPtr(inReplyTo)
+	e.References = StringPtr(references)
 	return e, nil
 }
 
 // ListEmailsForConversation retrieves all emails for a conversation
 func (db *DB) ListEmailsForConversation(ctx context.Context, convID string) ([]Email, error) {
-	rows, err := db.QueryContext(ctx, `
+	mf := MessageFilter{ConversationID: &convID, OrderBy: OrderByActivityAsc}
+	where, args := mf.SQL()
+	query := `
+		SELECT id, conversation_id, gmail_id, thread_id, subject, from_address, from_name,
+		       to_address, date, direction, snippet, body_stored,
+		       classification, confidence, extracted_data, message_id, in_reply_to,
+		       references_json, created_at
+		FROM emails
+	`
+	if where != "" {
+		query += " WHERE " + where
+	}
+	query += " ORDER BY " + mf.orderClause()
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []Email
+	for rows.Next() {
+		e := Email{}
+		var subject, fromName, toAddress, snippet, classification, extractedData sql.NullString
+		var messageID, inReplyTo, references sql.NullString
+		var confidence sql.NullFloat64
+
+		if err := rows.Scan(
+			&e.ID, &e.ConversationID, &e.GmailID, &e.ThreadID, &subject, &e.FromAddress, &fromName,
+			&toAddress, &e.Date, &e.Direction, &snippet, &e.BodyStored,
+			&classification, &confidence, &extractedData, &messageID, &inReplyTo, &references, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		e.Subject = StringPtr(subject)
+		e.FromName = StringPtr(fromName)
+		e.ToAddress = StringPtr(toAddress)
+		e.Snippet = StringPtr(snippet)
+		e.Classification = StringPtr(classification)
+		e.Confidence = Float64Ptr(confidence)
+		e.ExtractedData = StringPtr(extractedData)
+		e.MessageID = StringPtr(messageID)
+		e.InReplyTo = StringPtr(inReplyTo)
+		e.References = StringPtr(references)
+		emails = append(emails, e)
+	}
+
+	return emails, rows.Err()
+}
+
+// SearchEmails retrieves emails matching the given EmailSearchCriteria,
+// the emails-table counterpart to Query.
+func (db *DB) SearchEmails(ctx context.Context, crit EmailSearchCriteria) ([]Email, error) {
+	query := `
 		SELECT id, conversation_id, gmail_id, thread_id, subject, from_address, from_name,
 		       to_address, date, direction, snippet, body_stored,
-		       classification, confidence, extracted_data, created_at
-		FROM emails WHERE conversation_id = ?
-		ORDER BY date ASC
-	`, convID)
+		       classification, confidence, extracted_data, message_id, in_reply_to,
+		       references_json, created_at
+		FROM emails
+		WHERE 1=1
+	`
+	where, args := buildEmailCriteriaClause(crit)
+	if where != "" {
+		query += " AND " + where
+	}
+
+	query += " ORDER BY date ASC"
+
+	if crit.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", crit.Limit)
+		if crit.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET %d", crit.Offset)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -245,12 +444,13 @@ func (db *DB) ListEmailsForConversation(ctx context.Context, convID string) ([]E
 	for rows.Next() {
 		e := Email{}
 		var subject, fromName, toAddress, snippet, classification, extractedData sql.NullString
+		var messageID, inReplyTo, references sql.NullString
 		var confidence sql.NullFloat64
 
 		if err := rows.Scan(
 			&e.ID, &e.ConversationID, &e.GmailID, &e.ThreadID, &subject, &e.FromAddress, &fromName,
 			&toAddress, &e.Date, &e.Direction, &snippet, &e.BodyStored,
-			&classification, &confidence, &extractedData, &e.CreatedAt,
+			&classification, &confidence, &extractedData, &messageID, &inReplyTo, &references, &e.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -262,6 +462,9 @@ func (db *DB) ListEmailsForConversation(ctx context.Context, convID string) ([]E
 		e.Classification = StringPtr(classification)
 		e.Confidence = Float64Ptr(confidence)
 		e.ExtractedData = StringPtr(extractedData)
+		e.MessageID = StringPtr(messageID)
+		e.InReplyTo = StringPtr(inReplyTo)
+		e.References = StringPtr(references)
 		emails = append(emails, e)
 	}
 
@@ -309,13 +512,47 @@ func (db *DB) GetStats(ctx context.Context, since *time.Time) (*Stats, error) {
 	return stats, nil
 }
 
-// Search searches conversations by text
+// Search searches conversations by text. It prefers FullTextSearch (FTS5 +
+// BM25 ranking) when this database's sqlite3 driver has the fts5 module
+// compiled in, falling back to a portable LIKE scan otherwise - see
+// EnsureFTS. query is treated as a single phrase, matching the old LIKE
+// scan's substring semantics as closely as FTS5 allows; callers that want
+// phrase syntax, column filters, or ranking/snippets should call
+// FullTextSearch directly via SearchOptions instead.
 func (db *DB) Search(ctx context.Context, query string) ([]Conversation, error) {
+	if db.fts {
+		results, err := db.FullTextSearch(ctx, SearchOptions{Query: ftsPhrase(query)})
+		if err == nil {
+			conversations := make([]Conversation, len(results))
+			for i, r := range results {
+				conversations[i] = r.Conversation
+			}
+			return conversations, nil
+		}
+		// Fall through to the LIKE scan on any FTS5 query error - e.g. a
+		// character FTS5's query syntax treats specially - rather than
+		// surfacing a confusing syntax error for what's meant to be a
+		// plain free-text search.
+	}
+	return db.searchLike(ctx, query)
+}
+
+// ftsPhrase quotes s as a single FTS5 phrase, so punctuation in a free-text
+// query (a hyphenated company name, an "@" in an email address) is matched
+// literally instead of parsed as FTS5 query syntax.
+func ftsPhrase(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// searchLike is the original case-insensitive LIKE scan across a handful of
+// columns, kept as Search's fallback for sqlite3 builds without fts5.
+func (db *DB) searchLike(ctx context.Context, query string) ([]Conversation, error) {
 	searchPattern := "%" + strings.ToLower(query) + "%"
 
 	rows, err := db.QueryContext(ctx, `
 		SELECT DISTINCT c.id, c.company, c.position, c.recruiter_name, c.recruiter_email,
-		       c.direction, c.status, c.last_activity_at, c.email_count, c.created_at, c.updated_at
+		       c.direction, c.status, c.last_activity_at, c.email_count, c.archived, c.review_suggested, c.snoozed_until,
+		       c.bounced, c.bounce_type, c.bounce_address, c.created_at, c.updated_at
 		FROM conversations c
 		LEFT JOIN emails e ON c.id = e.conversation_id
 		WHERE LOWER(c.company) LIKE ?
@@ -333,11 +570,95 @@ func (db *DB) Search(ctx context.Context, query string) ([]Conversation, error)
 	var conversations []Conversation
 	for rows.Next() {
 		c := Conversation{}
-		var position, recruiterName, recruiterEmail sql.NullString
+		var position, recruiterName, recruiterEmail, bounceType, bounceAddress sql.NullString
+		var snoozedUntil sql.NullTime
+
+		if err := rows.Scan(
+			&c.ID, &c.Company, &position, &recruiterName, &recruiterEmail,
+			&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.Archived, &c.ReviewSuggested, &snoozedUntil,
+			&c.Bounced, &bounceType, &bounceAddress, &c.CreatedAt, &c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		c.Position = StringPtr(position)
+		c.RecruiterName = StringPtr(recruiterName)
+		c.RecruiterEmail = StringPtr(recruiterEmail)
+		c.BounceType = StringPtr(bounceType)
+		c.BounceAddress = StringPtr(bounceAddress)
+		if snoozedUntil.Valid {
+			c.SnoozedUntil = &snoozedUntil.Time
+		}
+		conversations = append(conversations, c)
+	}
+
+	return conversations, rows.Err()
+}
+
+// Query retrieves conversations matching the given SearchCriteria. It is the
+// single predicate-driven entry point shared by the list, archive, unarchive,
+// and bulk commands, replacing the separate company/ID/search-string
+// resolution chains each used to implement on its own.
+//
+// When crit is free-text-only (see ftsPlan) and FTS5 is available, Query
+// prefers FullTextSearch for BM25-ranked results over the LIKE-based scan
+// below - the same FTS5-first, LIKE-fallback behavior Search already has -
+// so search_conversations and 'jobsearch search'/'list', which both build
+// their SearchCriteria via ParseQuery, get ranked results for plain
+// keyword queries without giving up field:value/boolean-group predicates,
+// which still go through the LIKE scan.
+func (db *DB) Query(ctx context.Context, crit SearchCriteria) ([]Conversation, error) {
+	if db.fts {
+		if opts, ok := ftsPlan(crit); ok {
+			if results, err := db.FullTextSearch(ctx, opts); err == nil {
+				conversations := make([]Conversation, len(results))
+				for i, r := range results {
+					conversations[i] = r.Conversation
+				}
+				return conversations, nil
+			}
+			// Fall through to the LIKE scan below on any FTS5 query error.
+		}
+	}
+
+	query := `
+		SELECT DISTINCT c.id, c.company, c.position, c.recruiter_name, c.recruiter_email,
+		       c.direction, c.status, c.last_activity_at, c.email_count, c.archived, c.review_suggested, c.snoozed_until,
+		       c.bounced, c.bounce_type, c.bounce_address, c.created_at, c.updated_at
+		FROM conversations c
+		LEFT JOIN emails e ON c.id = e.conversation_id
+		WHERE 1=1
+	`
+	where, args := buildCriteriaClause(crit)
+	if where != "" {
+		query += " AND " + where
+	}
+
+	query += " ORDER BY c.last_activity_at DESC"
+
+	if crit.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", crit.Limit)
+		if crit.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET %d", crit.Offset)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var conversations []Conversation
+	for rows.Next() {
+		c := Conversation{}
+		var position, recruiterName, recruiterEmail, bounceType, bounceAddress sql.NullString
+		var snoozedUntil sql.NullTime
 
 		if err := rows.Scan(
 			&c.ID, &c.Company, &position, &recruiterName, &recruiterEmail,
-			&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.CreatedAt, &c.UpdatedAt,
+			&c.Direction, &c.Status, &c.LastActivityAt, &c.EmailCount, &c.Archived, &c.ReviewSuggested, &snoozedUntil,
+			&c.Bounced, &bounceType, &bounceAddress, &c.CreatedAt, &c.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -345,12 +666,142 @@ func (db *DB) Search(ctx context.Context, query string) ([]Conversation, error)
 		c.Position = StringPtr(position)
 		c.RecruiterName = StringPtr(recruiterName)
 		c.RecruiterEmail = StringPtr(recruiterEmail)
+		c.BounceType = StringPtr(bounceType)
+		c.BounceAddress = StringPtr(bounceAddress)
+		if snoozedUntil.Valid {
+			c.SnoozedUntil = &snoozedUntil.Time
+		}
 		conversations = append(conversations, c)
 	}
 
 	return conversations, rows.Err()
 }
 
+// AmbiguousMatchError is returned by FindConversation when an identifier
+// resolves to more than one conversation, so callers can show the user what
+// it actually matched instead of silently acting on an arbitrary one.
+type AmbiguousMatchError struct {
+	Identifier string
+	Candidates []Conversation
+}
+
+func (e *AmbiguousMatchError) Error() string {
+	names := make([]string, len(e.Candidates))
+	for i, c := range e.Candidates {
+		names[i] = fmt.Sprintf("%s (%s)", c.Company, c.ID)
+	}
+	return fmt.Sprintf("%q matches more than one conversation: %s", e.Identifier, strings.Join(names, ", "))
+}
+
+// FindConversation resolves identifier to a single conversation, the shared
+// lookup behind the CLI's merge/thread/show commands and tracker.FetchThread.
+// It tries, in order: an exact ID match, an exact (case-insensitive) company
+// name match, and finally a widened full-text match over company, position,
+// recruiter, and subject. The first two are precise by construction; the
+// last returns nil with no error for zero hits and an *AmbiguousMatchError
+// for more than one, rather than silently picking the first result.
+func (db *DB) FindConversation(ctx context.Context, identifier string, includeArchived bool) (*Conversation, error) {
+	byID, err := db.Query(ctx, SearchCriteria{ID: &identifier, IncludeArchived: includeArchived, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(byID) > 0 {
+		return &byID[0], nil
+	}
+
+	c, err := db.GetConversationByCompany(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil && (includeArchived || !c.Archived) {
+		return c, nil
+	}
+
+	candidates, err := db.Query(ctx, SearchCriteria{FullText: &identifier, IncludeArchived: includeArchived})
+	if err != nil {
+		return nil, err
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &candidates[0], nil
+	default:
+		return nil, &AmbiguousMatchError{Identifier: identifier, Candidates: candidates}
+	}
+}
+
+// globToLike converts a simple glob pattern (only "*" wildcards are
+// supported) into a SQL LIKE pattern.
+func globToLike(glob string) string {
+	if !strings.Contains(glob, "*") {
+		return "%" + glob + "%"
+	}
+	return strings.ReplaceAll(glob, "*", "%")
+}
+
+// ArchiveConversation marks a conversation as archived, hiding it from
+// default list output.
+func (db *DB) ArchiveConversation(ctx context.Context, id string) (*ArchiveResult, error) {
+	return db.setArchived(ctx, id, true)
+}
+
+// UnarchiveConversation clears a conversation's archived flag.
+func (db *DB) UnarchiveConversation(ctx context.Context, id string) (*ArchiveResult, error) {
+	return db.setArchived(ctx, id, false)
+}
+
+// BulkArchive archives multiple conversations in a single transaction.
+func (db *DB) BulkArchive(ctx context.Context, ids []string) error {
+	return db.bulkSetArchived(ctx, ids, true)
+}
+
+// BulkUnarchive unarchives multiple conversations in a single transaction.
+func (db *DB) BulkUnarchive(ctx context.Context, ids []string) error {
+	return db.bulkSetArchived(ctx, ids, false)
+}
+
+func (db *DB) bulkSetArchived(ctx context.Context, ids []string, archived bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	now := time.Now()
+	return db.Transaction(ctx, func(tx *sql.Tx) error {
+		for _, id := range ids {
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE conversations SET archived = ?, updated_at = ? WHERE id = ?`,
+				archived, now, id,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (db *DB) setArchived(ctx context.Context, id string, archived bool) (*ArchiveResult, error) {
+	result, err := db.ExecContext(ctx, `UPDATE conversations SET archived = ?, updated_at = ? WHERE id = ?`,
+		archived, time.Now(), id)
+	if err != nil {
+		return nil, err
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return nil, fmt.Errorf("conversation not found: %s", id)
+	}
+
+	conv, err := db.GetConversation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArchiveResult{
+		ConversationID: conv.ID,
+		Company:        conv.Company,
+		Archived:       conv.Archived,
+	}, nil
+}
+
 // GetSyncState retrieves the current sync state
 func (db *DB) GetSyncState(ctx context.Context) (*SyncState, error) {
 	state := &SyncState{}
@@ -358,9 +809,9 @@ func (db *DB) GetSyncState(ctx context.Context) (*SyncState, error) {
 	var lastHistoryID sql.NullString
 
 	err := db.QueryRowContext(ctx, `
-		SELECT id, last_sync_at, last_history_id, emails_processed
+		SELECT id, last_sync_at, last_history_id, backend, emails_processed
 		FROM sync_state WHERE id = 1
-	`).Scan(&state.ID, &lastSyncAt, &lastHistoryID, &state.EmailsProcessed)
+	`).Scan(&state.ID, &lastSyncAt, &lastHistoryID, &state.Backend, &state.EmailsProcessed)
 	if err != nil {
 		return nil, err
 	}
@@ -376,9 +827,9 @@ func (db *DB) GetSyncState(ctx context.Context) (*SyncState, error) {
 func (db *DB) UpdateSyncState(ctx context.Context, state *SyncState) error {
 	_, err := db.ExecContext(ctx, `
 		UPDATE sync_state SET
-			last_sync_at = ?, last_history_id = ?, emails_processed = ?
+			last_sync_at = ?, last_history_id = ?, backend = ?, emails_processed = ?
 		WHERE id = 1
-	`, state.LastSyncAt, NullString(state.LastHistoryID), state.EmailsProcessed)
+	`, state.LastSyncAt, NullString(state.LastHistoryID), state.Backend, state.EmailsProcessed)
 	return err
 }
 
@@ -411,6 +862,16 @@ func (db *DB) GetConversationByThreadID(ctx context.Context, threadID string) (*
 	return c, nil
 }
 
+// UpdateEmailBody caches a provider-fetched body against an already-stored
+// email, so the next FetchThread for its conversation can skip the round
+// trip to the provider.
+func (db *DB) UpdateEmailBody(ctx context.Context, emailID, body string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE emails SET body_stored = 1, body_encrypted = ? WHERE id = ?
+	`, body, emailID)
+	return err
+}
+
 // IncrementEmailCount increments the email count for a conversation
 func (db *DB) IncrementEmailCount(ctx context.Context, convID string) error {
 	_, err := db.ExecContext(ctx, `
@@ -420,6 +881,66 @@ func (db *DB) IncrementEmailCount(ctx context.Context, convID string) error {
 	return err
 }
 
+// MergeConversations moves every email from sourceID onto targetID and
+// deletes the now-empty source conversation, used both by the `merge` CLI
+// command/MCP tool and by `jobsearch rethread` when JWZ threading
+// (internal/tracker/thread) determines two conversations are really one
+// thread that company-name matching split apart.
+func (db *DB) MergeConversations(ctx context.Context, targetID, sourceID string) (*MergeResult, error) {
+	if targetID == sourceID {
+		return nil, fmt.Errorf("cannot merge a conversation with itself")
+	}
+
+	target, err := db.GetConversation(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, fmt.Errorf("target conversation not found: %s", targetID)
+	}
+
+	source, err := db.GetConversation(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, fmt.Errorf("source conversation not found: %s", sourceID)
+	}
+
+	result := &MergeResult{TargetID: targetID, SourceID: sourceID, EmailsMoved: source.EmailCount}
+
+	err = db.Transaction(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE emails SET conversation_id = ? WHERE conversation_id = ?`,
+			targetID, sourceID,
+		); err != nil {
+			return err
+		}
+
+		lastActivity := target.LastActivityAt
+		if source.LastActivityAt.After(lastActivity) {
+			lastActivity = source.LastActivityAt
+		}
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE conversations SET email_count = email_count + ?, last_activity_at = ?, updated_at = ? WHERE id = ?`,
+			source.EmailCount, lastActivity, time.Now(), targetID,
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM conversations WHERE id = ?`, sourceID); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.TotalEmails = target.EmailCount + source.EmailCount
+	return result, nil
+}
+
 // Learned Filters
 
 // FilterType constants
@@ -429,6 +950,8 @@ const (
 	FilterTypeSubjectKeyword   = "subject_keyword"
 	FilterTypeBodyKeyword      = "body_keyword"
 	FilterTypeSubjectBlacklist = "subject_blacklist"
+	FilterTypeRegex            = "regex"        // value is a regular expression matched against the subject
+	FilterTypeSenderExact      = "sender_exact" // value is a full sender address, matched exactly rather than by domain
 )
 
 // FilterSource constants
@@ -446,12 +969,12 @@ func (db *DB) CreateLearnedFilter(ctx context.Context, f *LearnedFilter) error {
 	f.CreatedAt = time.Now()
 
 	_, err := db.ExecContext(ctx, `
-		INSERT INTO learned_filters (id, filter_type, value, source, confidence, created_at)
-		VALUES (?, ?, ?, ?, ?, ?)
+		INSERT INTO learned_filters (id, filter_type, value, source, confidence, false_positive_count, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(filter_type, value) DO UPDATE SET
 			source = excluded.source,
 			confidence = excluded.confidence
-	`, f.ID, f.FilterType, f.Value, f.Source, NullFloat64(f.Confidence), f.CreatedAt)
+	`, f.ID, f.FilterType, f.Value, f.Source, NullFloat64(f.Confidence), f.FalsePositiveCount, f.CreatedAt)
 	return err
 }
 
@@ -459,11 +982,12 @@ func (db *DB) CreateLearnedFilter(ctx context.Context, f *LearnedFilter) error {
 func (db *DB) GetLearnedFilter(ctx context.Context, id string) (*LearnedFilter, error) {
 	f := &LearnedFilter{}
 	var confidence sql.NullFloat64
+	var lastSeen, lastMatchedAt sql.NullTime
 
 	err := db.QueryRowContext(ctx, `
-		SELECT id, filter_type, value, source, confidence, created_at
+		SELECT id, filter_type, value, source, confidence, false_positive_count, support_count, support_since_fp, last_seen, match_count, last_matched_at, created_at
 		FROM learned_filters WHERE id = ?
-	`, id).Scan(&f.ID, &f.FilterType, &f.Value, &f.Source, &confidence, &f.CreatedAt)
+	`, id).Scan(&f.ID, &f.FilterType, &f.Value, &f.Source, &confidence, &f.FalsePositiveCount, &f.SupportCount, &f.SupportSinceFP, &lastSeen, &f.MatchCount, &lastMatchedAt, &f.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -472,6 +996,40 @@ func (db *DB) GetLearnedFilter(ctx context.Context, id string) (*LearnedFilter,
 	}
 
 	f.Confidence = Float64Ptr(confidence)
+	if lastSeen.Valid {
+		f.LastSeen = &lastSeen.Time
+	}
+	if lastMatchedAt.Valid {
+		f.LastMatchedAt = &lastMatchedAt.Time
+	}
+	return f, nil
+}
+
+// GetLearnedFilterByValue retrieves a learned filter by its type and exact
+// value, or nil if no such filter exists yet.
+func (db *DB) GetLearnedFilterByValue(ctx context.Context, filterType, value string) (*LearnedFilter, error) {
+	f := &LearnedFilter{}
+	var confidence sql.NullFloat64
+	var lastSeen, lastMatchedAt sql.NullTime
+
+	err := db.QueryRowContext(ctx, `
+		SELECT id, filter_type, value, source, confidence, false_positive_count, support_count, support_since_fp, last_seen, match_count, last_matched_at, created_at
+		FROM learned_filters WHERE filter_type = ? AND value = ?
+	`, filterType, value).Scan(&f.ID, &f.FilterType, &f.Value, &f.Source, &confidence, &f.FalsePositiveCount, &f.SupportCount, &f.SupportSinceFP, &lastSeen, &f.MatchCount, &lastMatchedAt, &f.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f.Confidence = Float64Ptr(confidence)
+	if lastSeen.Valid {
+		f.LastSeen = &lastSeen.Time
+	}
+	if lastMatchedAt.Valid {
+		f.LastMatchedAt = &lastMatchedAt.Time
+	}
 	return f, nil
 }
 
@@ -484,7 +1042,7 @@ type LearnedFilterListOptions struct {
 
 // ListLearnedFilters retrieves learned filters with optional filters
 func (db *DB) ListLearnedFilters(ctx context.Context, opts LearnedFilterListOptions) ([]LearnedFilter, error) {
-	query := `SELECT id, filter_type, value, source, confidence, created_at FROM learned_filters WHERE 1=1`
+	query := `SELECT id, filter_type, value, source, confidence, false_positive_count, support_count, support_since_fp, last_seen, match_count, last_matched_at, created_at FROM learned_filters WHERE 1=1`
 	args := []interface{}{}
 
 	if opts.FilterType != nil {
@@ -512,12 +1070,19 @@ func (db *DB) ListLearnedFilters(ctx context.Context, opts LearnedFilterListOpti
 	for rows.Next() {
 		f := LearnedFilter{}
 		var confidence sql.NullFloat64
+		var lastSeen, lastMatchedAt sql.NullTime
 
-		if err := rows.Scan(&f.ID, &f.FilterType, &f.Value, &f.Source, &confidence, &f.CreatedAt); err != nil {
+		if err := rows.Scan(&f.ID, &f.FilterType, &f.Value, &f.Source, &confidence, &f.FalsePositiveCount, &f.SupportCount, &f.SupportSinceFP, &lastSeen, &f.MatchCount, &lastMatchedAt, &f.CreatedAt); err != nil {
 			return nil, err
 		}
 
 		f.Confidence = Float64Ptr(confidence)
+		if lastSeen.Valid {
+			f.LastSeen = &lastSeen.Time
+		}
+		if lastMatchedAt.Valid {
+			f.LastMatchedAt = &lastMatchedAt.Time
+		}
 		filters = append(filters, f)
 	}
 
@@ -566,6 +1131,95 @@ func (db *DB) ApproveLearnedFilter(ctx context.Context, id string) error {
 	return nil
 }
 
+// FilterSupport holds the contribution counter for a single learned filter
+// row. It's the learned_filters analogue of BayesClassTotal.
+type FilterSupport struct {
+	ID    string
+	Count int
+}
+
+// GetFilterSupport retrieves the support counter for a filter by type and
+// value, or nil if no such filter exists.
+func (db *DB) GetFilterSupport(ctx context.Context, filterType, value string) (*FilterSupport, error) {
+	fs := &FilterSupport{}
+	err := db.QueryRowContext(ctx, `
+		SELECT id, support_count FROM learned_filters
+		WHERE filter_type = ? AND value = ?
+	`, filterType, value).Scan(&fs.ID, &fs.Count)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// SaveFilterSupport updates the support counter of an existing filter row
+// (a missing id changes nothing), and bumps
+// support_since_fp (the clean-run length since the last false positive) and
+// last_seen alongside it.
+func (db *DB) SaveFilterSupport(ctx context.Context, id string, count int) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE learned_filters
+		SET support_count = ?, support_since_fp = support_since_fp + 1, last_seen = ?
+		WHERE id = ?
+	`, count, time.Now(), id)
+	return err
+}
+
+// IncrementFilterFalsePositive bumps a filter's false-positive counter and
+// resets its clean-run length to 0. It's a no-op if no filter with the
+// given type and value exists yet, since the suggestion that would have
+// caused the false positive was never staged.
+func (db *DB) IncrementFilterFalsePositive(ctx context.Context, filterType, value string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE learned_filters
+		SET false_positive_count = false_positive_count + 1, support_since_fp = 0
+		WHERE filter_type = ? AND value = ?
+	`, filterType, value)
+	return err
+}
+
+// IncrementMatchCount bumps a filter's match_count and sets last_matched_at
+// to now, for filters.Matcher to record each time a confirmed filter
+// actually fires - distinct from IncrementFilterFalsePositive/SupportCount,
+// which track staged (ai_suggested) evidence rather than a confirmed
+// filter's ongoing usefulness.
+func (db *DB) IncrementMatchCount(ctx context.Context, id string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE learned_filters
+		SET match_count = match_count + 1, last_matched_at = ?
+		WHERE id = ?
+	`, time.Now(), id)
+	return err
+}
+
+// DemoteLearnedFilter changes a filter's source from ai_confirmed back to
+// ai_suggested so it re-enters the auto-promotion pipeline instead of
+// being deleted outright - a later clean run of evidence can re-promote it.
+func (db *DB) DemoteLearnedFilter(ctx context.Context, id string) error {
+	result, err := db.ExecContext(ctx, `
+		UPDATE learned_filters SET source = ? WHERE id = ? AND source = ?
+	`, FilterSourceAISuggested, id, FilterSourceAIConfirmed)
+	if err != nil {
+		return err
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("filter not found or not confirmed: %s", id)
+	}
+	return nil
+}
+
+// ListLearnedFiltersBySource returns every learned filter with the given
+// source, across all filter types - used by the auto-promotion review pass
+// to sweep ai_confirmed filters for demotion.
+func (db *DB) ListLearnedFiltersBySource(ctx context.Context, source string) ([]LearnedFilter, error) {
+	return db.ListLearnedFilters(ctx, LearnedFilterListOptions{Source: &source})
+}
+
 // GetLearnedFiltersByType returns all confirmed filters of a specific type
 func (db *DB) GetLearnedFiltersByType(ctx context.Context, filterType string) ([]string, error) {
 	rows, err := db.QueryContext(ctx, `
@@ -589,3 +1243,133 @@ func (db *DB) GetLearnedFiltersByType(ctx context.Context, filterType string) ([
 
 	return values, rows.Err()
 }
+
+// EnqueueDigestEvent records that a conversation had an event worth
+// mentioning in userEmail's next digest. It's called at the same points
+// that would otherwise fire an immediate notification.
+func (db *DB) EnqueueDigestEvent(ctx context.Context, userEmail, conversationID, eventType string) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO digest_queue (id, user_email, conversation_id, event_type, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, uuid.New().String(), userEmail, conversationID, eventType, time.Now())
+	return err
+}
+
+// ListDigestQueue returns userEmail's queued digest events, oldest first.
+func (db *DB) ListDigestQueue(ctx context.Context, userEmail string) ([]DigestQueueEntry, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, user_email, conversation_id, event_type, created_at
+		FROM digest_queue WHERE user_email = ?
+		ORDER BY created_at ASC
+	`, userEmail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []DigestQueueEntry
+	for rows.Next() {
+		var e DigestQueueEntry
+		if err := rows.Scan(&e.ID, &e.UserEmail, &e.ConversationID, &e.EventType, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+// ClearDigestQueue removes the given digest_queue rows, once they've been
+// folded into a sent digest.
+func (db *DB) ClearDigestQueue(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	placeholders := strings.Repeat("?,", len(ids))
+	placeholders = placeholders[:len(placeholders)-1]
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	_, err := db.ExecContext(ctx, fmt.Sprintf(`
+		DELETE FROM digest_queue WHERE id IN (%s)
+	`, placeholders), args...)
+	return err
+}
+
+// ClearDigestQueueForConversation removes any queued digest events for
+// conversationID, so a conversation opted out via Tracker.OptOutRecruiter
+// doesn't still show up in the next digest.
+func (db *DB) ClearDigestQueueForConversation(ctx context.Context, conversationID string) error {
+	_, err := db.ExecContext(ctx, `
+		DELETE FROM digest_queue WHERE conversation_id = ?
+	`, conversationID)
+	return err
+}
+
+// GetMailWatermark returns the last processed cursor for address, or nil if
+// none has been recorded yet (a full sync, not an incremental one, is due).
+func (db *DB) GetMailWatermark(ctx context.Context, address string) (*MailWatermark, error) {
+	var w MailWatermark
+	err := db.QueryRowContext(ctx, `
+		SELECT address, cursor, updated_at FROM mail_watermarks WHERE address = ?
+	`, strings.ToLower(address)).Scan(&w.Address, &w.Cursor, &w.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// SetMailWatermark records cursor as the last processed point for address.
+func (db *DB) SetMailWatermark(ctx context.Context, address, cursor string) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO mail_watermarks (address, cursor, updated_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(address) DO UPDATE SET
+			cursor     = excluded.cursor,
+			updated_at = excluded.updated_at
+	`, strings.ToLower(address), cursor, time.Now())
+	return err
+}
+
+// CreateOptOut records that email should never reach a conversation again.
+// It's idempotent - opting the same address out twice (e.g. a recruiter who
+// emails from the same address across two conversations) just refreshes the
+// source/conversation on the existing row.
+func (db *DB) CreateOptOut(ctx context.Context, email, source string, conversationID *string) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO opt_outs (email, source, conversation_id, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(email) DO UPDATE SET
+			source = excluded.source,
+			conversation_id = excluded.conversation_id
+	`, strings.ToLower(email), source, conversationID, time.Now())
+	return err
+}
+
+// GetOptOutAddresses returns every opted-out address, for loading into
+// filter.Filter at the start of a sync.
+func (db *DB) GetOptOutAddresses(ctx context.Context) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `SELECT email FROM opt_outs`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var addresses []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, email)
+	}
+
+	return addresses, rows.Err()
+}
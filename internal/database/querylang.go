@@ -0,0 +1,276 @@
+package database
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ParseQuery translates a small query language into a SearchCriteria, so the
+// free-text search_conversations "query" string (and "jobsearch search")
+// can be routed through Query instead of Search. Example:
+//
+//	company:acme after:2024-01-01 waiting
+//
+// becomes Company="acme", Since=2024-01-01, and a FullText match on
+// "waiting" (bare words that aren't a recognized field:value pair fall
+// back to full-text terms). Fields support double-quoted values with
+// spaces (subject:"senior engineer"), and terms combine with the boolean
+// keywords AND/OR/NOT and parenthesized groups - bare juxtaposition
+// without a keyword between two terms means AND, same as before. Unknown
+// field names are treated as bare words rather than rejected, so a typo
+// degrades to a full-text search instead of an error.
+func ParseQuery(query string) SearchCriteria {
+	p := &queryParser{tokens: tokenizeQuery(query)}
+	return p.parseOr()
+}
+
+// tokenizeQuery splits query on whitespace, except inside double quotes
+// (so subject:"senior engineer" stays one token with the quotes removed),
+// and always splits "(" and ")" into their own tokens even when they abut
+// other text, so "(status:active)" parses as three tokens.
+func tokenizeQuery(query string) []string {
+	var tokens []string
+	var buf strings.Builder
+	inQuotes := false
+
+	flush := func() {
+		if buf.Len() > 0 {
+			tokens = append(tokens, buf.String())
+			buf.Reset()
+		}
+	}
+
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+			buf.WriteRune(r)
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// queryParser is a small recursive-descent parser over tokenizeQuery's
+// output, implementing the usual precedence: OR binds loosest, then
+// implicit/explicit AND, then NOT, then parenthesized groups or a single
+// field:value/bare-word term.
+type queryParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *queryParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *queryParser) parseOr() SearchCriteria {
+	terms := []SearchCriteria{p.parseAnd()}
+	for strings.EqualFold(p.peek(), "OR") {
+		p.next()
+		terms = append(terms, p.parseAnd())
+	}
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return SearchCriteria{Any: terms}
+}
+
+func (p *queryParser) parseAnd() SearchCriteria {
+	var terms []SearchCriteria
+	for {
+		tok := p.peek()
+		if tok == "" || tok == ")" || strings.EqualFold(tok, "OR") {
+			break
+		}
+		if strings.EqualFold(tok, "AND") {
+			p.next()
+			continue
+		}
+		terms = append(terms, p.parseUnary())
+	}
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return SearchCriteria{All: terms}
+}
+
+func (p *queryParser) parseUnary() SearchCriteria {
+	if strings.EqualFold(p.peek(), "NOT") {
+		p.next()
+		inner := p.parseUnary()
+		return SearchCriteria{Not: &inner}
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() SearchCriteria {
+	if p.peek() == "(" {
+		p.next()
+		inner := p.parseOr()
+		if p.peek() == ")" {
+			p.next()
+		}
+		return inner
+	}
+	return parseQueryTerm(p.next())
+}
+
+// labelToStatuses maps a search label: term onto the ConversationStatus
+// values it corresponds to. There's no separate stored pipeline stage for
+// "interview" or "offer" - CommandProcessor.Apply already collapses both
+// onto StatusActive (and reject/close onto StatusClosed) when a user
+// replies "#job interview", so searching by label reuses the same
+// collapse rather than inventing a second source of truth.
+func labelToStatuses(label string) []ConversationStatus {
+	switch strings.ToLower(label) {
+	case "interview", "offer", "active":
+		return []ConversationStatus{StatusActive}
+	case "reject", "rejected", "close", "closed":
+		return []ConversationStatus{StatusClosed}
+	default:
+		return []ConversationStatus{ConversationStatus(label)}
+	}
+}
+
+// parseQueryTerm parses a single bare word or field:value token into the
+// SearchCriteria it represents.
+func parseQueryTerm(token string) SearchCriteria {
+	field, value, ok := strings.Cut(token, ":")
+	if !ok || value == "" {
+		return SearchCriteria{FullText: StringPtrValue(token)}
+	}
+
+	switch strings.ToLower(field) {
+	case "company":
+		return SearchCriteria{Company: StringPtrValue(value)}
+	case "domain":
+		return SearchCriteria{Domain: StringPtrValue(value)}
+	case "recruiter":
+		return SearchCriteria{Recruiter: StringPtrValue(value)}
+	case "from":
+		// from:@stripe.com is a domain match; anything else is a glob over
+		// the recruiter name/email, same as recruiter:.
+		if domain, ok := strings.CutPrefix(value, "@"); ok {
+			return SearchCriteria{Domain: StringPtrValue(domain)}
+		}
+		return SearchCriteria{Recruiter: StringPtrValue(value)}
+	case "subject":
+		return SearchCriteria{Subject: StringPtrValue(value)}
+	case "to":
+		return SearchCriteria{To: StringPtrValue(value)}
+	case "position":
+		return SearchCriteria{Position: StringPtrValue(value)}
+	case "status":
+		return parseStatusTerm(value)
+	case "label":
+		return SearchCriteria{StatusIn: labelToStatuses(value)}
+	case "direction":
+		d := Direction(value)
+		return SearchCriteria{Direction: &d}
+	case "layer":
+		return SearchCriteria{Layer: StringPtrValue(value)}
+	case "after", "since":
+		if t, err := time.Parse("2006-01-02", value); err == nil {
+			return SearchCriteria{Since: &t}
+		}
+	case "before":
+		if t, err := time.Parse("2006-01-02", value); err == nil {
+			return SearchCriteria{Before: &t}
+		}
+	case "stale":
+		return parseStaleTerm(value)
+	case "archived":
+		if value == "true" || value == "yes" {
+			return SearchCriteria{ArchivedOnly: true}
+		}
+	case "review_suggested":
+		b := value == "true" || value == "yes"
+		return SearchCriteria{ReviewSuggested: &b}
+	}
+
+	return SearchCriteria{FullText: StringPtrValue(token)}
+}
+
+// parseStatusTerm splits a status: value on "," or "|" (both read as
+// "any of these") into StatusIn, or a single Status when there's no list.
+func parseStatusTerm(value string) SearchCriteria {
+	sep := ","
+	if strings.Contains(value, "|") {
+		sep = "|"
+	}
+	if !strings.Contains(value, sep) {
+		s := ConversationStatus(value)
+		return SearchCriteria{Status: &s}
+	}
+
+	var statusIn []ConversationStatus
+	for _, v := range strings.Split(value, sep) {
+		statusIn = append(statusIn, ConversationStatus(v))
+	}
+	return SearchCriteria{StatusIn: statusIn}
+}
+
+// parseStaleTerm parses a stale:>30d / stale:<7d comparison against
+// last_activity_at. "more than 30 days stale" is the same bound Before
+// already expresses (last activity before now-30d), and "<" is the same
+// bound Since expresses, so no new column predicate is needed - just a
+// relative-duration reading of the existing absolute-date fields.
+func parseStaleTerm(value string) SearchCriteria {
+	cmp := ">"
+	if strings.HasPrefix(value, ">") || strings.HasPrefix(value, "<") {
+		cmp, value = value[:1], value[1:]
+	}
+
+	d, err := parseDayDuration(value)
+	if err != nil {
+		return SearchCriteria{}
+	}
+
+	t := time.Now().Add(-d)
+	if cmp == "<" {
+		return SearchCriteria{Since: &t}
+	}
+	return SearchCriteria{Before: &t}
+}
+
+// parseDayDuration parses a suffix-duration like "30d" or "12h", the same
+// "d" extension over time.ParseDuration that CommandProcessor's snooze
+// command uses for "#job snooze 7d".
+func parseDayDuration(value string) (time.Duration, error) {
+	if days, isDays := strings.CutSuffix(value, "d"); isDays {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// StringPtrValue returns a pointer to a copy of s, for building SearchCriteria
+// literals inline (the struct fields are pointers so a zero value can be
+// told apart from an explicit empty string).
+func StringPtrValue(s string) *string {
+	return &s
+}
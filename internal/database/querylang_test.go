@@ -0,0 +1,140 @@
+package database
+
+import "testing"
+
+func TestParseQueryFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		check func(t *testing.T, crit SearchCriteria)
+	}{
+		{
+			name:  "bare word falls back to full text",
+			query: "waiting",
+			check: func(t *testing.T, crit SearchCriteria) {
+				if crit.FullText == nil || *crit.FullText != "waiting" {
+					t.Fatalf("expected FullText=waiting, got %+v", crit)
+				}
+			},
+		},
+		{
+			name:  "company and date implicit AND",
+			query: "company:acme after:2024-01-01",
+			check: func(t *testing.T, crit SearchCriteria) {
+				if len(crit.All) != 2 {
+					t.Fatalf("expected 2 AND-ed terms, got %d: %+v", len(crit.All), crit)
+				}
+				if crit.All[0].Company == nil || *crit.All[0].Company != "acme" {
+					t.Fatalf("expected Company=acme, got %+v", crit.All[0])
+				}
+				if crit.All[1].Since == nil {
+					t.Fatalf("expected Since to be set, got %+v", crit.All[1])
+				}
+			},
+		},
+		{
+			name:  "quoted value keeps spaces",
+			query: `subject:"senior engineer"`,
+			check: func(t *testing.T, crit SearchCriteria) {
+				if crit.Subject == nil || *crit.Subject != "senior engineer" {
+					t.Fatalf("expected Subject='senior engineer', got %+v", crit)
+				}
+			},
+		},
+		{
+			name:  "from with at-sign is a domain",
+			query: "from:@stripe.com",
+			check: func(t *testing.T, crit SearchCriteria) {
+				if crit.Domain == nil || *crit.Domain != "stripe.com" {
+					t.Fatalf("expected Domain=stripe.com, got %+v", crit)
+				}
+			},
+		},
+		{
+			name:  "from without at-sign is a recruiter",
+			query: "from:jane",
+			check: func(t *testing.T, crit SearchCriteria) {
+				if crit.Recruiter == nil || *crit.Recruiter != "jane" {
+					t.Fatalf("expected Recruiter=jane, got %+v", crit)
+				}
+			},
+		},
+		{
+			name:  "pipe separated status becomes StatusIn",
+			query: "status:active|rejected|ghosted",
+			check: func(t *testing.T, crit SearchCriteria) {
+				want := []ConversationStatus{"active", "rejected", "ghosted"}
+				if len(crit.StatusIn) != len(want) {
+					t.Fatalf("expected %v, got %+v", want, crit.StatusIn)
+				}
+				for i, s := range want {
+					if crit.StatusIn[i] != s {
+						t.Fatalf("expected %v, got %+v", want, crit.StatusIn)
+					}
+				}
+			},
+		},
+		{
+			name:  "label interview collapses to active status",
+			query: "label:interview",
+			check: func(t *testing.T, crit SearchCriteria) {
+				if len(crit.StatusIn) != 1 || crit.StatusIn[0] != StatusActive {
+					t.Fatalf("expected StatusIn=[active], got %+v", crit.StatusIn)
+				}
+			},
+		},
+		{
+			name:  "stale greater-than sets Before",
+			query: "stale:>30d",
+			check: func(t *testing.T, crit SearchCriteria) {
+				if crit.Before == nil {
+					t.Fatalf("expected Before to be set, got %+v", crit)
+				}
+			},
+		},
+		{
+			name:  "stale less-than sets Since",
+			query: "stale:<7d",
+			check: func(t *testing.T, crit SearchCriteria) {
+				if crit.Since == nil {
+					t.Fatalf("expected Since to be set, got %+v", crit)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, ParseQuery(tt.query))
+		})
+	}
+}
+
+func TestParseQueryBooleanLogic(t *testing.T) {
+	t.Run("OR produces an Any group", func(t *testing.T) {
+		crit := ParseQuery("status:active OR status:closed")
+		if len(crit.Any) != 2 {
+			t.Fatalf("expected 2 OR-ed terms, got %d: %+v", len(crit.Any), crit)
+		}
+	})
+
+	t.Run("NOT negates the following term", func(t *testing.T) {
+		crit := ParseQuery("NOT label:interview")
+		if crit.Not == nil {
+			t.Fatalf("expected Not to be set, got %+v", crit)
+		}
+		if len(crit.Not.StatusIn) != 1 || crit.Not.StatusIn[0] != StatusActive {
+			t.Fatalf("expected negated StatusIn=[active], got %+v", crit.Not)
+		}
+	})
+
+	t.Run("parenthesized group binds before AND", func(t *testing.T) {
+		crit := ParseQuery("company:acme (status:active OR status:closed)")
+		if len(crit.All) != 2 {
+			t.Fatalf("expected 2 AND-ed terms, got %d: %+v", len(crit.All), crit)
+		}
+		if len(crit.All[1].Any) != 2 {
+			t.Fatalf("expected nested OR group, got %+v", crit.All[1])
+		}
+	})
+}
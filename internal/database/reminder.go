@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateReminder inserts r, generating an ID and CreatedAt/Status if unset.
+func (db *DB) CreateReminder(ctx context.Context, r *Reminder) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	if r.Status == "" {
+		r.Status = ReminderStatusPending
+	}
+	if r.Params == "" {
+		r.Params = "{}"
+	}
+	r.CreatedAt = time.Now()
+
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO reminders (id, conversation_id, fire_at, action, params, note, anchor_status, status, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, r.ID, r.ConversationID, r.FireAt, r.Action, r.Params, NullString(strPtrOrNil(r.Note)),
+		NullString(statusPtrOrNil(r.AnchorStatus)), r.Status, r.CreatedAt)
+	return err
+}
+
+// statusPtrOrNil returns nil for an empty ConversationStatus, so
+// NullString stores a SQL NULL rather than an empty-string anchor_status
+// column for a reminder with no status expectation.
+func statusPtrOrNil(s ConversationStatus) *string {
+	if s == "" {
+		return nil
+	}
+	str := string(s)
+	return &str
+}
+
+// GetReminder returns the reminder with the given ID, or nil if none exists.
+func (db *DB) GetReminder(ctx context.Context, id string) (*Reminder, error) {
+	row := db.QueryRowContext(ctx, `
+		SELECT id, conversation_id, fire_at, action, params, note, anchor_status, status, created_at
+		FROM reminders WHERE id = ?
+	`, id)
+	r, err := scanReminder(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return r, err
+}
+
+// ListDueReminders returns every pending reminder whose fire_at is at or
+// before before, soonest first - the feed reminder.Manager's sweep pops
+// from.
+func (db *DB) ListDueReminders(ctx context.Context, before time.Time) ([]Reminder, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, conversation_id, fire_at, action, params, note, anchor_status, status, created_at
+		FROM reminders WHERE status = ? AND fire_at <= ?
+		ORDER BY fire_at ASC
+	`, ReminderStatusPending, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanReminders(rows)
+}
+
+// ListReminders returns every reminder recorded against conversationID,
+// soonest fire_at first.
+func (db *DB) ListReminders(ctx context.Context, conversationID string) ([]Reminder, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, conversation_id, fire_at, action, params, note, anchor_status, status, created_at
+		FROM reminders WHERE conversation_id = ?
+		ORDER BY fire_at ASC
+	`, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanReminders(rows)
+}
+
+// ListPendingReminders returns every pending reminder across every
+// conversation, soonest fire_at first, for "jobsearch reminders list".
+func (db *DB) ListPendingReminders(ctx context.Context) ([]Reminder, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, conversation_id, fire_at, action, params, note, anchor_status, status, created_at
+		FROM reminders WHERE status = ?
+		ORDER BY fire_at ASC
+	`, ReminderStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanReminders(rows)
+}
+
+// MarkReminderFired marks id as fired, so reminder.Manager's sweep doesn't
+// pop it again.
+func (db *DB) MarkReminderFired(ctx context.Context, id string) error {
+	_, err := db.ExecContext(ctx, `UPDATE reminders SET status = ? WHERE id = ?`, ReminderStatusFired, id)
+	return err
+}
+
+// CancelReminder marks id as cancelled, for "jobsearch reminders cancel".
+// Cancelling an already-fired reminder is a no-op, not an error.
+func (db *DB) CancelReminder(ctx context.Context, id string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE reminders SET status = ? WHERE id = ? AND status = ?
+	`, ReminderStatusCancelled, id, ReminderStatusPending)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanReminder(row rowScanner) (*Reminder, error) {
+	var r Reminder
+	var note, anchorStatus sql.NullString
+	if err := row.Scan(&r.ID, &r.ConversationID, &r.FireAt, &r.Action, &r.Params, &note, &anchorStatus, &r.Status, &r.CreatedAt); err != nil {
+		return nil, err
+	}
+	r.Note = note.String
+	r.AnchorStatus = ConversationStatus(anchorStatus.String)
+	return &r, nil
+}
+
+func scanReminders(rows *sql.Rows) ([]Reminder, error) {
+	var reminders []Reminder
+	for rows.Next() {
+		r, err := scanReminder(rows)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, *r)
+	}
+	return reminders, rows.Err()
+}
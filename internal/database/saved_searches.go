@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SavedSearch is a named SearchCriteria, persisted so it can be listed (via
+// the saved_searches resource) and re-run without the caller having to
+// reconstruct the criteria from scratch each time.
+type SavedSearch struct {
+	Name      string
+	Criteria  SearchCriteria
+	CreatedAt time.Time
+}
+
+// SaveSearch creates or overwrites the named saved search.
+func (db *DB) SaveSearch(ctx context.Context, name string, crit SearchCriteria) error {
+	data, err := json.Marshal(crit)
+	if err != nil {
+		return fmt.Errorf("failed to encode criteria: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO saved_searches (name, criteria_json, created_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			criteria_json = excluded.criteria_json,
+			created_at = excluded.created_at
+	`, name, string(data), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to save search: %w", err)
+	}
+
+	return nil
+}
+
+// GetSavedSearch retrieves a saved search by name, or nil if none exists.
+func (db *DB) GetSavedSearch(ctx context.Context, name string) (*SavedSearch, error) {
+	var criteriaJSON string
+	s := SavedSearch{Name: name}
+
+	err := db.QueryRowContext(ctx, `
+		SELECT criteria_json, created_at FROM saved_searches WHERE name = ?
+	`, name).Scan(&criteriaJSON, &s.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(criteriaJSON), &s.Criteria); err != nil {
+		return nil, fmt.Errorf("failed to decode criteria: %w", err)
+	}
+
+	return &s, nil
+}
+
+// ListSavedSearches returns all saved searches, most recently created first.
+func (db *DB) ListSavedSearches(ctx context.Context) ([]SavedSearch, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT name, criteria_json, created_at FROM saved_searches ORDER BY created_at DESC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []SavedSearch
+	for rows.Next() {
+		var s SavedSearch
+		var criteriaJSON string
+		if err := rows.Scan(&s.Name, &criteriaJSON, &s.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(criteriaJSON), &s.Criteria); err != nil {
+			return nil, fmt.Errorf("failed to decode criteria for %q: %w", s.Name, err)
+		}
+		results = append(results, s)
+	}
+
+	return results, rows.Err()
+}
@@ -0,0 +1,204 @@
+package database
+
+import "strings"
+
+// buildCriteriaClause translates a SearchCriteria into a SQL boolean
+// expression (without the leading "WHERE"/"AND") plus its bound args. It's
+// centralized here, rather than inlined in Query, so a future backend (a
+// JMAP-style remote index, say) can translate the same SearchCriteria object
+// into its own query language instead of SQL.
+//
+// Composability beyond the struct's own flat fields (which are always
+// AND-ed together) comes from three fields: All (more AND-ed sub-groups),
+// Any (OR-ed sub-groups), and Not (a negated sub-group) - each holding
+// nested SearchCriteria, recursed into the same way.
+func buildCriteriaClause(crit SearchCriteria) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	add := func(clause string, a ...interface{}) {
+		clauses = append(clauses, clause)
+		args = append(args, a...)
+	}
+
+	if crit.ID != nil {
+		add("c.id = ?", *crit.ID)
+	}
+	if len(crit.IDIn) > 0 {
+		placeholders := make([]string, len(crit.IDIn))
+		for i, id := range crit.IDIn {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		clauses = append(clauses, "c.id IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if crit.Company != nil {
+		add("LOWER(c.company) LIKE LOWER(?)", globToLike(*crit.Company))
+	}
+	if crit.Domain != nil {
+		add("LOWER(c.recruiter_email) LIKE LOWER(?)", "%@"+strings.TrimPrefix(strings.ToLower(*crit.Domain), "@"))
+	}
+	if crit.Recruiter != nil {
+		pattern := globToLike(*crit.Recruiter)
+		clauses = append(clauses, "(LOWER(c.recruiter_name) LIKE LOWER(?) OR LOWER(c.recruiter_email) LIKE LOWER(?))")
+		args = append(args, pattern, pattern)
+	}
+	if crit.Position != nil {
+		add("LOWER(c.position) LIKE LOWER(?)", globToLike(*crit.Position))
+	}
+	if crit.To != nil {
+		add("LOWER(e.to_address) LIKE LOWER(?)", globToLike(*crit.To))
+	}
+	if crit.Subject != nil {
+		add("LOWER(e.subject) LIKE LOWER(?)", globToLike(*crit.Subject))
+	}
+	if crit.Status != nil {
+		add("c.status = ?", *crit.Status)
+	}
+	if len(crit.StatusIn) > 0 {
+		placeholders := make([]string, len(crit.StatusIn))
+		for i, s := range crit.StatusIn {
+			placeholders[i] = "?"
+			args = append(args, s)
+		}
+		clauses = append(clauses, "c.status IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if crit.Direction != nil {
+		add("c.direction = ?", *crit.Direction)
+	}
+	if crit.StaleOnly {
+		add("c.status = ?", StatusStale)
+	}
+	if crit.Since != nil {
+		add("c.last_activity_at >= ?", *crit.Since)
+	}
+	if crit.Before != nil {
+		add("c.last_activity_at <= ?", *crit.Before)
+	}
+	if crit.HasRecruiter {
+		clauses = append(clauses, "c.recruiter_email IS NOT NULL AND c.recruiter_email != ''")
+	}
+	if crit.BouncedOnly {
+		clauses = append(clauses, "c.bounced = 1")
+	}
+	if crit.ReviewSuggested != nil {
+		add("c.review_suggested = ?", *crit.ReviewSuggested)
+	}
+	if crit.MinEmailCount != nil {
+		add("c.email_count >= ?", *crit.MinEmailCount)
+	}
+	if crit.MaxEmailCount != nil {
+		add("c.email_count <= ?", *crit.MaxEmailCount)
+	}
+	if crit.MinConfidence != nil {
+		add("e.confidence >= ?", *crit.MinConfidence)
+	}
+	if crit.MaxConfidence != nil {
+		add("e.confidence <= ?", *crit.MaxConfidence)
+	}
+	if crit.Layer != nil {
+		add("e.classification = ?", *crit.Layer)
+	}
+	if crit.HasClassification != nil {
+		if *crit.HasClassification {
+			clauses = append(clauses, "e.classification IS NOT NULL")
+		} else {
+			clauses = append(clauses, "e.classification IS NULL")
+		}
+	}
+	if crit.ArchivedOnly {
+		clauses = append(clauses, "c.archived = 1")
+	} else if !crit.IncludeArchived {
+		clauses = append(clauses, "c.archived = 0")
+	}
+	if crit.FullText != nil {
+		pattern := "%" + strings.ToLower(*crit.FullText) + "%"
+		clauses = append(clauses, `(LOWER(c.company) LIKE ?
+		   OR LOWER(c.position) LIKE ?
+		   OR LOWER(c.recruiter_name) LIKE ?
+		   OR LOWER(c.recruiter_email) LIKE ?
+		   OR LOWER(e.subject) LIKE ?)`)
+		args = append(args, pattern, pattern, pattern, pattern, pattern)
+	}
+
+	for _, sub := range crit.All {
+		if clause, subArgs := buildCriteriaClause(sub); clause != "" {
+			clauses = append(clauses, "("+clause+")")
+			args = append(args, subArgs...)
+		}
+	}
+
+	if len(crit.Any) > 0 {
+		var orClauses []string
+		for _, sub := range crit.Any {
+			clause, subArgs := buildCriteriaClause(sub)
+			if clause == "" {
+				continue
+			}
+			orClauses = append(orClauses, "("+clause+")")
+			args = append(args, subArgs...)
+		}
+		if len(orClauses) > 0 {
+			clauses = append(clauses, "("+strings.Join(orClauses, " OR ")+")")
+		}
+	}
+
+	if crit.Not != nil {
+		if clause, subArgs := buildCriteriaClause(*crit.Not); clause != "" {
+			clauses = append(clauses, "NOT ("+clause+")")
+			args = append(args, subArgs...)
+		}
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+// buildEmailCriteriaClause translates an EmailSearchCriteria into a SQL
+// boolean expression (without the leading "WHERE") plus its bound args,
+// mirroring buildCriteriaClause for the emails table.
+func buildEmailCriteriaClause(crit EmailSearchCriteria) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	add := func(clause string, a ...interface{}) {
+		clauses = append(clauses, clause)
+		args = append(args, a...)
+	}
+
+	if crit.ConversationID != nil {
+		add("conversation_id = ?", *crit.ConversationID)
+	}
+	if crit.Direction != nil {
+		add("direction = ?", *crit.Direction)
+	}
+	if crit.Layer != nil {
+		add("classification = ?", *crit.Layer)
+	}
+	if crit.MinConfidence != nil {
+		add("confidence >= ?", *crit.MinConfidence)
+	}
+	if crit.MaxConfidence != nil {
+		add("confidence <= ?", *crit.MaxConfidence)
+	}
+	if crit.Since != nil {
+		add("date >= ?", *crit.Since)
+	}
+	if crit.Before != nil {
+		add("date <= ?", *crit.Before)
+	}
+	if crit.TextQuery != nil {
+		pattern := "%" + strings.ToLower(*crit.TextQuery) + "%"
+		clauses = append(clauses, `(LOWER(subject) LIKE ?
+		   OR LOWER(snippet) LIKE ?
+		   OR LOWER(extracted_data) LIKE ?)`)
+		args = append(args, pattern, pattern, pattern)
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return strings.Join(clauses, " AND "), args
+}
@@ -0,0 +1,21 @@
+package database
+
+// This file documents the state of the sqlc migration requested for this
+// package. internal/database/queries/*.sql and sqlc.yaml (repo root) are in
+// place and annotate the CreateConversation/GetConversation/
+// GetConversationByCompany/UpdateConversation and CreateEmail/
+// GetEmailByGmailID/GetEmail/ListEmailsForConversation queries as a first
+// slice of the package.
+//
+// `make sqlc` (see the Makefile) shells out to the sqlc CLI to turn those
+// into a generated queries.sql.go with typed Params structs and a Queries
+// type, per the plan in the originating request. That CLI isn't available
+// in this environment, so queries.sql.go hasn't been generated or
+// committed, the remaining methods (Search, the batch operations, the
+// filter/notify/digest tables, etc.) haven't been annotated yet, and DB
+// hasn't been changed to embed a generated Queries - doing that by hand
+// without the actual tool to verify the generated code's correctness would
+// just swap one unverified implementation for another. The current
+// hand-written methods in queries.go and elsewhere remain the source of
+// truth until someone runs `make sqlc` in an environment that has it and
+// migrates the embedding over.
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecordStatusTransition records that a content-based transition rule
+// moved a conversation from one status to another, triggered by t.
+// MessageID.
+func (db *DB) RecordStatusTransition(ctx context.Context, t *StatusTransition) error {
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+	if t.AppliedAt.IsZero() {
+		t.AppliedAt = time.Now()
+	}
+
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO status_transitions (id, conversation_id, message_id, rule_name, from_status, to_status, confidence, applied_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, t.ID, t.ConversationID, t.MessageID, t.RuleName, t.FromStatus, t.ToStatus, t.Confidence, t.AppliedAt)
+	return err
+}
+
+// GetStatusTransitionByMessageID returns the transition triggered by
+// messageID, or nil if none was recorded - e.g. the message predates
+// internal/transitions, or matched no rule.
+func (db *DB) GetStatusTransitionByMessageID(ctx context.Context, messageID string) (*StatusTransition, error) {
+	var t StatusTransition
+	err := db.QueryRowContext(ctx, `
+		SELECT id, conversation_id, message_id, rule_name, from_status, to_status, confidence, applied_at
+		FROM status_transitions WHERE message_id = ?
+	`, messageID).Scan(&t.ID, &t.ConversationID, &t.MessageID, &t.RuleName, &t.FromStatus, &t.ToStatus, &t.Confidence, &t.AppliedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// DeleteStatusTransition removes the transition record with id, so a
+// rolled-back transition isn't offered for rollback again.
+func (db *DB) DeleteStatusTransition(ctx context.Context, id string) error {
+	_, err := db.ExecContext(ctx, `DELETE FROM status_transitions WHERE id = ?`, id)
+	return err
+}
@@ -0,0 +1,314 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/search"
+)
+
+// emailSearchClause translates a search.Criteria into a SQL boolean
+// expression (without the leading "WHERE") plus its bound args, for
+// QueryEmails' emails-joined-to-conversations query (aliases "e" and
+// "conv"). conversationSearchClause is the conversations-only counterpart;
+// it skips the email-level fields (e.g. ClassificationIn) rather than
+// rejecting them, so the same Criteria drives both SearchConversations and
+// QueryEmails without the caller needing two slightly different structs.
+func emailSearchClause(c search.Criteria) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	if len(c.Companies) > 0 {
+		placeholders := make([]string, len(c.Companies))
+		for i, company := range c.Companies {
+			placeholders[i] = "LOWER(conv.company) = LOWER(?)"
+			args = append(args, company)
+		}
+		clauses = append(clauses, "("+strings.Join(placeholders, " OR ")+")")
+	}
+	if len(c.Recruiters) > 0 {
+		placeholders := make([]string, len(c.Recruiters))
+		for i, r := range c.Recruiters {
+			placeholders[i] = "(LOWER(conv.recruiter_name) = LOWER(?) OR LOWER(conv.recruiter_email) = LOWER(?))"
+			args = append(args, r, r)
+		}
+		clauses = append(clauses, "("+strings.Join(placeholders, " OR ")+")")
+	}
+	if len(c.Statuses) > 0 {
+		placeholders := make([]string, len(c.Statuses))
+		for i, s := range c.Statuses {
+			placeholders[i] = "?"
+			args = append(args, s)
+		}
+		clauses = append(clauses, "conv.status IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if len(c.Directions) > 0 {
+		placeholders := make([]string, len(c.Directions))
+		for i, d := range c.Directions {
+			placeholders[i] = "?"
+			args = append(args, d)
+		}
+		clauses = append(clauses, "e.direction IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if len(c.SubjectContains) > 0 {
+		var sub []string
+		for _, s := range c.SubjectContains {
+			sub = append(sub, "LOWER(e.subject) LIKE LOWER(?)")
+			args = append(args, "%"+s+"%")
+		}
+		clauses = append(clauses, "("+strings.Join(sub, " OR ")+")")
+	}
+	if len(c.BodyContains) > 0 {
+		var sub []string
+		for _, s := range c.BodyContains {
+			sub = append(sub, "LOWER(e.snippet) LIKE LOWER(?)")
+			args = append(args, "%"+s+"%")
+		}
+		clauses = append(clauses, "("+strings.Join(sub, " OR ")+")")
+	}
+	if len(c.ClassificationIn) > 0 {
+		placeholders := make([]string, len(c.ClassificationIn))
+		for i, cl := range c.ClassificationIn {
+			placeholders[i] = "?"
+			args = append(args, cl)
+		}
+		clauses = append(clauses, "e.classification IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if c.ConfidenceMin != nil {
+		clauses = append(clauses, "e.confidence >= ?")
+		args = append(args, *c.ConfidenceMin)
+	}
+	if c.ConfidenceMax != nil {
+		clauses = append(clauses, "e.confidence <= ?")
+		args = append(args, *c.ConfidenceMax)
+	}
+	if c.DateAfter != nil {
+		clauses = append(clauses, "e.date >= ?")
+		args = append(args, *c.DateAfter)
+	}
+	if c.DateBefore != nil {
+		clauses = append(clauses, "e.date <= ?")
+		args = append(args, *c.DateBefore)
+	}
+	if c.HasPosition != nil {
+		if *c.HasPosition {
+			clauses = append(clauses, "conv.position IS NOT NULL")
+		} else {
+			clauses = append(clauses, "conv.position IS NULL")
+		}
+	}
+
+	// Layers has no direct column on either table - classification layer
+	// is the filter package's own in-memory concept (filter.Result.Layer),
+	// not something this session persists per email, so it isn't
+	// translated to SQL here; filter.Match doesn't evaluate it either.
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+// QueryEmails runs c against the emails table joined to its parent
+// conversation, so company/recruiter/status predicates (which live on
+// conversations) and subject/body/classification predicates (which live on
+// emails) can be combined in one call - the email-level counterpart to
+// SearchConversations.
+func (db *DB) QueryEmails(ctx context.Context, c search.Criteria) ([]Email, error) {
+	where, args := emailSearchClause(c)
+
+	query := `
+		SELECT e.id, e.conversation_id, e.gmail_id, e.thread_id, e.subject, e.from_address, e.from_name,
+		       e.to_address, e.date, e.direction, e.snippet, e.body_stored, e.body_encrypted,
+		       e.classification, e.confidence, e.extracted_data, e.message_id, e.in_reply_to,
+		       e.references_json, e.created_at
+		FROM emails e
+		JOIN conversations conv ON conv.id = e.conversation_id
+	`
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	orderColumn := "e.date"
+	switch c.SortBy {
+	case "confidence":
+		orderColumn = "e.confidence"
+	case "created_at":
+		orderColumn = "e.created_at"
+	}
+	direction := "ASC"
+	if c.SortDesc {
+		direction = "DESC"
+	}
+	query += fmt.Sprintf(" ORDER BY %s %s", orderColumn, direction)
+
+	if c.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", c.Limit)
+		if c.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET %d", c.Offset)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []Email
+	for rows.Next() {
+		e, err := scanEmailRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, e)
+	}
+	return emails, rows.Err()
+}
+
+// scanEmailRow scans one row of the column list QueryEmails/ListEmails
+// share into an Email.
+func scanEmailRow(rows *sql.Rows) (Email, error) {
+	e := Email{}
+	var subject, fromName, toAddress, snippet, bodyEncrypted, classification, extractedData sql.NullString
+	var messageID, inReplyTo, references sql.NullString
+	var confidence sql.NullFloat64
+
+	if err := rows.Scan(
+		&e.ID, &e.ConversationID, &e.GmailID, &e.ThreadID, &subject, &e.FromAddress, &fromName,
+		&toAddress, &e.Date, &e.Direction, &snippet, &e.BodyStored, &bodyEncrypted,
+		&classification, &confidence, &extractedData, &messageID, &inReplyTo, &references, &e.CreatedAt,
+	); err != nil {
+		return e, err
+	}
+
+	e.Subject = StringPtr(subject)
+	e.FromName = StringPtr(fromName)
+	e.ToAddress = StringPtr(toAddress)
+	e.Snippet = StringPtr(snippet)
+	e.BodyEncrypted = StringPtr(bodyEncrypted)
+	e.Classification = StringPtr(classification)
+	e.Confidence = Float64Ptr(confidence)
+	e.ExtractedData = StringPtr(extractedData)
+	e.MessageID = StringPtr(messageID)
+	e.InReplyTo = StringPtr(inReplyTo)
+	e.References = StringPtr(references)
+	return e, nil
+}
+
+// conversationSearchClause is SearchConversations' table-local counterpart
+// to emailSearchClause: the subset of search.Criteria that applies directly
+// to the conversations table, with no emails join.
+func conversationSearchClause(c search.Criteria) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	if len(c.Companies) > 0 {
+		placeholders := make([]string, len(c.Companies))
+		for i, company := range c.Companies {
+			placeholders[i] = "LOWER(company) = LOWER(?)"
+			args = append(args, company)
+		}
+		clauses = append(clauses, "("+strings.Join(placeholders, " OR ")+")")
+	}
+	if len(c.Recruiters) > 0 {
+		placeholders := make([]string, len(c.Recruiters))
+		for i, r := range c.Recruiters {
+			placeholders[i] = "(LOWER(recruiter_name) = LOWER(?) OR LOWER(recruiter_email) = LOWER(?))"
+			args = append(args, r, r)
+		}
+		clauses = append(clauses, "("+strings.Join(placeholders, " OR ")+")")
+	}
+	if len(c.Statuses) > 0 {
+		placeholders := make([]string, len(c.Statuses))
+		for i, s := range c.Statuses {
+			placeholders[i] = "?"
+			args = append(args, s)
+		}
+		clauses = append(clauses, "status IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if len(c.Directions) > 0 {
+		placeholders := make([]string, len(c.Directions))
+		for i, d := range c.Directions {
+			placeholders[i] = "?"
+			args = append(args, d)
+		}
+		clauses = append(clauses, "direction IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if c.DateAfter != nil {
+		clauses = append(clauses, "last_activity_at >= ?")
+		args = append(args, *c.DateAfter)
+	}
+	if c.DateBefore != nil {
+		clauses = append(clauses, "last_activity_at <= ?")
+		args = append(args, *c.DateBefore)
+	}
+	if c.HasPosition != nil {
+		if *c.HasPosition {
+			clauses = append(clauses, "position IS NOT NULL")
+		} else {
+			clauses = append(clauses, "position IS NULL")
+		}
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+// SearchConversations runs c against the conversations table. It's kept
+// separate from ListConversations(ctx, ListOptions) rather than replacing
+// it, the same call judged in ConversationFilter/QueryConversations
+// (filter.go): ListOptions has too many existing call sites across
+// cli/mcp/db_test.go to retarget in one change, so this is an additional,
+// richer entry point for callers (like the MCP tools) that want
+// search.Criteria's multi-valued predicates instead.
+func (db *DB) SearchConversations(ctx context.Context, c search.Criteria) ([]Conversation, error) {
+	where, args := conversationSearchClause(c)
+
+	query := `
+		SELECT id, company, position, recruiter_name, recruiter_email,
+		       direction, status, last_activity_at, email_count, archived, review_suggested, snoozed_until,
+		       bounced, bounce_type, bounce_address, created_at, updated_at
+		FROM conversations
+	`
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	orderColumn := "last_activity_at"
+	if c.SortBy == "created_at" {
+		orderColumn = "created_at"
+	}
+	direction := "DESC"
+	if !c.SortDesc && c.SortBy != "" {
+		direction = "ASC"
+	}
+	query += fmt.Sprintf(" ORDER BY %s %s", orderColumn, direction)
+
+	if c.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", c.Limit)
+		if c.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET %d", c.Offset)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var conversations []Conversation
+	for rows.Next() {
+		c, err := scanConversationRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		conversations = append(conversations, c)
+	}
+	return conversations, rows.Err()
+}
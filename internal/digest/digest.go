@@ -0,0 +1,163 @@
+// Package digest batches job-activity events (new recruiters, stage
+// transitions, stale reminders) into a single combined email instead of one
+// notification per event, draining a per-user digest_queue on whatever
+// interval the caller chooses to run it at.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// Event types recorded in the digest_queue by whatever enqueues them
+// (tracker.Sync, for new conversations and stage changes).
+const (
+	EventNewConversation = "new_conversation"
+	EventStageChange     = "stage_change"
+)
+
+// Sender delivers a rendered digest. It has the same shape as
+// tracker.ReplySender, kept as its own type so a digest.Sender isn't
+// required to be one.
+type Sender interface {
+	SendReply(ctx context.Context, to, subject, body string) error
+}
+
+// Options configures a single digest run.
+type Options struct {
+	UserEmail string // whose digest_queue to drain
+	ReplyTo   string // address the rendered digest is sent to
+
+	// ReplyAddr builds the "reply to act" deep-link address for a
+	// conversation ID. Left as a caller-supplied func, rather than digest
+	// depending on tracker's HMAC reply-address scheme directly, so the two
+	// packages don't import each other.
+	ReplyAddr func(conversationID string) string
+}
+
+// Item is one conversation folded into a rendered digest, with its queued
+// events de-duplicated.
+type Item struct {
+	Conversation *database.Conversation
+	EventTypes   []string
+	ReplyAddr    string // "#job ..." deep-link address, see tracker.EncodeReplyAddress
+}
+
+// Rendered is a digest ready to send.
+type Rendered struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Run drains db's digest_queue for opts.UserEmail and renders the result as
+// one combined email. If sender is non-nil, it's delivered before the queue
+// is cleared, so a delivery failure leaves the events queued for the next
+// run rather than losing them. Run returns (nil, nil) if the queue is
+// empty - there's nothing to send.
+func Run(ctx context.Context, db *database.DB, sender Sender, opts Options) (*Rendered, error) {
+	entries, err := db.ListDigestQueue(ctx, opts.UserEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list digest queue: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	items, err := groupByConversation(ctx, db, opts, entries)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		// Every queued conversation was since deleted; nothing to render,
+		// but still clear the now-orphaned entries below.
+		return nil, clearEntries(ctx, db, entries)
+	}
+
+	rendered, err := render(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render digest: %w", err)
+	}
+
+	if sender != nil {
+		if err := sender.SendReply(ctx, opts.ReplyTo, rendered.Subject, rendered.Text); err != nil {
+			return nil, fmt.Errorf("failed to send digest: %w", err)
+		}
+	}
+
+	if err := clearEntries(ctx, db, entries); err != nil {
+		return nil, err
+	}
+
+	return rendered, nil
+}
+
+func clearEntries(ctx context.Context, db *database.DB, entries []database.DigestQueueEntry) error {
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	if err := db.ClearDigestQueue(ctx, ids); err != nil {
+		return fmt.Errorf("failed to clear digest queue: %w", err)
+	}
+	return nil
+}
+
+// groupByConversation loads each distinct conversation referenced by entries
+// once, combining their event types, so a conversation with several queued
+// events still appears a single time in the digest.
+func groupByConversation(ctx context.Context, db *database.DB, opts Options, entries []database.DigestQueueEntry) ([]Item, error) {
+	order := make([]string, 0, len(entries))
+	byConv := make(map[string]*Item, len(entries))
+
+	for _, e := range entries {
+		item, ok := byConv[e.ConversationID]
+		if !ok {
+			conv, err := db.GetConversation(ctx, e.ConversationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load conversation %s: %w", e.ConversationID, err)
+			}
+			if conv == nil {
+				continue // conversation deleted since being queued
+			}
+
+			replyAddr := ""
+			if opts.ReplyAddr != nil {
+				replyAddr = opts.ReplyAddr(conv.ID)
+			}
+			item = &Item{
+				Conversation: conv,
+				ReplyAddr:    replyAddr,
+			}
+			byConv[e.ConversationID] = item
+			order = append(order, e.ConversationID)
+		}
+
+		if !hasEventType(item.EventTypes, e.EventType) {
+			item.EventTypes = append(item.EventTypes, e.EventType)
+		}
+	}
+
+	items := make([]Item, 0, len(order))
+	for _, id := range order {
+		items = append(items, *byConv[id])
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Conversation.LastActivityAt.After(items[j].Conversation.LastActivityAt)
+	})
+
+	return items, nil
+}
+
+func hasEventType(types []string, t string) bool {
+	for _, existing := range types {
+		if existing == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package digest
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+const textTemplateSrc = `Job search digest ({{len .Items}} conversation{{if ne (len .Items) 1}}s{{end}})
+{{range .Items}}
+- {{.Conversation.Company}}{{if .Conversation.Position}} ({{.Conversation.Position}}){{end}} [{{.Conversation.Status}}]
+  {{.EventSummary}}
+  Reply to act: {{.ReplyAddr}}
+{{end}}`
+
+const htmlTemplateSrc = `<html><body>
+<h2>Job search digest ({{len .Items}} conversation{{if ne (len .Items) 1}}s{{end}})</h2>
+<ul>
+{{range .Items}}
+  <li>
+    <strong>{{.Conversation.Company}}</strong>{{if .Conversation.Position}} ({{.Conversation.Position}}){{end}}
+    &mdash; {{.Conversation.Status}}<br>
+    {{.EventSummary}}<br>
+    <a href="mailto:{{.ReplyAddr}}">Reply to act</a>
+  </li>
+{{end}}
+</ul>
+</body></html>`
+
+var textTmpl = texttemplate.Must(texttemplate.New("digest_text").Parse(textTemplateSrc))
+var htmlTmpl = template.Must(template.New("digest_html").Parse(htmlTemplateSrc))
+
+// renderItem wraps Item with the presentation helpers the templates need,
+// kept out of Item itself since Item is also digest.Run's public return
+// shape and shouldn't carry template-only concerns.
+type renderItem struct {
+	Item
+}
+
+// EventSummary renders an item's de-duplicated event types as a short,
+// human-readable phrase (e.g. "new conversation, moved to stale").
+func (r renderItem) EventSummary() string {
+	labels := make([]string, len(r.EventTypes))
+	for i, t := range r.EventTypes {
+		switch t {
+		case EventNewConversation:
+			labels[i] = "new conversation"
+		case EventStageChange:
+			labels[i] = fmt.Sprintf("moved to %s", r.Conversation.Status)
+		default:
+			labels[i] = t
+		}
+	}
+	return strings.Join(labels, ", ")
+}
+
+func render(items []Item) (*Rendered, error) {
+	renderItems := make([]renderItem, len(items))
+	for i, it := range items {
+		renderItems[i] = renderItem{it}
+	}
+	data := struct{ Items []renderItem }{Items: renderItems}
+
+	var textBuf, htmlBuf strings.Builder
+	if err := textTmpl.Execute(&textBuf, data); err != nil {
+		return nil, fmt.Errorf("failed to render digest text: %w", err)
+	}
+	if err := htmlTmpl.Execute(&htmlBuf, data); err != nil {
+		return nil, fmt.Errorf("failed to render digest html: %w", err)
+	}
+
+	return &Rendered{
+		Subject: subjectLine(items),
+		Text:    textBuf.String(),
+		HTML:    htmlBuf.String(),
+	}, nil
+}
+
+func subjectLine(items []Item) string {
+	if len(items) == 1 {
+		return fmt.Sprintf("Job search update: %s", items[0].Conversation.Company)
+	}
+	return fmt.Sprintf("Job search update: %d conversations", len(items))
+}
@@ -0,0 +1,70 @@
+// Package commands detects "#jobsearch ..." directives a user can send
+// themselves (or to a dedicated control address) to triage a conversation
+// from their phone's mail app - an inbound counterpart to the CLI's
+// archive/mark-spam/status-driving commands, parsed from the reply during
+// a normal sync rather than requiring the HMAC-signed reply address
+// internal/tracker's "#job ..." flow (see tracker.HandleIncomingMail)
+// depends on. Tracker.applyInboxCommand applies what Parse finds, the same
+// split tracker.ParseCommand/CommandProcessor use for that other flow.
+package commands
+
+import (
+	"bufio"
+	"strings"
+)
+
+// Prefix marks a line as a jobsearch inbox command ("#jobsearch <command> [arg]").
+const Prefix = "#jobsearch"
+
+// Directive is a single parsed "#jobsearch ..." line.
+type Directive struct {
+	Command string // lowercased, e.g. "archive", "status", "snooze", "mark-spam"
+	Arg     string // remaining text, or the value half of "key=value"
+}
+
+// Parse finds the first #jobsearch line in body, ignoring quoted reply
+// text, and splits it into a command and argument. "key=value" syntax
+// (e.g. "#jobsearch status=applied") splits on the first "=" instead of
+// whitespace, so a single-word command can also be written as an
+// assignment. ok is false if no directive line was found.
+func Parse(body string) (d Directive, ok bool) {
+	for _, line := range stripQuoted(body) {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, Prefix) {
+			continue
+		}
+		rest := strings.TrimSpace(strings.TrimPrefix(line, Prefix))
+		if rest == "" {
+			continue
+		}
+		if key, value, found := strings.Cut(rest, "="); found {
+			return Directive{Command: strings.ToLower(strings.TrimSpace(key)), Arg: strings.TrimSpace(value)}, true
+		}
+		fields := strings.Fields(rest)
+		return Directive{Command: strings.ToLower(fields[0]), Arg: strings.Join(fields[1:], " ")}, true
+	}
+	return Directive{}, false
+}
+
+// stripQuoted returns body's lines with quoted reply text removed: anything
+// from the first "On ... wrote:" header onward, and any line starting with
+// the conventional ">" quote marker. Mirrors tracker's own stripQuoted for
+// the "#job ..." reply-address flow; kept as a separate copy since this
+// package can't import internal/tracker (tracker imports this package, not
+// the other way around).
+func stripQuoted(body string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "On ") && strings.HasSuffix(trimmed, "wrote:") {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -59,19 +62,29 @@ func saveToken(tokenPath string, token *oauth2.Token) error {
 	return os.WriteFile(tokenPath, data, 0600)
 }
 
-// getTokenFromWeb performs the OAuth flow via browser
+// getTokenFromWeb performs the OAuth flow via browser. It binds to an
+// ephemeral port (localhost:0) rather than a fixed one, since a hardcoded
+// port can already be in use, and registers the callback on a fresh
+// http.ServeMux instead of the package-global DefaultServeMux, since the
+// latter would panic on a second auth attempt in the same process
+// ("multiple registrations for /callback").
 func getTokenFromWeb(ctx context.Context, config *oauth2.Config) (*oauth2.Token, error) {
 	// Use a random state for security
 	state := fmt.Sprintf("%d", time.Now().UnixNano())
 
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind local callback listener: %w", err)
+	}
+
 	// Create a channel to receive the auth code
 	codeChan := make(chan string)
 	errChan := make(chan error)
 
-	// Start a local server to receive the callback
-	server := &http.Server{Addr: "localhost:8080"}
+	mux := http.NewServeMux()
+	server := &http.Server{Handler: mux}
 
-	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Query().Get("state") != state {
 			errChan <- fmt.Errorf("invalid state parameter")
 			return
@@ -90,13 +103,13 @@ func getTokenFromWeb(ctx context.Context, config *oauth2.Config) (*oauth2.Token,
 
 	// Start server in background
 	go func() {
-		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		if err := server.Serve(listener); err != http.ErrServerClosed {
 			errChan <- err
 		}
 	}()
 
-	// Configure for localhost callback
-	config.RedirectURL = "http://localhost:8080/callback"
+	// Configure for the port we actually got
+	config.RedirectURL = fmt.Sprintf("http://localhost:%d/callback", listener.Addr().(*net.TCPAddr).Port)
 
 	// Generate auth URL
 	authURL := config.AuthCodeURL(state, oauth2.AccessTypeOffline, oauth2.ApprovalForce)
@@ -136,6 +149,152 @@ func getTokenFromWeb(ctx context.Context, config *oauth2.Config) (*oauth2.Token,
 	return token, nil
 }
 
+// deviceCodeEndpoint and deviceTokenEndpoint are Google's OAuth 2.0 device
+// authorization endpoints, used by getTokenFromDevice.
+const (
+	deviceCodeEndpoint  = "https://oauth2.googleapis.com/device/code"
+	deviceTokenEndpoint = "https://oauth2.googleapis.com/token"
+)
+
+// deviceCodeResponse is the response body from deviceCodeEndpoint.
+type deviceCodeResponse struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURL string `json:"verification_url"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// deviceTokenResponse is the response body from deviceTokenEndpoint. Error
+// is non-empty while the user hasn't finished authorizing yet
+// ("authorization_pending") or the poll rate needs to back off
+// ("slow_down"); the token fields are populated once authorization completes.
+type deviceTokenResponse struct {
+	Error        string `json:"error"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	TokenType    string `json:"token_type"`
+}
+
+// getTokenFromDevice performs the OAuth flow via Google's device
+// authorization grant: it prints a verification URL and short user code for
+// the user to enter on any other device, then polls for completion. This is
+// the --auth-mode=device fallback for servers and containers with no
+// browser to redirect through.
+func getTokenFromDevice(ctx context.Context, cfg *oauth2.Config) (*oauth2.Token, error) {
+	dc, err := requestDeviceCode(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request device code: %w", err)
+	}
+
+	fmt.Println("To authenticate, visit:")
+	fmt.Printf("  %s\n", dc.VerificationURL)
+	fmt.Println("And enter the code:")
+	fmt.Printf("  %s\n\n", dc.UserCode)
+	fmt.Println("Waiting for authorization...")
+
+	interval := time.Duration(dc.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	deadline := time.Now().Add(time.Duration(dc.ExpiresIn) * time.Second)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("device code expired before authorization completed")
+		}
+
+		tr, err := pollDeviceToken(ctx, cfg, dc.DeviceCode)
+		if err != nil {
+			return nil, err
+		}
+
+		switch tr.Error {
+		case "":
+			return &oauth2.Token{
+				AccessToken:  tr.AccessToken,
+				RefreshToken: tr.RefreshToken,
+				TokenType:    tr.TokenType,
+				Expiry:       time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second),
+			}, nil
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += 5 * time.Second
+			continue
+		default:
+			return nil, fmt.Errorf("device authorization failed: %s", tr.Error)
+		}
+	}
+}
+
+// requestDeviceCode calls deviceCodeEndpoint to start a device authorization.
+func requestDeviceCode(ctx context.Context, cfg *oauth2.Config) (*deviceCodeResponse, error) {
+	form := url.Values{
+		"client_id": {cfg.ClientID},
+		"scope":     {strings.Join(cfg.Scopes, " ")},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, deviceCodeEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var dc deviceCodeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dc); err != nil {
+		return nil, fmt.Errorf("failed to decode device code response: %w", err)
+	}
+	if dc.DeviceCode == "" {
+		return nil, fmt.Errorf("device authorization request returned no device_code (status %s)", resp.Status)
+	}
+
+	return &dc, nil
+}
+
+// pollDeviceToken calls deviceTokenEndpoint once to check whether the user
+// has finished authorizing deviceCode.
+func pollDeviceToken(ctx context.Context, cfg *oauth2.Config, deviceCode string) (*deviceTokenResponse, error) {
+	form := url.Values{
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"device_code":   {deviceCode},
+		"grant_type":    {"urn:ietf:params:oauth:grant-type:device_code"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, deviceTokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tr deviceTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("failed to decode device token response: %w", err)
+	}
+
+	return &tr, nil
+}
+
 // openBrowser opens the URL in the default browser
 func openBrowser(url string) {
 	var cmd *exec.Cmd
@@ -154,12 +313,19 @@ func openBrowser(url string) {
 	_ = cmd.Start()
 }
 
-// getClient returns an authenticated HTTP client
-func getClient(ctx context.Context, config *oauth2.Config, tokenPath string) (*http.Client, error) {
+// getClient returns an authenticated HTTP client. authMode selects how a
+// missing or invalid token is obtained: "device" uses Google's device
+// authorization flow (for servers with no browser); anything else
+// (including "") uses the local-server browser redirect flow.
+func getClient(ctx context.Context, config *oauth2.Config, tokenPath, authMode string) (*http.Client, error) {
 	token, err := loadToken(tokenPath)
 	if err != nil {
 		// Need to authenticate
-		token, err = getTokenFromWeb(ctx, config)
+		if authMode == "device" {
+			token, err = getTokenFromDevice(ctx, config)
+		} else {
+			token, err = getTokenFromWeb(ctx, config)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,174 @@
+package gmail
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+// batchEndpoint is Gmail's HTTP batch endpoint, which accepts up to 100
+// sub-requests packed into one multipart/mixed POST.
+const batchEndpoint = "https://www.googleapis.com/batch/gmail/v1"
+
+// maxBatchRetries bounds the exponential backoff batchGet applies to
+// individual 429/5xx sub-responses before giving up on that message.
+const maxBatchRetries = 3
+
+// batchGet fetches ids via Gmail's HTTP batch endpoint in a single POST
+// and returns the results in the same order as ids. A sub-response that
+// comes back 429 or 5xx is retried (that one message only) with
+// exponential backoff, packed into the next round's batch request; a
+// sub-response that still fails after maxBatchRetries is left nil.
+func (p *Provider) batchGet(ctx context.Context, ids []string) ([]*gmail.Message, error) {
+	if p.httpClient == nil {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	msgs := make([]*gmail.Message, len(ids))
+	pending := ids
+	indexByID := make(map[string]int, len(ids))
+	for i, id := range ids {
+		indexByID[id] = i
+	}
+
+	backoff := 500 * time.Millisecond
+	for attempt := 0; attempt <= maxBatchRetries && len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return msgs, ctx.Err()
+			}
+			backoff *= 2
+		}
+
+		responses, err := p.doBatchRequest(ctx, pending)
+		if err != nil {
+			return msgs, err
+		}
+
+		var retry []string
+		for _, id := range pending {
+			resp, ok := responses[id]
+			if !ok {
+				retry = append(retry, id)
+				continue
+			}
+			if resp.statusCode == http.StatusTooManyRequests || resp.statusCode >= 500 {
+				retry = append(retry, id)
+				continue
+			}
+			if resp.statusCode != http.StatusOK {
+				return msgs, fmt.Errorf("batch sub-request for %s failed: status %d", id, resp.statusCode)
+			}
+
+			var msg gmail.Message
+			if err := json.Unmarshal(resp.body, &msg); err != nil {
+				return msgs, fmt.Errorf("failed to decode batch response for %s: %w", id, err)
+			}
+			msgs[indexByID[id]] = &msg
+		}
+		pending = retry
+	}
+
+	return msgs, nil
+}
+
+// batchSubResponse is one parsed sub-response from a batch HTTP response.
+type batchSubResponse struct {
+	statusCode int
+	body       []byte
+}
+
+// doBatchRequest sends one multipart/mixed POST containing a
+// Messages.Get sub-request per id, and returns the parsed sub-responses
+// keyed by the Content-ID (== message ID) each part was requested with.
+func (p *Provider) doBatchRequest(ctx context.Context, ids []string) (map[string]batchSubResponse, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for _, id := range ids {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type": {"application/http"},
+			"Content-ID":   {id},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to build batch request part: %w", err)
+		}
+		fmt.Fprintf(part, "GET /gmail/v1/users/me/messages/%s?format=full HTTP/1.1\r\n\r\n", id)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close batch request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, batchEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "multipart/mixed; boundary="+writer.Boundary())
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("batch request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("batch request returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return parseBatchResponse(resp)
+}
+
+// parseBatchResponse parses a Gmail batch response: a multipart/mixed
+// body where each part's content is itself a raw HTTP response for one
+// sub-request, identified by a "Content-ID: <response-CONTENT_ID>" part
+// header that echoes the request's Content-ID.
+func parseBatchResponse(resp *http.Response) (map[string]batchSubResponse, error) {
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("unexpected batch response content-type: %s", resp.Header.Get("Content-Type"))
+	}
+
+	reader := multipart.NewReader(resp.Body, params["boundary"])
+	results := make(map[string]batchSubResponse)
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read batch response part: %w", err)
+		}
+
+		contentID := strings.TrimPrefix(part.Header.Get("Content-ID"), "response-")
+		contentID = strings.Trim(contentID, "<>")
+
+		httpResp, err := http.ReadResponse(bufio.NewReader(part), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sub-response for %s: %w", contentID, err)
+		}
+		subBody, err := io.ReadAll(httpResp.Body)
+		httpResp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sub-response body for %s: %w", contentID, err)
+		}
+
+		results[contentID] = batchSubResponse{statusCode: httpResp.StatusCode, body: subBody}
+	}
+
+	return results, nil
+}
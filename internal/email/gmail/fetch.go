@@ -8,30 +8,123 @@ import (
 
 	"google.golang.org/api/gmail/v1"
 
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/calendar"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
 )
 
-// buildQuery constructs a Gmail search query from FetchOptions
-func buildQuery(opts email.FetchOptions) string {
+// gmailHeaderTokens maps the Headers keys Gmail's X-GM-RAW syntax can
+// actually express to their search operator. Any other header name has no
+// Gmail equivalent and is silently dropped, the same way buildFilter drops
+// IncludeSent/Labels for JMAP.
+var gmailHeaderTokens = map[string]string{
+	"message-id":  "rfc822msgid",
+	"in-reply-to": "rfc822msgid",
+	"list-id":     "list",
+}
+
+// gmailFlagTokens maps the HasFlags/NotFlags values buildQuery understands
+// to their Gmail search operator.
+var gmailFlagTokens = map[string]string{
+	"attachment": "has:attachment",
+	"unread":     "is:unread",
+	"starred":    "is:starred",
+	"important":  "is:important",
+}
+
+// buildQuery translates a SearchCriteria into Gmail's X-GM-RAW query
+// syntax. It's the Gmail-specific half of the email.SearchCriteria
+// abstraction; the jmap package has its own translator to a JMAP Filter.
+func buildQuery(crit email.SearchCriteria) string {
+	parts := flatQueryParts(crit)
+
+	for _, sub := range crit.All {
+		if q := buildQuery(sub); q != "" {
+			parts = append(parts, fmt.Sprintf("(%s)", q))
+		}
+	}
+	if len(crit.Any) > 0 {
+		var any []string
+		for _, sub := range crit.Any {
+			if q := buildQuery(sub); q != "" {
+				any = append(any, q)
+			}
+		}
+		if len(any) > 0 {
+			parts = append(parts, fmt.Sprintf("(%s)", strings.Join(any, " OR ")))
+		}
+	}
+	if crit.Not != nil {
+		if q := buildQuery(*crit.Not); q != "" {
+			parts = append(parts, fmt.Sprintf("-(%s)", q))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// flatQueryParts translates crit's non-tree fields only, leaving All/Any/Not
+// composition to buildQuery.
+func flatQueryParts(crit email.SearchCriteria) []string {
 	var parts []string
 
-	// Add date filter
-	if opts.After != nil {
-		parts = append(parts, fmt.Sprintf("after:%s", opts.After.Format("2006/01/02")))
+	if crit.After != nil {
+		parts = append(parts, fmt.Sprintf("after:%s", crit.After.Format("2006/01/02")))
+	}
+	if crit.Before != nil {
+		parts = append(parts, fmt.Sprintf("before:%s", crit.Before.Format("2006/01/02")))
+	}
+	if crit.From != "" {
+		parts = append(parts, fmt.Sprintf("from:%s", crit.From))
+	}
+	if crit.To != "" {
+		parts = append(parts, fmt.Sprintf("to:%s", crit.To))
+	}
+	if crit.Subject != "" {
+		parts = append(parts, fmt.Sprintf("subject:%q", crit.Subject))
+	}
+	if crit.Body != "" {
+		parts = append(parts, fmt.Sprintf("%q", crit.Body))
+	}
+	for name, values := range crit.Headers {
+		token, ok := gmailHeaderTokens[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+		for _, v := range values {
+			parts = append(parts, fmt.Sprintf("%s:%s", token, v))
+		}
+	}
+	for _, flag := range crit.HasFlags {
+		if token, ok := gmailFlagTokens[flag]; ok {
+			parts = append(parts, token)
+		}
+	}
+	for _, flag := range crit.NotFlags {
+		if token, ok := gmailFlagTokens[flag]; ok {
+			parts = append(parts, "-"+token)
+		}
+	}
+	for _, term := range crit.Terms {
+		parts = append(parts, fmt.Sprintf("%q", term))
+	}
+	for _, term := range crit.NotTerms {
+		parts = append(parts, fmt.Sprintf("-%q", term))
+	}
+	for _, label := range crit.Labels {
+		parts = append(parts, fmt.Sprintf("label:%s", label))
 	}
 
-	// Include both inbox and sent emails when IncludeSent is true
-	// This ensures we capture replies to recruiters
-	if opts.IncludeSent {
+	// Include both inbox and sent emails when IncludeSent is true. This
+	// ensures we capture replies to recruiters.
+	if crit.IncludeSent {
 		parts = append(parts, "(in:inbox OR in:sent)")
 	}
 
-	// Add custom query if provided
-	if opts.Query != "" {
-		parts = append(parts, opts.Query)
+	if crit.RawTerms != "" {
+		parts = append(parts, crit.RawTerms)
 	}
 
-	return strings.Join(parts, " ")
+	return parts
 }
 
 // convertMessage converts a Gmail message to our Email type
@@ -78,6 +171,14 @@ func convertMessage(msg *gmail.Message) email.Email {
 	// Extract body
 	e.Body = extractBody(msg.Payload)
 
+	// Extract calendar invites/cancellations, if any
+	for _, ics := range extractCalendarParts(msg.Payload) {
+		events, err := calendar.Parse(ics, time.Now())
+		if err == nil {
+			e.Events = append(e.Events, events...)
+		}
+	}
+
 	return e
 }
 
@@ -144,6 +245,29 @@ func extractPartByMime(part *gmail.MessagePart, mimeType string) string {
 	return ""
 }
 
+// extractCalendarParts recursively collects every text/calendar part's raw
+// decoded bytes, unlike extractPartByMime which stops at the first match -
+// a single message can carry both the original invite and, on a reschedule
+// or cancellation, a follow-up one, and each needs parsing separately.
+func extractCalendarParts(part *gmail.MessagePart) [][]byte {
+	if part == nil {
+		return nil
+	}
+
+	var parts [][]byte
+	if strings.HasPrefix(part.MimeType, "text/calendar") && part.Body != nil && part.Body.Data != "" {
+		if decoded, err := base64.URLEncoding.DecodeString(part.Body.Data); err == nil {
+			parts = append(parts, decoded)
+		}
+	}
+
+	for _, subpart := range part.Parts {
+		parts = append(parts, extractCalendarParts(subpart)...)
+	}
+
+	return parts
+}
+
 // stripHTMLTags removes HTML tags (basic implementation)
 func stripHTMLTags(html string) string {
 	var result strings.Builder
@@ -0,0 +1,173 @@
+package gmail
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/googleapi"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// HistoryRecord is one messageAdded or label-changed event returned by
+// ListHistory.
+type HistoryRecord struct {
+	MessageID string
+}
+
+// ListHistory returns the messages added or whose labels changed since
+// startHistoryID (Gmail's Users.History.List, messageAdded/labelAdded/
+// labelRemoved records) along with the new historyId to resume from next
+// time. If Gmail reports startHistoryID is too old (history has been
+// purged), err wraps a 404 googleapi.Error the caller can detect with
+// IsHistoryExpired so it knows to fall back to a full resync.
+func (p *Provider) ListHistory(ctx context.Context, startHistoryID string) ([]HistoryRecord, string, error) {
+	if p.service == nil {
+		return nil, "", fmt.Errorf("not authenticated")
+	}
+
+	var records []HistoryRecord
+	newHistoryID := startHistoryID
+
+	call := p.service.Users.History.List("me").
+		StartHistoryId(mustParseUint64(startHistoryID)).
+		HistoryTypes("messageAdded", "labelAdded", "labelRemoved").
+		Context(ctx)
+
+	err := call.Pages(ctx, func(page *gmail.ListHistoryResponse) error {
+		for _, h := range page.History {
+			for _, added := range h.MessagesAdded {
+				records = append(records, HistoryRecord{MessageID: added.Message.Id})
+			}
+			for _, added := range h.LabelsAdded {
+				records = append(records, HistoryRecord{MessageID: added.Message.Id})
+			}
+			for _, removed := range h.LabelsRemoved {
+				records = append(records, HistoryRecord{MessageID: removed.Message.Id})
+			}
+		}
+		if page.HistoryId != 0 {
+			newHistoryID = strconv.FormatUint(page.HistoryId, 10)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list history: %w", err)
+	}
+
+	return dedupHistoryRecords(records), newHistoryID, nil
+}
+
+// dedupHistoryRecords collapses multiple history records for the same
+// message (e.g. a message that was both added and label-changed in the
+// same window) into one, preserving first-seen order.
+func dedupHistoryRecords(records []HistoryRecord) []HistoryRecord {
+	seen := make(map[string]bool, len(records))
+	deduped := make([]HistoryRecord, 0, len(records))
+	for _, r := range records {
+		if seen[r.MessageID] {
+			continue
+		}
+		seen[r.MessageID] = true
+		deduped = append(deduped, r)
+	}
+	return deduped
+}
+
+// IsHistoryExpired reports whether err came back from ListHistory because
+// Gmail purged the requested startHistoryId (HTTP 404) - the signal that a
+// bounded query-based resync is needed instead of history replay.
+func IsHistoryExpired(err error) bool {
+	var apiErr *googleapi.Error
+	return asGoogleAPIError(err, &apiErr) && apiErr.Code == 404
+}
+
+func asGoogleAPIError(err error, target **googleapi.Error) bool {
+	for err != nil {
+		if apiErr, ok := err.(*googleapi.Error); ok {
+			*target = apiErr
+			return true
+		}
+		unwrapper, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return false
+		}
+		err = unwrapper.Unwrap()
+	}
+	return false
+}
+
+// CurrentHistoryID returns the account's current historyId, used to seed a
+// watermark the first time a poller or webhook handler sees an address.
+func (p *Provider) CurrentHistoryID(ctx context.Context) (string, error) {
+	if p.service == nil {
+		return "", fmt.Errorf("not authenticated")
+	}
+	profile, err := p.service.Users.GetProfile("me").Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to get profile: %w", err)
+	}
+	return strconv.FormatUint(profile.HistoryId, 10), nil
+}
+
+// FetchIncremental fetches only the messages added or label-changed since
+// watermark (a Gmail historyId previously returned by FetchIncremental or
+// CurrentHistoryID), returning the new watermark to persist. If watermark
+// is empty (first sync for this account) or Gmail reports it's too old
+// (IsHistoryExpired), it falls back to FetchEmails' bounded date-range
+// query and reseeds the watermark from CurrentHistoryID, exactly as a
+// first-time sync would.
+func (p *Provider) FetchIncremental(ctx context.Context, watermark string) ([]email.Email, string, error) {
+	if watermark == "" {
+		return p.resyncFromScratch(ctx)
+	}
+
+	records, newWatermark, err := p.ListHistory(ctx, watermark)
+	if err != nil {
+		if IsHistoryExpired(err) {
+			return p.resyncFromScratch(ctx)
+		}
+		return nil, "", err
+	}
+
+	if len(records) == 0 {
+		return nil, newWatermark, nil
+	}
+
+	ids := make([]string, len(records))
+	for i, rec := range records {
+		ids[i] = rec.MessageID
+	}
+
+	emails, err := p.fetchMessagesParallel(ctx, ids)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return emails, newWatermark, nil
+}
+
+// resyncFromScratch runs a bounded FetchEmails query (the same one a
+// date-ranged "jobsearch sync" would) and reseeds the watermark from the
+// account's current historyId, for when there's no prior watermark to
+// resume from or Gmail has purged it.
+func (p *Provider) resyncFromScratch(ctx context.Context) ([]email.Email, string, error) {
+	emails, err := p.FetchEmails(ctx, email.DefaultFetchOptions())
+	if err != nil {
+		return nil, "", err
+	}
+
+	historyID, err := p.CurrentHistoryID(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to seed watermark after resync: %w", err)
+	}
+
+	return emails, historyID, nil
+}
+
+func mustParseUint64(s string) uint64 {
+	n, _ := strconv.ParseUint(s, 10, 64)
+	return n
+}
@@ -3,6 +3,7 @@ package gmail
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"sync"
 	"sync/atomic"
@@ -23,7 +24,9 @@ const concurrentFetches = 10
 type Provider struct {
 	credPath         string
 	tokenPath        string
+	authMode         string
 	service          *gmail.Service
+	httpClient       *http.Client // authenticated client, reused for the batch endpoint (see batch.go)
 	userEmail        string
 	progressCallback ProgressCallback
 }
@@ -36,6 +39,13 @@ func New(credPath, tokenPath string) *Provider {
 	}
 }
 
+// SetAuthMode selects how the initial OAuth token is obtained: "device"
+// uses Google's device authorization flow; anything else uses the default
+// browser redirect flow. It must be called before Authenticate.
+func (p *Provider) SetAuthMode(mode string) {
+	p.authMode = mode
+}
+
 // Name returns the provider identifier
 func (p *Provider) Name() string {
 	return "gmail"
@@ -66,7 +76,7 @@ func (p *Provider) Authenticate(ctx context.Context) error {
 		return err
 	}
 
-	client, err := getClient(ctx, config, p.tokenPath)
+	client, err := getClient(ctx, config, p.tokenPath, p.authMode)
 	if err != nil {
 		return fmt.Errorf("failed to get OAuth client: %w", err)
 	}
@@ -77,6 +87,7 @@ func (p *Provider) Authenticate(ctx context.Context) error {
 	}
 
 	p.service = service
+	p.httpClient = client
 
 	// Get and cache user email
 	profile, err := service.Users.GetProfile("me").Context(ctx).Do()
@@ -103,7 +114,7 @@ func (p *Provider) FetchEmails(ctx context.Context, opts email.FetchOptions) ([]
 	}
 
 	// Build query
-	query := buildQuery(opts)
+	query := buildQuery(opts.Criteria)
 
 	// Step 1: Collect all message IDs
 	var messageIDs []string
@@ -150,32 +161,50 @@ func (p *Provider) FetchEmails(ctx context.Context, opts email.FetchOptions) ([]
 	return p.fetchMessagesParallel(ctx, messageIDs)
 }
 
-// fetchMessagesParallel fetches multiple messages concurrently
+// batchSize is how many messages are packed into one HTTP batch request.
+// Gmail allows up to 100; we stay well under that and the 250 quota-unit/s
+// ceiling by also limiting how many batches run concurrently below.
+const batchSize = 50
+
+// concurrentBatches caps how many batch HTTP requests are in flight at
+// once. Each batch already carries batchSize messages, so this bounds
+// total in-flight messages to roughly batchSize*concurrentBatches instead
+// of the old one-goroutine-per-message concurrentFetches cap.
+const concurrentBatches = 4
+
+// fetchMessagesParallel fetches multiple messages using Gmail's HTTP batch
+// endpoint, which packs many Messages.Get calls into one multipart/mixed
+// POST - far fewer TLS/HTTP round trips than one request per message, and
+// friendlier to the per-user quota-unit/sec limit. A batch that fails
+// outright (e.g. the endpoint itself errors, rather than an individual
+// sub-response) falls back to fetching that batch's messages one at a
+// time via fetchMessagesIndividually.
 func (p *Provider) fetchMessagesParallel(ctx context.Context, messageIDs []string) ([]email.Email, error) {
-	// Result channel and slice
+	var batches [][]string
+	for i := 0; i < len(messageIDs); i += batchSize {
+		end := min(i+batchSize, len(messageIDs))
+		batches = append(batches, messageIDs[i:end])
+	}
+
 	type result struct {
 		index int
-		email email.Email
+		msgs  []*gmail.Message
 		err   error
 	}
 
-	results := make(chan result, len(messageIDs))
+	results := make(chan result, len(batches))
 	var wg sync.WaitGroup
 	var fetchedCount int64
-
-	// Semaphore to limit concurrent requests
-	sem := make(chan struct{}, concurrentFetches)
+	sem := make(chan struct{}, concurrentBatches)
 
 	total := len(messageIDs)
 	p.reportProgress("fetching", 0, total)
 
-	// Launch workers
-	for i, msgID := range messageIDs {
+	for i, batch := range batches {
 		wg.Add(1)
-		go func(index int, id string) {
+		go func(index int, ids []string) {
 			defer wg.Done()
 
-			// Acquire semaphore
 			select {
 			case sem <- struct{}{}:
 				defer func() { <-sem }()
@@ -184,58 +213,77 @@ func (p *Provider) fetchMessagesParallel(ctx context.Context, messageIDs []strin
 				return
 			}
 
-			// Fetch message
-			fullMsg, err := p.service.Users.Messages.Get("me", id).
-				Format("full").
-				Context(ctx).
-				Do()
+			msgs, err := p.batchGet(ctx, ids)
 			if err != nil {
-				results <- result{index: index, err: err}
-				return
+				fmt.Fprintf(os.Stderr, "Warning: batch fetch failed, falling back to per-message fetch: %v\n", err)
+				msgs, err = p.fetchMessagesIndividually(ctx, ids)
 			}
 
-			// Report progress
-			current := int(atomic.AddInt64(&fetchedCount, 1))
+			current := int(atomic.AddInt64(&fetchedCount, int64(len(ids))))
 			p.reportProgress("fetching", current, total)
 
-			results <- result{index: index, email: convertMessage(fullMsg)}
-		}(i, msgID)
+			results <- result{index: index, msgs: msgs, err: err}
+		}(i, batch)
 	}
 
-	// Close results channel when all workers done
 	go func() {
 		wg.Wait()
 		close(results)
 	}()
 
-	// Collect results
-	emails := make([]email.Email, len(messageIDs))
+	ordered := make([][]*gmail.Message, len(batches))
 	var fetchErrors []error
-
 	for r := range results {
 		if r.err != nil {
-			fetchErrors = append(fetchErrors, fmt.Errorf("message %d: %w", r.index, r.err))
+			fetchErrors = append(fetchErrors, fmt.Errorf("batch %d: %w", r.index, r.err))
 			continue
 		}
-		emails[r.index] = r.email
+		ordered[r.index] = r.msgs
 	}
 
-	// Filter out zero-value emails (from errors)
 	var validEmails []email.Email
-	for _, e := range emails {
-		if e.ID != "" {
-			validEmails = append(validEmails, e)
+	for _, msgs := range ordered {
+		for _, msg := range msgs {
+			if msg != nil {
+				validEmails = append(validEmails, convertMessage(msg))
+			}
 		}
 	}
 
-	// Log errors if any
 	if len(fetchErrors) > 0 {
-		fmt.Fprintf(os.Stderr, "Warning: failed to fetch %d messages\n", len(fetchErrors))
+		fmt.Fprintf(os.Stderr, "Warning: failed to fetch %d batches\n", len(fetchErrors))
 	}
 
 	return validEmails, nil
 }
 
+// fetchMessagesIndividually is the one-request-per-message fallback used
+// when a provider-level batch call can't be used - kept for batchGet
+// failures and for any future provider wiring that doesn't support
+// batching at all.
+func (p *Provider) fetchMessagesIndividually(ctx context.Context, messageIDs []string) ([]*gmail.Message, error) {
+	msgs := make([]*gmail.Message, len(messageIDs))
+	var fetchErrors []error
+
+	for i, id := range messageIDs {
+		msg, err := p.service.Users.Messages.Get("me", id).
+			Format("full").
+			Context(ctx).
+			Do()
+		if err != nil {
+			fetchErrors = append(fetchErrors, fmt.Errorf("message %s: %w", id, err))
+			continue
+		}
+		msgs[i] = msg
+	}
+
+	if len(fetchErrors) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: failed to fetch %d messages\n", len(fetchErrors))
+	}
+
+	return msgs, nil
+}
+
 // GetEmail retrieves a single email by ID
 func (p *Provider) GetEmail(ctx context.Context, id string) (*email.Email, error) {
 	if p.service == nil {
@@ -0,0 +1,83 @@
+package gmail
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/threading"
+)
+
+// SendReply sends body as a reply to inReplyTo, threaded into the same
+// Gmail conversation via ThreadId and proper In-Reply-To/References
+// headers, from the authenticated user. convID is accepted for parity
+// with how callers (internal/tracker) key everything else by conversation,
+// though Gmail itself only needs inReplyTo's ThreadID to thread the reply.
+func (p *Provider) SendReply(ctx context.Context, convID string, body string, inReplyTo email.Email) error {
+	if p.service == nil {
+		return fmt.Errorf("not authenticated")
+	}
+
+	inReplyToID, _ := inReplyTo.Header("Message-ID")
+	inReplyToID = firstMessageID(inReplyToID)
+
+	references, _ := inReplyTo.Header("References")
+	refChain := threading.ParseMessageIDs(references)
+	if inReplyToID != "" {
+		refChain = append(refChain, inReplyToID)
+	}
+
+	subject := inReplyTo.Subject
+	if !strings.HasPrefix(strings.ToLower(subject), "re:") {
+		subject = "Re: " + subject
+	}
+
+	raw := buildRFC5322Message(p.userEmail, inReplyTo.From.String(), subject, inReplyToID, refChain, body)
+
+	msg := &gmail.Message{
+		ThreadId: inReplyTo.ThreadID,
+		Raw:      base64.URLEncoding.EncodeToString([]byte(raw)),
+	}
+
+	if _, err := p.service.Users.Messages.Send("me", msg).Context(ctx).Do(); err != nil {
+		return fmt.Errorf("failed to send reply for conversation %s: %w", convID, err)
+	}
+	return nil
+}
+
+// firstMessageID returns the first Message-ID found in header, stripped
+// of angle brackets, or "" if header doesn't contain one.
+func firstMessageID(header string) string {
+	ids := threading.ParseMessageIDs(header)
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+// buildRFC5322Message assembles a minimal plain-text RFC 5322 message with
+// the In-Reply-To/References headers needed for mail clients (and our own
+// JWZ threading in internal/threading) to thread it under inReplyToID.
+func buildRFC5322Message(from, to, subject, inReplyToID string, references []string, body string) string {
+	var headers []string
+	headers = append(headers, fmt.Sprintf("From: %s", from))
+	headers = append(headers, fmt.Sprintf("To: %s", to))
+	headers = append(headers, fmt.Sprintf("Subject: %s", subject))
+	if inReplyToID != "" {
+		headers = append(headers, fmt.Sprintf("In-Reply-To: <%s>", inReplyToID))
+	}
+	if len(references) > 0 {
+		wrapped := make([]string, len(references))
+		for i, id := range references {
+			wrapped[i] = "<" + id + ">"
+		}
+		headers = append(headers, fmt.Sprintf("References: %s", strings.Join(wrapped, " ")))
+	}
+	headers = append(headers, "Content-Type: text/plain; charset=\"UTF-8\"")
+
+	return strings.Join(headers, "\r\n") + "\r\n\r\n" + body
+}
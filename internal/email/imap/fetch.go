@@ -0,0 +1,303 @@
+package imap
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	goimap "github.com/emersion/go-imap"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// fetchItems are the IMAP FETCH attributes requested for every message;
+// FetchRFC822Text carries the plain body text (the IMAP counterpart to
+// jmap's fetchTextBodyValues / gmail's message "full" format).
+var fetchItems = []goimap.FetchItem{
+	goimap.FetchEnvelope,
+	goimap.FetchFlags,
+	goimap.FetchUid,
+	goimap.FetchInternalDate,
+	goimap.FetchRFC822Text,
+}
+
+// FetchEmails retrieves emails matching criteria via IMAP SEARCH/FETCH, the
+// UID-scoped counterpart to gmail.Provider.FetchEmails' X-GM-RAW query and
+// jmap.Provider.FetchEmails' Email/query filter.
+func (p *Provider) FetchEmails(ctx context.Context, opts email.FetchOptions) ([]email.Email, error) {
+	if p.conn == nil {
+		return nil, fmt.Errorf("not authenticated - call Authenticate() first")
+	}
+
+	uids, err := p.conn.UidSearch(buildSearchCriteria(opts.Criteria))
+	if err != nil {
+		return nil, fmt.Errorf("IMAP search failed: %w", err)
+	}
+	if len(uids) == 0 {
+		return nil, nil
+	}
+
+	// SEARCH returns UIDs oldest-first; keep the newest MaxResults to match
+	// the other providers' "most recent N" semantics.
+	if opts.MaxResults > 0 && len(uids) > opts.MaxResults {
+		uids = uids[len(uids)-opts.MaxResults:]
+	}
+
+	return p.fetchUIDs(uids)
+}
+
+// imapFlags maps the HasFlags/NotFlags values buildSearchCriteria
+// understands to a native IMAP flag. "attachment" and "important" have no
+// IMAP flag equivalent and are silently dropped, the same way buildFilter
+// drops fields JMAP can't express.
+var imapFlags = map[string]string{
+	"unread":  goimap.SeenFlag,
+	"starred": goimap.FlaggedFlag,
+}
+
+// buildSearchCriteria translates a SearchCriteria into IMAP SEARCH criteria,
+// the IMAP-specific counterpart to gmail.buildQuery and jmap.buildFilter.
+// All/Any/Not map onto goimap.SearchCriteria's own Not/Or composition.
+func buildSearchCriteria(crit email.SearchCriteria) *goimap.SearchCriteria {
+	criteria := goimap.NewSearchCriteria()
+
+	if crit.After != nil {
+		criteria.Since = *crit.After
+	}
+	if crit.Before != nil {
+		criteria.Before = *crit.Before
+	}
+	if crit.From != "" {
+		criteria.Header.Add("From", crit.From)
+	}
+	if crit.To != "" {
+		criteria.Header.Add("To", crit.To)
+	}
+	if crit.Subject != "" {
+		criteria.Header.Add("Subject", crit.Subject)
+	}
+	if crit.Body != "" {
+		criteria.Body = append(criteria.Body, crit.Body)
+	}
+	for name, values := range crit.Headers {
+		for _, v := range values {
+			criteria.Header.Add(name, v)
+		}
+	}
+	for _, flag := range crit.HasFlags {
+		// "unread" means the \Seen flag is absent, not present.
+		if flag == "unread" {
+			criteria.WithoutFlags = append(criteria.WithoutFlags, imapFlags[flag])
+			continue
+		}
+		if f, ok := imapFlags[flag]; ok {
+			criteria.WithFlags = append(criteria.WithFlags, f)
+		}
+	}
+	for _, flag := range crit.NotFlags {
+		if flag == "unread" {
+			criteria.WithFlags = append(criteria.WithFlags, imapFlags[flag])
+			continue
+		}
+		if f, ok := imapFlags[flag]; ok {
+			criteria.WithoutFlags = append(criteria.WithoutFlags, f)
+		}
+	}
+	criteria.Body = append(criteria.Body, crit.Terms...)
+	if crit.RawTerms != "" {
+		criteria.Text = append(criteria.Text, crit.RawTerms)
+	}
+	for _, term := range crit.NotTerms {
+		criteria.Not = append(criteria.Not, &goimap.SearchCriteria{Body: []string{term}})
+	}
+
+	// All is AND-ed into this same criteria by folding each sub-criteria's
+	// fields in directly - IMAP SEARCH ANDs every criterion by default.
+	for _, sub := range crit.All {
+		subCriteria := buildSearchCriteria(sub)
+		mergeHeaders(criteria.Header, subCriteria.Header)
+		criteria.Body = append(criteria.Body, subCriteria.Body...)
+		criteria.Text = append(criteria.Text, subCriteria.Text...)
+		criteria.WithFlags = append(criteria.WithFlags, subCriteria.WithFlags...)
+		criteria.WithoutFlags = append(criteria.WithoutFlags, subCriteria.WithoutFlags...)
+		criteria.Not = append(criteria.Not, subCriteria.Not...)
+		criteria.Or = append(criteria.Or, subCriteria.Or...)
+	}
+
+	// Any folds into a left-leaning tree of Or pairs, since goimap.Or only
+	// takes two operands at a time, then is AND-ed into this criteria the
+	// same way All's sub-criteria are.
+	if len(crit.Any) > 0 {
+		var acc *goimap.SearchCriteria
+		for _, sub := range crit.Any {
+			subCriteria := buildSearchCriteria(sub)
+			if acc == nil {
+				acc = subCriteria
+				continue
+			}
+			acc = &goimap.SearchCriteria{Or: [][2]*goimap.SearchCriteria{{acc, subCriteria}}}
+		}
+		if acc != nil {
+			mergeHeaders(criteria.Header, acc.Header)
+			criteria.Body = append(criteria.Body, acc.Body...)
+			criteria.Text = append(criteria.Text, acc.Text...)
+			criteria.WithFlags = append(criteria.WithFlags, acc.WithFlags...)
+			criteria.WithoutFlags = append(criteria.WithoutFlags, acc.WithoutFlags...)
+			criteria.Not = append(criteria.Not, acc.Not...)
+			criteria.Or = append(criteria.Or, acc.Or...)
+		}
+	}
+
+	if crit.Not != nil {
+		criteria.Not = append(criteria.Not, buildSearchCriteria(*crit.Not))
+	}
+
+	return criteria
+}
+
+// mergeHeaders adds every key/value pair from src into dst in place.
+func mergeHeaders(dst, src textproto.MIMEHeader) {
+	for name, values := range src {
+		for _, v := range values {
+			dst.Add(name, v)
+		}
+	}
+}
+
+// GetEmail retrieves a single message by its "<uidvalidity>.<uid>" ID.
+func (p *Provider) GetEmail(ctx context.Context, id string) (*email.Email, error) {
+	if p.conn == nil {
+		return nil, fmt.Errorf("not authenticated - call Authenticate() first")
+	}
+
+	uidValidity, uid, err := parseMessageID(id)
+	if err != nil {
+		return nil, err
+	}
+	if status := p.conn.Mailbox(); status == nil || status.UidValidity != uidValidity {
+		return nil, fmt.Errorf("message %s: mailbox UIDVALIDITY has changed since it was fetched", id)
+	}
+
+	emails, err := p.fetchUIDs([]uint32{uid})
+	if err != nil {
+		return nil, err
+	}
+	if len(emails) == 0 {
+		return nil, fmt.Errorf("message not found: %s", id)
+	}
+	return &emails[0], nil
+}
+
+// fetchUIDs fetches the given message UIDs from the currently selected
+// mailbox and converts them to provider-agnostic email.Email values.
+func (p *Provider) fetchUIDs(uids []uint32) ([]email.Email, error) {
+	seqset := new(goimap.SeqSet)
+	seqset.AddNum(uids...)
+
+	uidValidity := uint32(0)
+	if status := p.conn.Mailbox(); status != nil {
+		uidValidity = status.UidValidity
+	}
+
+	messages := make(chan *goimap.Message, len(uids))
+	done := make(chan error, 1)
+	go func() {
+		done <- p.conn.UidFetch(seqset, fetchItems, messages)
+	}()
+
+	var emails []email.Email
+	for msg := range messages {
+		emails = append(emails, convertMessage(msg, uidValidity, p.mailbox))
+	}
+	if err := <-done; err != nil {
+		return nil, fmt.Errorf("IMAP fetch failed: %w", err)
+	}
+	return emails, nil
+}
+
+// convertMessage converts an IMAP message to our provider-agnostic
+// email.Email, the IMAP counterpart to gmail.convertMessage and
+// jmap.convertEmail.
+func convertMessage(msg *goimap.Message, uidValidity uint32, mailbox string) email.Email {
+	e := email.Email{
+		ID:     formatMessageID(uidValidity, msg.Uid),
+		Date:   msg.InternalDate,
+		Labels: append([]string{mailbox}, msg.Flags...),
+	}
+
+	if env := msg.Envelope; env != nil {
+		e.ThreadID = env.MessageId
+		e.Subject = env.Subject
+		if len(env.From) > 0 {
+			e.From = addressFromIMAP(env.From[0])
+		}
+		for _, to := range env.To {
+			e.To = append(e.To, addressFromIMAP(to))
+		}
+		if !env.Date.IsZero() {
+			e.Date = env.Date
+		}
+	}
+
+	for _, literal := range msg.Body {
+		if literal == nil {
+			continue
+		}
+		body, err := io.ReadAll(literal)
+		if err != nil {
+			continue
+		}
+		e.Body = string(body)
+		break
+	}
+	e.Snippet = snippet(e.Body)
+
+	for _, flag := range msg.Flags {
+		if flag == goimap.SeenFlag {
+			e.IsRead = true
+		}
+	}
+
+	return e
+}
+
+// snippet truncates body to a short preview, mirroring the length of the
+// previews gmail and jmap surface for their own Snippet/preview fields.
+func snippet(body string) string {
+	const maxLen = 200
+	body = strings.TrimSpace(body)
+	if len(body) <= maxLen {
+		return body
+	}
+	return body[:maxLen]
+}
+
+func addressFromIMAP(addr *goimap.Address) email.Address {
+	return email.Address{Name: addr.PersonalName, Email: addr.MailboxName + "@" + addr.HostName}
+}
+
+// formatMessageID builds a synthetic, UIDVALIDITY-stable message ID, since
+// a bare UID is only unique until the server resets UIDVALIDITY (e.g. a
+// mailbox rebuild).
+func formatMessageID(uidValidity, uid uint32) string {
+	return fmt.Sprintf("%d.%d", uidValidity, uid)
+}
+
+func parseMessageID(id string) (uidValidity, uid uint32, err error) {
+	parts := strings.SplitN(id, ".", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid IMAP message id: %s", id)
+	}
+	v, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid IMAP message id: %s", id)
+	}
+	u, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid IMAP message id: %s", id)
+	}
+	return uint32(v), uint32(u), nil
+}
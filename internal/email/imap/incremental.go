@@ -0,0 +1,144 @@
+package imap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goimap "github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// FetchIncremental fetches only the messages added since watermark (a
+// "<uidvalidity>.<lastuid>" cursor previously returned by FetchIncremental),
+// returning the new watermark to persist - the IMAP counterpart to
+// gmail.Provider.FetchIncremental's historyId cursor, using UIDVALIDITY +
+// highest-seen UID the way mox's store tracks per-mailbox state. If
+// watermark is empty or its UIDVALIDITY no longer matches the mailbox's
+// current one (the server renumbered UIDs), it falls back to FetchEmails'
+// bounded query and reseeds the watermark from scratch.
+func (p *Provider) FetchIncremental(ctx context.Context, watermark string) ([]email.Email, string, error) {
+	if p.conn == nil {
+		return nil, "", fmt.Errorf("not authenticated - call Authenticate() first")
+	}
+
+	status := p.conn.Mailbox()
+	if status == nil {
+		return nil, "", fmt.Errorf("no mailbox selected")
+	}
+
+	uidValidity, lastUID, ok := parseWatermark(watermark)
+	if !ok || uidValidity != status.UidValidity {
+		return p.resyncFromScratch(ctx, status.UidValidity)
+	}
+
+	seqset := new(goimap.SeqSet)
+	seqset.AddRange(lastUID+1, 0) // 0 means "no upper bound" (*)
+	criteria := goimap.NewSearchCriteria()
+	criteria.Uid = seqset
+
+	uids, err := p.conn.UidSearch(criteria)
+	if err != nil {
+		return nil, "", fmt.Errorf("IMAP search failed: %w", err)
+	}
+	if len(uids) == 0 {
+		return nil, watermark, nil
+	}
+
+	emails, err := p.fetchUIDs(uids)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return emails, formatWatermark(uidValidity, maxUID(uids, lastUID)), nil
+}
+
+// resyncFromScratch runs a bounded FetchEmails query (the same one a
+// date-ranged "jobsearch sync" would) and reseeds the watermark at the
+// mailbox's current UIDVALIDITY and highest fetched UID.
+func (p *Provider) resyncFromScratch(ctx context.Context, uidValidity uint32) ([]email.Email, string, error) {
+	emails, err := p.FetchEmails(ctx, email.DefaultFetchOptions())
+	if err != nil {
+		return nil, "", err
+	}
+
+	var lastUID uint32
+	for _, e := range emails {
+		if _, uid, err := parseMessageID(e.ID); err == nil && uid > lastUID {
+			lastUID = uid
+		}
+	}
+
+	return emails, formatWatermark(uidValidity, lastUID), nil
+}
+
+func maxUID(uids []uint32, floor uint32) uint32 {
+	max := floor
+	for _, uid := range uids {
+		if uid > max {
+			max = uid
+		}
+	}
+	return max
+}
+
+func formatWatermark(uidValidity, lastUID uint32) string {
+	return fmt.Sprintf("%d.%d", uidValidity, lastUID)
+}
+
+func parseWatermark(watermark string) (uidValidity, lastUID uint32, ok bool) {
+	if watermark == "" {
+		return 0, 0, false
+	}
+	parts := strings.SplitN(watermark, ".", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	v, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, false
+	}
+	u, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, false
+	}
+	return uint32(v), uint32(u), true
+}
+
+// Idle watches the selected mailbox for new messages using the IMAP IDLE
+// extension (RFC 2177), invoking onUpdate whenever the server reports a
+// change, until ctx is canceled. It's an optional capability outside the
+// base email.Provider interface - callers that want push sync type-assert
+// for it the same way tracker checks for incrementalProvider.
+func (p *Provider) Idle(ctx context.Context, onUpdate func()) error {
+	if p.conn == nil {
+		return fmt.Errorf("not authenticated - call Authenticate() first")
+	}
+
+	updates := make(chan client.Update, 1)
+	p.conn.Updates = updates
+
+	stop := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- p.conn.Idle(stop, nil)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			close(stop)
+			<-done
+			p.conn.Updates = nil
+			return ctx.Err()
+		case <-updates:
+			onUpdate()
+		case err := <-done:
+			p.conn.Updates = nil
+			return err
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Package imap implements email.Provider against a generic IMAP4rev1
+// server (Fastmail, mox, Proton Bridge, self-hosted/corporate mailboxes,
+// etc.), the same interface the gmail and jmap packages implement against
+// their own backends. There's no OAuth dance here - like jmap.New's
+// tokenPath, credentials are a plain password file on disk; this repo has
+// no OS keychain integration to build against yet.
+package imap
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/emersion/go-imap/client"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// Provider implements the email.Provider interface against a generic IMAP
+// server.
+type Provider struct {
+	host         string
+	port         int
+	username     string
+	passwordPath string
+	mailbox      string
+	useTLS       bool
+
+	conn      *client.Client
+	userEmail string
+}
+
+// New creates a new IMAP provider. passwordPath is a file holding the
+// account password (or an app-specific password for servers that require
+// one), mirroring how gmail.New/jmap.New take a path to their own stored
+// credential. mailbox is the folder synced, e.g. "INBOX".
+func New(host string, port int, username, passwordPath, mailbox string, useTLS bool) *Provider {
+	return &Provider{
+		host:         host,
+		port:         port,
+		username:     username,
+		passwordPath: passwordPath,
+		mailbox:      mailbox,
+		useTLS:       useTLS,
+	}
+}
+
+// Name returns the provider identifier
+func (p *Provider) Name() string {
+	return "imap"
+}
+
+// IsAuthenticated checks if a saved password file exists
+func (p *Provider) IsAuthenticated() bool {
+	_, err := loadPassword(p.passwordPath)
+	return err == nil
+}
+
+// Authenticate dials the IMAP server, logs in with the stored password,
+// and selects the configured mailbox.
+func (p *Provider) Authenticate(ctx context.Context) error {
+	password, err := loadPassword(p.passwordPath)
+	if err != nil {
+		return fmt.Errorf("failed to read IMAP password file: %w\n\nSave the account password (or an app-specific password) to: %s", err, p.passwordPath)
+	}
+
+	addr := net.JoinHostPort(p.host, strconv.Itoa(p.port))
+	var conn *client.Client
+	if p.useTLS {
+		conn, err = client.DialTLS(addr, &tls.Config{ServerName: p.host})
+	} else {
+		conn, err = client.Dial(addr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+
+	if err := conn.Login(p.username, password); err != nil {
+		conn.Logout()
+		return fmt.Errorf("IMAP login failed: %w", err)
+	}
+
+	if _, err := conn.Select(p.mailbox, false); err != nil {
+		conn.Logout()
+		return fmt.Errorf("failed to select mailbox %q: %w", p.mailbox, err)
+	}
+
+	p.conn = conn
+	p.userEmail = p.username
+	return nil
+}
+
+// GetUserEmail returns the account address the provider authenticated as.
+func (p *Provider) GetUserEmail(ctx context.Context) (string, error) {
+	if p.conn == nil {
+		return "", fmt.Errorf("not authenticated")
+	}
+	return p.userEmail, nil
+}
+
+// Close logs out of the IMAP session, releasing the server-side
+// connection. Unlike gmail/jmap's stateless HTTP clients, an IMAP
+// connection holds a mailbox selected for the life of the Provider, so
+// callers that construct short-lived providers should defer Close.
+func (p *Provider) Close() error {
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Logout()
+	p.conn = nil
+	return err
+}
+
+func loadPassword(passwordPath string) (string, error) {
+	data, err := os.ReadFile(passwordPath)
+	if err != nil {
+		return "", err
+	}
+	password := strings.TrimSpace(string(data))
+	if password == "" {
+		return "", fmt.Errorf("password file %s is empty", passwordPath)
+	}
+	return password, nil
+}
+
+var _ email.Provider = (*Provider)(nil)
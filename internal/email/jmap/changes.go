@@ -0,0 +1,206 @@
+package jmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// CurrentState returns the mail account's current JMAP state string, used
+// to seed a watermark the first time a poller sees this account - the JMAP
+// counterpart to gmail.Provider.CurrentHistoryID.
+func (p *Provider) CurrentState(ctx context.Context) (string, error) {
+	if p.apiURL == "" {
+		return "", fmt.Errorf("not authenticated")
+	}
+
+	responses, err := p.call(ctx, methodCall{
+		Name: "Email/query",
+		Args: map[string]interface{}{
+			"accountId": p.accountID,
+			"limit":     0,
+		},
+		ID: "q",
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get current state: %w", err)
+	}
+
+	queryResp, err := responseByID(responses, "q")
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		QueryState string `json:"queryState"`
+	}
+	if err := json.Unmarshal(queryResp.Args, &result); err != nil {
+		return "", fmt.Errorf("failed to decode Email/query response: %w", err)
+	}
+	return result.QueryState, nil
+}
+
+// FetchIncremental fetches only the messages created or updated since
+// watermark (a JMAP state string previously returned by FetchIncremental or
+// CurrentState), returning the new state to persist - the JMAP counterpart
+// to gmail.Provider.FetchIncremental, built on Email/changes (RFC 8620
+// section 5.2) instead of the Gmail History API. If watermark is empty
+// (first sync) or the server reports it can't compute the delta
+// (cannotCalculateChanges, meaning the state is too old or invalid), it
+// falls back to FetchEmails' bounded query and reseeds the watermark from
+// CurrentState, exactly as gmail.Provider does on a 404 history-expired.
+func (p *Provider) FetchIncremental(ctx context.Context, watermark string) ([]email.Email, string, error) {
+	if watermark == "" {
+		return p.resyncFromScratch(ctx)
+	}
+
+	ids, newState, err := p.emailChanges(ctx, watermark)
+	if err != nil {
+		if isCannotCalculateChanges(err) {
+			return p.resyncFromScratch(ctx)
+		}
+		return nil, "", err
+	}
+
+	if len(ids) == 0 {
+		return nil, newState, nil
+	}
+
+	emails, err := p.fetchByIDs(ctx, ids)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return emails, newState, nil
+}
+
+// emailChanges calls Email/changes since sinceState, following its
+// hasMoreChanges pagination until the server reports it's caught up, and
+// returns the union of created and updated ids (destroyed ids are left for
+// the caller's next full FetchEmails to notice as simply absent) along with
+// the final newState to resume from next time.
+func (p *Provider) emailChanges(ctx context.Context, sinceState string) ([]string, string, error) {
+	var ids []string
+	state := sinceState
+
+	for {
+		responses, err := p.call(ctx, methodCall{
+			Name: "Email/changes",
+			Args: map[string]interface{}{
+				"accountId":  p.accountID,
+				"sinceState": state,
+			},
+			ID: "c",
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		changesResp, err := responseByID(responses, "c")
+		if err != nil {
+			return nil, "", err
+		}
+
+		var result struct {
+			NewState       string   `json:"newState"`
+			HasMoreChanges bool     `json:"hasMoreChanges"`
+			Created        []string `json:"created"`
+			Updated        []string `json:"updated"`
+		}
+		if err := json.Unmarshal(changesResp.Args, &result); err != nil {
+			return nil, "", fmt.Errorf("failed to decode Email/changes response: %w", err)
+		}
+
+		ids = append(ids, result.Created...)
+		ids = append(ids, result.Updated...)
+		state = result.NewState
+
+		if !result.HasMoreChanges {
+			break
+		}
+	}
+
+	return dedupStrings(ids), state, nil
+}
+
+// fetchByIDs hydrates a batch of message ids via Email/get, the same call
+// FetchEmails uses after Email/query, the JMAP counterpart to
+// gmail.Provider.fetchMessagesParallel.
+func (p *Provider) fetchByIDs(ctx context.Context, ids []string) ([]email.Email, error) {
+	responses, err := p.call(ctx, methodCall{
+		Name: "Email/get",
+		Args: map[string]interface{}{
+			"accountId":           p.accountID,
+			"ids":                 ids,
+			"properties":          emailProperties,
+			"fetchTextBodyValues": true,
+			"fetchHTMLBodyValues": true,
+			"maxBodyValueBytes":   512 * 1024,
+		},
+		ID: "g",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch messages: %w", err)
+	}
+
+	getResp, err := responseByID(responses, "g")
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		List []jmapEmail `json:"list"`
+	}
+	if err := json.Unmarshal(getResp.Args, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode Email/get response: %w", err)
+	}
+
+	emails := make([]email.Email, len(result.List))
+	for i, e := range result.List {
+		emails[i] = convertEmail(e)
+	}
+	return emails, nil
+}
+
+// resyncFromScratch runs a bounded FetchEmails query (the same one a
+// date-ranged "jobsearch sync" would) and reseeds the watermark from the
+// account's current state, for when there's no prior watermark to resume
+// from or the server can't compute a delta from it anymore.
+func (p *Provider) resyncFromScratch(ctx context.Context) ([]email.Email, string, error) {
+	emails, err := p.FetchEmails(ctx, email.DefaultFetchOptions())
+	if err != nil {
+		return nil, "", err
+	}
+
+	state, err := p.CurrentState(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to seed watermark after resync: %w", err)
+	}
+
+	return emails, state, nil
+}
+
+// isCannotCalculateChanges reports whether err came back from Email/changes
+// because the server can no longer compute a delta from the given state
+// (RFC 8620 section 5.2's "cannotCalculateChanges" error) - the JMAP
+// signal, analogous to Gmail's 404 history-expired, that a bounded
+// resync is needed instead of a changes replay.
+func isCannotCalculateChanges(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "cannotCalculateChanges")
+}
+
+func dedupStrings(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	deduped := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		deduped = append(deduped, id)
+	}
+	return deduped
+}
@@ -0,0 +1,115 @@
+package jmap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// methodCall is one entry of a JMAP request's methodCalls array: [name,
+// arguments, callId]. callId links a methodResponse back to the call that
+// produced it, and lets later calls in the same request reference an
+// earlier one's results (a "result reference") instead of round-tripping.
+type methodCall struct {
+	Name string
+	Args map[string]interface{}
+	ID   string
+}
+
+func (c methodCall) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]interface{}{c.Name, c.Args, c.ID})
+}
+
+// methodResponse is the decoded counterpart of methodCall.
+type methodResponse struct {
+	Name string
+	Args json.RawMessage
+	ID   string
+}
+
+func (r *methodResponse) UnmarshalJSON(data []byte) error {
+	var raw [3]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw[0], &r.Name); err != nil {
+		return err
+	}
+	r.Args = raw[1]
+	return json.Unmarshal(raw[2], &r.ID)
+}
+
+// resultRef builds a JMAP ResultReference pointing at the ids returned by
+// an earlier call in the same request, e.g. the anchorRef pattern used to
+// feed an Email/query's output straight into an Email/get without a
+// second round trip.
+func resultRef(callID, path string) map[string]interface{} {
+	return map[string]interface{}{
+		"resultOf": callID,
+		"name":     "Email/query",
+		"path":     path,
+	}
+}
+
+// call sends a single JMAP request containing calls, in order, and
+// returns the decoded methodResponses in the same order.
+func (p *Provider) call(ctx context.Context, calls ...methodCall) ([]methodResponse, error) {
+	body := map[string]interface{}{
+		"using":       []string{coreCapability, mailCapability},
+		"methodCalls": calls,
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JMAP request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.token)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JMAP request failed with status %d", resp.StatusCode)
+	}
+
+	var decoded struct {
+		MethodResponses []methodResponse `json:"methodResponses"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode JMAP response: %w", err)
+	}
+
+	return decoded.MethodResponses, nil
+}
+
+// responseByID returns the methodResponse with the given call ID, or an
+// error if the call errored (JMAP reports errors as a response named
+// "error" rather than an HTTP status) or is missing entirely.
+func responseByID(responses []methodResponse, id string) (*methodResponse, error) {
+	for i := range responses {
+		if responses[i].ID != id {
+			continue
+		}
+		if responses[i].Name == "error" {
+			var jmapErr struct {
+				Type        string `json:"type"`
+				Description string `json:"description"`
+			}
+			_ = json.Unmarshal(responses[i].Args, &jmapErr)
+			return nil, fmt.Errorf("JMAP error (%s): %s", jmapErr.Type, jmapErr.Description)
+		}
+		return &responses[i], nil
+	}
+	return nil, fmt.Errorf("JMAP response missing call %q", id)
+}
@@ -0,0 +1,199 @@
+package jmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// emailProperties are the JMAP Email object properties fetched for every
+// message; bodyValues carries the plain-text (or, failing that, HTML)
+// body once fetchTextBodyValues/fetchHTMLBodyValues resolve it.
+var emailProperties = []string{
+	"id", "threadId", "subject", "from", "to", "receivedAt",
+	"preview", "keywords", "mailboxIds", "bodyValues", "textBody", "htmlBody",
+}
+
+// FetchEmails retrieves emails matching criteria, resolving the Email/query
+// and the following Email/get in a single JMAP request via a result
+// reference - the JMAP analogue of gmail.Provider.fetchMessagesParallel,
+// except the server does the batching instead of N goroutines.
+func (p *Provider) FetchEmails(ctx context.Context, opts email.FetchOptions) ([]email.Email, error) {
+	if p.apiURL == "" {
+		return nil, fmt.Errorf("not authenticated - call Authenticate() first")
+	}
+
+	queryCall := methodCall{
+		Name: "Email/query",
+		Args: map[string]interface{}{
+			"accountId": p.accountID,
+			"filter":    buildFilter(opts.Criteria),
+			"sort": []map[string]interface{}{
+				{"property": "receivedAt", "isAscending": false},
+			},
+			"limit": opts.MaxResults,
+		},
+		ID: "q",
+	}
+
+	getCall := methodCall{
+		Name: "Email/get",
+		Args: map[string]interface{}{
+			"accountId":           p.accountID,
+			"properties":          emailProperties,
+			"fetchTextBodyValues": true,
+			"fetchHTMLBodyValues": true,
+			"maxBodyValueBytes":   512 * 1024,
+			"#ids":                resultRef("q", "/ids"),
+		},
+		ID: "g",
+	}
+
+	responses, err := p.call(ctx, queryCall, getCall)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch emails: %w", err)
+	}
+
+	getResp, err := responseByID(responses, "g")
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		List []jmapEmail `json:"list"`
+	}
+	if err := json.Unmarshal(getResp.Args, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode Email/get response: %w", err)
+	}
+
+	emails := make([]email.Email, len(result.List))
+	for i, e := range result.List {
+		emails[i] = convertEmail(e)
+	}
+	return emails, nil
+}
+
+// GetEmail retrieves a single email by ID
+func (p *Provider) GetEmail(ctx context.Context, id string) (*email.Email, error) {
+	if p.apiURL == "" {
+		return nil, fmt.Errorf("not authenticated")
+	}
+
+	responses, err := p.call(ctx, methodCall{
+		Name: "Email/get",
+		Args: map[string]interface{}{
+			"accountId":           p.accountID,
+			"ids":                 []string{id},
+			"properties":          emailProperties,
+			"fetchTextBodyValues": true,
+			"fetchHTMLBodyValues": true,
+			"maxBodyValueBytes":   512 * 1024,
+		},
+		ID: "g",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	getResp, err := responseByID(responses, "g")
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		List []jmapEmail `json:"list"`
+	}
+	if err := json.Unmarshal(getResp.Args, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode Email/get response: %w", err)
+	}
+	if len(result.List) == 0 {
+		return nil, fmt.Errorf("message %s not found", id)
+	}
+
+	converted := convertEmail(result.List[0])
+	return &converted, nil
+}
+
+// jmapEmail is the subset of the JMAP Email object (RFC 8621 section 4.1)
+// this package reads.
+type jmapEmail struct {
+	ID         string                 `json:"id"`
+	ThreadID   string                 `json:"threadId"`
+	Subject    string                 `json:"subject"`
+	From       []jmapAddress          `json:"from"`
+	To         []jmapAddress          `json:"to"`
+	ReceivedAt string                 `json:"receivedAt"`
+	Preview    string                 `json:"preview"`
+	Keywords   map[string]bool        `json:"keywords"`
+	MailboxIDs map[string]bool        `json:"mailboxIds"`
+	TextBody   []jmapBodyPart         `json:"textBody"`
+	HTMLBody   []jmapBodyPart         `json:"htmlBody"`
+	BodyValues map[string]jmapBodyVal `json:"bodyValues"`
+}
+
+type jmapAddress struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type jmapBodyPart struct {
+	PartID string `json:"partId"`
+}
+
+type jmapBodyVal struct {
+	Value string `json:"value"`
+}
+
+// convertEmail converts a JMAP Email object to our provider-agnostic
+// email.Email, the JMAP counterpart to gmail.convertMessage.
+func convertEmail(e jmapEmail) email.Email {
+	out := email.Email{
+		ID:       e.ID,
+		ThreadID: e.ThreadID,
+		Subject:  e.Subject,
+		Snippet:  e.Preview,
+		Body:     bodyText(e),
+		Headers:  make(map[string]string),
+	}
+
+	if len(e.From) > 0 {
+		out.From = email.Address{Name: e.From[0].Name, Email: e.From[0].Email}
+	}
+	for _, to := range e.To {
+		out.To = append(out.To, email.Address{Name: to.Name, Email: to.Email})
+	}
+
+	if t, err := time.Parse(time.RFC3339, e.ReceivedAt); err == nil {
+		out.Date = t
+	}
+
+	for mailboxID, in := range e.MailboxIDs {
+		if in {
+			out.Labels = append(out.Labels, mailboxID)
+		}
+	}
+
+	out.IsRead = e.Keywords["$seen"]
+
+	return out
+}
+
+// bodyText prefers the plain-text body part; JMAP servers only populate
+// bodyValues for parts actually requested via fetchTextBodyValues, so the
+// HTML fallback only ever fires for HTML-only messages.
+func bodyText(e jmapEmail) string {
+	for _, part := range e.TextBody {
+		if val, ok := e.BodyValues[part.PartID]; ok && val.Value != "" {
+			return val.Value
+		}
+	}
+	for _, part := range e.HTMLBody {
+		if val, ok := e.BodyValues[part.PartID]; ok && val.Value != "" {
+			return val.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package jmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SetKeyword adds or removes a JMAP keyword (RFC 8621 section 4.1.1) on a
+// single message via Email/set, e.g. "$seen" or a custom flag like
+// "jobsearch-archived" - the JMAP counterpart to Gmail label pushes, since
+// JMAP has no separate label object of its own to attach.
+func (p *Provider) SetKeyword(ctx context.Context, id, keyword string, value bool) error {
+	if p.apiURL == "" {
+		return fmt.Errorf("not authenticated")
+	}
+
+	responses, err := p.call(ctx, methodCall{
+		Name: "Email/set",
+		Args: map[string]interface{}{
+			"accountId": p.accountID,
+			"update": map[string]interface{}{
+				id: map[string]interface{}{
+					"keywords/" + keyword: value,
+				},
+			},
+		},
+		ID: "s",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set keyword: %w", err)
+	}
+
+	setResp, err := responseByID(responses, "s")
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		NotUpdated map[string]struct {
+			Type        string `json:"type"`
+			Description string `json:"description"`
+		} `json:"notUpdated"`
+	}
+	if err := json.Unmarshal(setResp.Args, &result); err != nil {
+		return fmt.Errorf("failed to decode Email/set response: %w", err)
+	}
+	if failure, ok := result.NotUpdated[id]; ok {
+		return fmt.Errorf("server rejected keyword update (%s): %s", failure.Type, failure.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Package jmap implements email.Provider against a JMAP server (RFC 8620 /
+// RFC 8621), the same interface the gmail package implements against the
+// Gmail API. It's the sibling aerc's "backend" abstraction models: one
+// email.SearchCriteria feeding multiple provider-specific query
+// translators, so a non-Gmail account (Fastmail, Stalwart, a corporate
+// IMAP-via-JMAP proxy) can sync into the same database as Gmail does.
+package jmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// coreCapability and mailCapability are the JMAP capability URIs this
+// package requires the server to support.
+const (
+	coreCapability = "urn:ietf:params:jmap:core"
+	mailCapability = "urn:ietf:params:jmap:mail"
+)
+
+// Provider implements the email.Provider interface against a JMAP server.
+type Provider struct {
+	sessionURL string
+	tokenPath  string
+	httpClient *http.Client
+
+	token     string
+	apiURL    string
+	accountID string
+	userEmail string
+}
+
+// New creates a new JMAP provider. sessionURL is the server's JMAP session
+// endpoint (e.g. "https://api.fastmail.com/jmap/session"); tokenPath is a
+// file holding the bearer API token, mirroring how gmail.New takes a
+// tokenPath for its OAuth token.
+func New(sessionURL, tokenPath string) *Provider {
+	return &Provider{
+		sessionURL: sessionURL,
+		tokenPath:  tokenPath,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// Name returns the provider identifier
+func (p *Provider) Name() string {
+	return "jmap"
+}
+
+// IsAuthenticated checks if a saved API token exists
+func (p *Provider) IsAuthenticated() bool {
+	_, err := loadToken(p.tokenPath)
+	return err == nil
+}
+
+// Authenticate loads the saved API token and fetches the JMAP session
+// resource to discover the mail account's apiUrl and accountId. JMAP has
+// no OAuth dance of its own here - tokenPath is expected to already
+// contain a server-issued API token (e.g. a Fastmail app password).
+func (p *Provider) Authenticate(ctx context.Context) error {
+	token, err := loadToken(p.tokenPath)
+	if err != nil {
+		return fmt.Errorf("failed to read JMAP token file: %w\n\nSave a server-issued API token to: %s", err, p.tokenPath)
+	}
+	p.token = token
+
+	session, err := p.fetchSession(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JMAP session: %w", err)
+	}
+
+	accountID, ok := session.PrimaryAccounts[mailCapability]
+	if !ok {
+		return fmt.Errorf("JMAP server does not advertise a primary mail account")
+	}
+	account, ok := session.Accounts[accountID]
+	if !ok {
+		return fmt.Errorf("JMAP session is missing account %s", accountID)
+	}
+
+	p.apiURL = session.APIURL
+	p.accountID = accountID
+	p.userEmail = account.Name
+	return nil
+}
+
+// GetUserEmail returns the authenticated account's primary address
+func (p *Provider) GetUserEmail(ctx context.Context) (string, error) {
+	if p.userEmail == "" {
+		return "", fmt.Errorf("not authenticated")
+	}
+	return p.userEmail, nil
+}
+
+func loadToken(tokenPath string) (string, error) {
+	data, err := os.ReadFile(tokenPath)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", tokenPath)
+	}
+	return token, nil
+}
+
+type session struct {
+	APIURL          string             `json:"apiUrl"`
+	Accounts        map[string]account `json:"accounts"`
+	PrimaryAccounts map[string]string  `json:"primaryAccounts"`
+}
+
+type account struct {
+	Name string `json:"name"`
+}
+
+func (p *Provider) fetchSession(ctx context.Context) (*session, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.sessionURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.token)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var s session
+	if err := json.NewDecoder(resp.Body).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to decode session: %w", err)
+	}
+	return &s, nil
+}
+
+var _ email.Provider = (*Provider)(nil)
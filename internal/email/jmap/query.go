@@ -0,0 +1,116 @@
+package jmap
+
+import (
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// jmapFlagKeywords maps the HasFlags/NotFlags values buildFilter understands
+// to their IMAP keyword, used via the Email/query FilterCondition's
+// hasKeyword/notKeyword (RFC 8621 section 4.4.1). "attachment" has no
+// keyword - it's JMAP's own boolean hasAttachment property - and is handled
+// separately.
+var jmapFlagKeywords = map[string]string{
+	"unread":    "$seen",
+	"starred":   "$flagged",
+	"important": "$important",
+}
+
+// buildFilter translates a SearchCriteria into a JMAP Email/query Filter
+// object (RFC 8621 section 4.4.1). It's the JMAP-specific counterpart to
+// gmail.buildQuery's translation to X-GM-RAW. All/Any/Not compose into a
+// FilterOperator ({"operator": "AND"/"OR"/"NOT", "conditions": [...]})
+// wrapping this FilterCondition alongside the nested criteria's own filters.
+func buildFilter(crit email.SearchCriteria) map[string]interface{} {
+	filter := map[string]interface{}{}
+
+	if crit.After != nil {
+		filter["after"] = crit.After.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	if crit.Before != nil {
+		filter["before"] = crit.Before.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	if crit.From != "" {
+		filter["from"] = crit.From
+	}
+	if crit.To != "" {
+		filter["to"] = crit.To
+	}
+	if crit.Subject != "" {
+		filter["subject"] = crit.Subject
+	}
+	if crit.Body != "" {
+		filter["body"] = crit.Body
+	}
+	for _, flag := range crit.HasFlags {
+		if flag == "attachment" {
+			filter["hasAttachment"] = true
+			continue
+		}
+		// "unread" means the $seen keyword is absent, not present.
+		if flag == "unread" {
+			filter["notKeyword"] = jmapFlagKeywords[flag]
+			continue
+		}
+		if keyword, ok := jmapFlagKeywords[flag]; ok {
+			filter["hasKeyword"] = keyword
+		}
+	}
+	for _, flag := range crit.NotFlags {
+		if flag == "attachment" {
+			filter["hasAttachment"] = false
+			continue
+		}
+		if flag == "unread" {
+			filter["hasKeyword"] = jmapFlagKeywords[flag]
+			continue
+		}
+		if keyword, ok := jmapFlagKeywords[flag]; ok {
+			filter["notKeyword"] = keyword
+		}
+	}
+	if len(crit.Terms) > 0 {
+		filter["text"] = strings.Join(crit.Terms, " ")
+	}
+	if crit.RawTerms != "" {
+		filter["text"] = crit.RawTerms
+	}
+
+	// IncludeSent, Labels, Headers and NotTerms have no direct JMAP Filter
+	// equivalent - JMAP scopes a query to one mailbox via "inMailbox", and
+	// the mail account doesn't necessarily name a "Sent" mailbox the way
+	// Gmail names a "SENT" label - so they're left for a future
+	// inMailbox/roles lookup rather than guessed at here.
+
+	conditions := []map[string]interface{}{filter}
+	for _, sub := range crit.All {
+		conditions = append(conditions, buildFilter(sub))
+	}
+
+	var anyConditions []map[string]interface{}
+	for _, sub := range crit.Any {
+		anyConditions = append(anyConditions, buildFilter(sub))
+	}
+	if len(anyConditions) > 0 {
+		conditions = append(conditions, map[string]interface{}{
+			"operator":   "OR",
+			"conditions": anyConditions,
+		})
+	}
+
+	if crit.Not != nil {
+		conditions = append(conditions, map[string]interface{}{
+			"operator":   "NOT",
+			"conditions": []map[string]interface{}{buildFilter(*crit.Not)},
+		})
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return map[string]interface{}{
+		"operator":   "AND",
+		"conditions": conditions,
+	}
+}
@@ -26,11 +26,71 @@ type Provider interface {
 	GetUserEmail(ctx context.Context) (string, error)
 }
 
+// ReplySender is implemented by providers that can send an outbound reply
+// threaded into an existing conversation. Not every Provider backs one -
+// IMAP and JMAP are read/search-only today - so callers that need to send
+// (see internal/cli's draft and show --reply commands) type-assert a
+// Provider against this interface rather than requiring it on every one.
+type ReplySender interface {
+	SendReply(ctx context.Context, convID string, body string, inReplyTo Email) error
+}
+
+// SearchCriteria is a backend-neutral email search description. Each
+// Provider translates it into its own query representation - Gmail into an
+// X-GM-RAW search string, JMAP into an Email/query Filter object, IMAP into
+// a SEARCH command - so callers don't need to know which backend a
+// FetchOptions ends up hitting. See internal/search.Criteria for the
+// equivalent abstraction over already-synced conversations/emails in the
+// database; this one is scoped to what a Provider can fetch in the first
+// place.
+type SearchCriteria struct {
+	From    string     // Sender address or domain
+	To      string     // Recipient address
+	Subject string     // Subject substring
+	Body    string     // Body substring
+	After   *time.Time // Only messages dated after this time
+	Before  *time.Time // Only messages dated before this time
+	Labels  []string   // Provider-specific labels/mailboxes to include
+
+	// Headers matches arbitrary message headers by name, each one AND-ed
+	// against the rest of Criteria; multiple values for the same header
+	// name are OR-ed. Only headers a given Provider's backend can search
+	// natively are honored - IMAP supports any header, Gmail only a small
+	// fixed set (see gmail's buildQuery), and JMAP's buildFilter ignores
+	// Headers altogether.
+	Headers map[string][]string
+
+	// HasFlags/NotFlags are provider-neutral message flags to require or
+	// exclude, e.g. "attachment", "unread", "starred". Not every flag has
+	// an equivalent on every backend - see each provider's query builder.
+	HasFlags []string
+	NotFlags []string
+
+	// Terms are bare free-text words, AND-ed together (and against every
+	// other field); NotTerms are excluded the same way.
+	Terms    []string
+	NotTerms []string
+
+	// IncludeSent also searches the account's Sent mailbox.
+	IncludeSent bool
+	// RawTerms is a single provider-specific query fragment, passed
+	// through verbatim - an escape hatch for syntax this struct doesn't
+	// model.
+	RawTerms string
+
+	// All/Any/Not compose this criteria's flat fields (always AND-ed
+	// together) with nested groups, mirroring database.SearchCriteria:
+	// All is AND-ed in, Any is OR-ed together as a single group, and Not
+	// is negated.
+	All []SearchCriteria
+	Any []SearchCriteria
+	Not *SearchCriteria
+}
+
 // FetchOptions configures email fetching
 type FetchOptions struct {
-	MaxResults int        // Maximum number of emails to fetch
-	After      *time.Time // Fetch emails after this date
-	Query      string     // Provider-specific query string
+	MaxResults int            // Maximum number of emails to fetch
+	Criteria   SearchCriteria // What to search for
 }
 
 // DefaultFetchOptions returns sensible defaults
@@ -38,6 +98,6 @@ func DefaultFetchOptions() FetchOptions {
 	after := time.Now().AddDate(0, -1, 0) // Last 30 days
 	return FetchOptions{
 		MaxResults: 100,
-		After:      &after,
+		Criteria:   SearchCriteria{After: &after},
 	}
 }
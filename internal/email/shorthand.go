@@ -0,0 +1,147 @@
+package email
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseSearchShorthand translates an aerc-style query string into a
+// SearchCriteria for Provider.FetchEmails. Unlike database.ParseQuery, this
+// is deliberately simpler: there's no explicit AND/OR keyword or
+// parenthesized grouping, just implicit AND between tokens and a leading
+// "-" for negation, matching real aerc filter syntax. Example:
+//
+//	from:recruiter@acme.com -has:attachment newer-than:14d "onsite"
+//
+// becomes From="recruiter@acme.com", NotFlags=["attachment"],
+// After=now-14d, and Terms=["onsite"]. Fields support double-quoted values
+// with spaces (subject:"senior engineer"). Unrecognized field:value tokens
+// fall back to a bare term, same as database.ParseQuery, so a typo degrades
+// to a free-text search instead of an error.
+func ParseSearchShorthand(s string) (SearchCriteria, error) {
+	var crit SearchCriteria
+	for _, token := range tokenizeShorthand(s) {
+		negate := false
+		if rest, ok := strings.CutPrefix(token, "-"); ok {
+			negate = true
+			token = rest
+		}
+
+		field, value, ok := strings.Cut(token, ":")
+		if !ok || value == "" {
+			if negate {
+				crit.NotTerms = append(crit.NotTerms, token)
+			} else {
+				crit.Terms = append(crit.Terms, token)
+			}
+			continue
+		}
+
+		switch strings.ToLower(field) {
+		case "from", "to", "subject", "label", "newer-than", "older-than":
+			if negate {
+				// These fields have no Not* list of their own, so the
+				// negated field is AND-ed in as its own NOT group.
+				sub, err := ParseSearchShorthand(field + `:"` + value + `"`)
+				if err != nil {
+					return SearchCriteria{}, err
+				}
+				crit.All = append(crit.All, SearchCriteria{Not: &sub})
+				continue
+			}
+		}
+
+		switch strings.ToLower(field) {
+		case "from":
+			crit.From = value
+		case "to":
+			crit.To = value
+		case "subject":
+			crit.Subject = value
+		case "body":
+			if negate {
+				crit.NotTerms = append(crit.NotTerms, value)
+			} else {
+				crit.Terms = append(crit.Terms, value)
+			}
+		case "label":
+			crit.Labels = append(crit.Labels, value)
+		case "has":
+			if negate {
+				crit.NotFlags = append(crit.NotFlags, value)
+			} else {
+				crit.HasFlags = append(crit.HasFlags, value)
+			}
+		case "newer-than":
+			d, err := parseShorthandDuration(value)
+			if err != nil {
+				return SearchCriteria{}, err
+			}
+			after := time.Now().Add(-d)
+			crit.After = &after
+		case "older-than":
+			d, err := parseShorthandDuration(value)
+			if err != nil {
+				return SearchCriteria{}, err
+			}
+			before := time.Now().Add(-d)
+			crit.Before = &before
+		default:
+			if negate {
+				crit.NotTerms = append(crit.NotTerms, token)
+			} else {
+				crit.Terms = append(crit.Terms, token)
+			}
+		}
+	}
+
+	return crit, nil
+}
+
+// tokenizeShorthand splits s on whitespace, except inside double quotes (so
+// subject:"senior engineer" stays one token with the quotes removed) - the
+// same rule database.tokenizeQuery uses, minus the "(" / ")" splitting this
+// grammar doesn't have.
+func tokenizeShorthand(s string) []string {
+	var tokens []string
+	var buf strings.Builder
+	inQuotes := false
+
+	flush := func() {
+		if buf.Len() > 0 {
+			tokens = append(tokens, buf.String())
+			buf.Reset()
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+			buf.WriteRune(r)
+		case r == ' ' || r == '\t' || r == '\n':
+			flush()
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// parseShorthandDuration parses a suffix-duration like "14d" or "12h", the
+// same "d" extension over time.ParseDuration that database.parseDayDuration
+// and CommandProcessor's snooze command use.
+func parseShorthandDuration(value string) (time.Duration, error) {
+	if days, isDays := strings.CutSuffix(value, "d"); isDays {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
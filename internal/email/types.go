@@ -3,6 +3,8 @@ package email
 import (
 	"strings"
 	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/calendar"
 )
 
 // Email represents a provider-agnostic email message
@@ -18,6 +20,7 @@ type Email struct {
 	Labels   []string          // Provider-specific labels
 	IsRead   bool              // Read status
 	Headers  map[string]string // Selected headers
+	Events   []calendar.Event  // Parsed text/calendar attachments (interview invites/cancellations)
 }
 
 // Address represents an email address with optional name
@@ -61,6 +64,18 @@ func (e *Email) Direction(myEmail string) string {
 	return "inbound"
 }
 
+// Header returns a header by name, case-insensitively, along with whether
+// it was present. Providers preserve the header's original casing (e.g.
+// "Message-ID"), so an exact map lookup on Headers is unreliable.
+func (e *Email) Header(name string) (string, bool) {
+	for k, v := range e.Headers {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
 // ParseAddress parses an email address string like "Name <email@example.com>"
 func ParseAddress(s string) Address {
 	s = strings.TrimSpace(s)
@@ -0,0 +1,21 @@
+package email
+
+import "regexp"
+
+// unsubscribeIntentPattern matches common opt-out phrasing in a message
+// body, for senders that don't set a List-Unsubscribe header.
+var unsubscribeIntentPattern = regexp.MustCompile(`(?i)\b(unsubscribe|opt[- ]?out|remove me from (your|this) (list|mailing))\b`)
+
+// DetectUnsubscribeIntent recognizes a sender asking to stop receiving
+// mail, either via the machine-readable List-Unsubscribe header or common
+// body phrasing. ok is false if e doesn't look like an opt-out request;
+// source is "header" or "body", for recording how it was detected.
+func DetectUnsubscribeIntent(e *Email) (source string, ok bool) {
+	if _, hasHeader := e.Header("List-Unsubscribe"); hasHeader {
+		return "header", true
+	}
+	if unsubscribeIntentPattern.MatchString(e.Body) {
+		return "body", true
+	}
+	return "", false
+}
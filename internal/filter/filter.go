@@ -15,6 +15,10 @@ const (
 	LayerUncertain Layer = "uncertain"
 	LayerRejected  Layer = "rejected"
 	LayerLLM       Layer = "llm"
+	LayerOptedOut  Layer = "opted_out"
+	// LayerBayes marks an uncertain email the local Bayesian classifier
+	// scored as spam with enough confidence to skip the LLM entirely.
+	LayerBayes Layer = "bayes"
 )
 
 // Result represents the outcome of filtering an email
@@ -43,6 +47,11 @@ type Filter struct {
 	learnedSubjectBlacklist []string
 	learnedSubjectKeywords  []string
 	learnedBodyKeywords     []string
+
+	// optOuts holds addresses (lowercased) that have asked to be
+	// unsubscribed, or been opted out by the user via
+	// Tracker.OptOutRecruiter. Checked before every other layer.
+	optOuts map[string]bool
 }
 
 // New creates a new Filter with the given configuration
@@ -55,6 +64,7 @@ func New(cfg config.FilterConfig) *Filter {
 			IncludeThreshold: 0.3,  // Include if score >= 30%
 			UncertainMin:     0.02, // Uncertain if score >= 2% (let LLM decide)
 		}),
+		optOuts: make(map[string]bool),
 	}
 }
 
@@ -63,6 +73,14 @@ func (f *Filter) SetUserEmail(email string) {
 	f.userEmail = email
 }
 
+// SetConfig replaces the static [filters] configuration (domain/subject/
+// body lists), leaving learned filters and opt-outs untouched - used by
+// a config.Watcher to pick up an edited config without losing filters
+// learned since the daemon started.
+func (f *Filter) SetConfig(cfg config.FilterConfig) {
+	f.config = cfg
+}
+
 // AddLearnedFilters adds learned filters to the filter configuration
 func (f *Filter) AddLearnedFilters(filterType string, values []string) {
 	switch filterType {
@@ -106,6 +124,13 @@ func (f *Filter) GetAllBodyKeywords() []string {
 
 // Apply runs the email through the filtering pipeline
 func (f *Filter) Apply(e *email.Email) Result {
+	// Layer 0: Opt-out (hard exclude; overrides even the whitelist, since an
+	// opted-out recruiter sending from an ATS domain still shouldn't reach
+	// the user again)
+	if result := f.checkOptOut(e); result != nil {
+		return *result
+	}
+
 	// Layer 1: Domain whitelist (auto-include)
 	if result := f.checkDomainWhitelist(e); result != nil {
 		return *result
@@ -170,6 +195,8 @@ type Stats struct {
 	ByKeyword   int
 	Uncertain   int
 	Rejected    int
+	OptedOut    int
+	Bayes       int
 }
 
 // GetStats returns statistics about filtered emails
@@ -188,6 +215,10 @@ func GetStats(filtered []FilteredEmail) Stats {
 			stats.Uncertain++
 		case LayerRejected:
 			stats.Rejected++
+		case LayerOptedOut:
+			stats.OptedOut++
+		case LayerBayes:
+			stats.Bayes++
 		}
 	}
 
@@ -0,0 +1,56 @@
+package filter
+
+import (
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/search"
+)
+
+// Match reports whether e satisfies c, the same predicate language
+// database.QueryEmails/SearchConversations run as SQL - so a batch already
+// sitting in memory (e.g. the output of ApplyBatch, before it's ever
+// reached the database) can be re-queried with the exact criteria an MCP
+// caller composed, without a round trip.
+//
+// Only the fields search.Criteria can evaluate against a bare
+// provider-agnostic email.Email are applied: SubjectContains, BodyContains,
+// and the date range. Companies, Recruiters, Statuses, ClassificationIn,
+// ConfidenceMin/Max and HasPosition describe Conversation/database.Email
+// state that doesn't exist on email.Email, so they're ignored here rather
+// than forcing Match to take a database handle just to look them up -
+// callers who need those predicates run database.QueryEmails instead.
+// Directions is skipped too: telling inbound from outbound requires the
+// user's own address, which isn't part of e or c.
+func Match(e *email.Email, c search.Criteria) bool {
+	if len(c.SubjectContains) > 0 && !containsAny(e.Subject, c.SubjectContains) {
+		return false
+	}
+	if len(c.BodyContains) > 0 {
+		body := e.Body
+		if body == "" {
+			body = e.Snippet
+		}
+		if !containsAny(body, c.BodyContains) {
+			return false
+		}
+	}
+	if c.DateAfter != nil && e.Date.Before(*c.DateAfter) {
+		return false
+	}
+	if c.DateBefore != nil && e.Date.After(*c.DateBefore) {
+		return false
+	}
+	return true
+}
+
+// containsAny reports whether text contains any of needles, case-insensitively.
+func containsAny(text string, needles []string) bool {
+	lower := strings.ToLower(text)
+	for _, n := range needles {
+		if strings.Contains(lower, strings.ToLower(n)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/search"
+)
+
+func TestMatch_SubjectContains(t *testing.T) {
+	e := &email.Email{Subject: "Re: Senior Engineer role at Acme"}
+
+	if !Match(e, search.Criteria{SubjectContains: []string{"senior engineer"}}) {
+		t.Error("expected subject match, got false")
+	}
+	if Match(e, search.Criteria{SubjectContains: []string{"recruiter spam"}}) {
+		t.Error("expected no subject match, got true")
+	}
+}
+
+func TestMatch_BodyContains(t *testing.T) {
+	e := &email.Email{Body: "We'd love to schedule a phone screen next week."}
+
+	if !Match(e, search.Criteria{BodyContains: []string{"phone screen"}}) {
+		t.Error("expected body match, got false")
+	}
+
+	eSnippetOnly := &email.Email{Snippet: "We'd love to schedule a phone screen"}
+	if !Match(eSnippetOnly, search.Criteria{BodyContains: []string{"phone screen"}}) {
+		t.Error("expected snippet fallback match, got false")
+	}
+}
+
+func TestMatch_DateRange(t *testing.T) {
+	e := &email.Email{Date: time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)}
+
+	after := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	if !Match(e, search.Criteria{DateAfter: &after, DateBefore: &before}) {
+		t.Error("expected date within range to match")
+	}
+
+	tooLate := time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC)
+	if Match(e, search.Criteria{DateBefore: &tooLate}) {
+		t.Error("expected date after DateBefore to not match")
+	}
+}
+
+func TestMatch_ZeroCriteriaMatchesEverything(t *testing.T) {
+	e := &email.Email{Subject: "anything"}
+	if !Match(e, search.Criteria{}) {
+		t.Error("expected zero-value criteria to match any email")
+	}
+}
@@ -0,0 +1,33 @@
+package filter
+
+import (
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// AddOptOuts marks addresses as opted out, so future emails from (or, for
+// an outbound email, to) them are excluded regardless of whitelist or LLM
+// verdict. Addresses are matched case-insensitively.
+func (f *Filter) AddOptOuts(addresses ...string) {
+	for _, addr := range addresses {
+		f.optOuts[strings.ToLower(addr)] = true
+	}
+}
+
+// checkOptOut checks whether the relevant address (sender for inbound,
+// recipient for outbound) has opted out - of an unsubscribe request we
+// detected, or a user-issued Tracker.OptOutRecruiter.
+func (f *Filter) checkOptOut(e *email.Email) *Result {
+	_, relevantEmail := f.getRelevantAddress(e)
+	if relevantEmail == "" || !f.optOuts[relevantEmail] {
+		return nil
+	}
+
+	return &Result{
+		Include:    false,
+		Layer:      LayerOptedOut,
+		Confidence: 1.0,
+		Reason:     "Opted out: " + relevantEmail,
+	}
+}
@@ -0,0 +1,183 @@
+package filter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// rakeStopwords splits candidate phrases the same way RAKE's original paper
+// does: any run of words NOT broken by one of these (or by punctuation) is a
+// candidate phrase. It's a short, generic English stoplist rather than a
+// domain-specific one, since the phrases it's meant to isolate here
+// (recruiting language) are domain-specific by construction.
+var rakeStopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "has": true, "have": true,
+	"i": true, "in": true, "is": true, "it": true, "its": true, "of": true,
+	"on": true, "or": true, "our": true, "that": true, "the": true, "their": true,
+	"this": true, "to": true, "was": true, "we": true, "were": true, "will": true,
+	"with": true, "you": true, "your": true, "if": true, "but": true, "so": true,
+	"not": true, "no": true, "can": true, "could": true, "would": true, "should": true,
+	"just": true, "about": true, "all": true, "also": true, "been": true, "into": true,
+}
+
+// rakeOptions bounds the phrases rakeKeywords keeps, mirroring the RAKE
+// paper's usual filters.
+type rakeOptions struct {
+	minCharacters int
+	maxWords      int
+	minFrequency  int
+	topN          int
+}
+
+// splitCandidates breaks text into candidate phrases at stopwords and
+// punctuation, lowercasing as it goes.
+func splitCandidates(text string) [][]string {
+	var phrases [][]string
+	var current []string
+
+	flush := func() {
+		if len(current) > 0 {
+			phrases = append(phrases, current)
+			current = nil
+		}
+	}
+
+	for _, field := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !((r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '\'')
+	}) {
+		if rakeStopwords[field] {
+			flush()
+			continue
+		}
+		current = append(current, field)
+	}
+	flush()
+
+	return phrases
+}
+
+// rakeKeywords runs RAKE (Rapid Automatic Keyword Extraction) over texts
+// pooled together: split into candidate phrases by rakeStopwords and
+// punctuation, score each word by deg(w)/freq(w) - deg being its total
+// co-occurrence degree across all candidate phrases it appears in, and freq
+// its raw count - then score each phrase as the sum of its words' scores.
+// Phrases are kept if they clear opts.minCharacters/maxWords/minFrequency,
+// highest-scoring opts.topN first.
+func rakeKeywords(texts []string, opts rakeOptions) []string {
+	var candidates [][]string
+	for _, text := range texts {
+		candidates = append(candidates, splitCandidates(text)...)
+	}
+
+	freq := make(map[string]int)
+	deg := make(map[string]int)
+	for _, phrase := range candidates {
+		degree := len(phrase) - 1 // co-occurrence with every other word in the phrase
+		for _, w := range phrase {
+			freq[w]++
+			deg[w] += degree
+		}
+	}
+
+	wordScore := make(map[string]float64, len(freq))
+	for w, f := range freq {
+		wordScore[w] = float64(deg[w]+f) / float64(f) // deg(w) here counts self-degree too, i.e. +f
+	}
+
+	type phraseCount struct {
+		phrase string
+		score  float64
+		count  int
+	}
+	phraseInfo := make(map[string]*phraseCount)
+	for _, words := range candidates {
+		if len(words) > opts.maxWords {
+			continue
+		}
+		phrase := strings.Join(words, " ")
+		if len(phrase) < opts.minCharacters {
+			continue
+		}
+		pc, ok := phraseInfo[phrase]
+		if !ok {
+			var score float64
+			for _, w := range words {
+				score += wordScore[w]
+			}
+			pc = &phraseCount{phrase: phrase, score: score}
+			phraseInfo[phrase] = pc
+		}
+		pc.count++
+	}
+
+	var kept []*phraseCount
+	for _, pc := range phraseInfo {
+		if pc.count >= opts.minFrequency {
+			kept = append(kept, pc)
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool {
+		if kept[i].score != kept[j].score {
+			return kept[i].score > kept[j].score
+		}
+		return kept[i].phrase < kept[j].phrase // stable tie-break
+	})
+
+	if len(kept) > opts.topN {
+		kept = kept[:opts.topN]
+	}
+
+	out := make([]string, len(kept))
+	for i, pc := range kept {
+		out[i] = pc.phrase
+	}
+	return out
+}
+
+// LearnKeywordsFromCorpus runs rakeKeywords separately over emails' subjects
+// and bodies (the two scoreKeywords fields), merges the newly discovered
+// phrases into the learned subject/body keyword lists via AddLearnedFilters,
+// and returns only the phrases that weren't already known - i.e. the ones a
+// caller still needs to persist - so a repeat run against the same corpus
+// doesn't keep re-suggesting what's already learned.
+func (f *Filter) LearnKeywordsFromCorpus(emails []*email.Email) (subjectKeywords, bodyKeywords []string) {
+	opts := rakeOptions{minCharacters: 3, maxWords: 2, minFrequency: 2, topN: 10}
+
+	var subjects, bodies []string
+	for _, e := range emails {
+		if e.Subject != "" {
+			subjects = append(subjects, e.Subject)
+		}
+		if e.Body != "" {
+			bodies = append(bodies, e.Body)
+		}
+	}
+
+	known := make(map[string]bool)
+	for _, kw := range f.GetAllSubjectKeywords() {
+		known[strings.ToLower(kw)] = true
+	}
+	for _, phrase := range rakeKeywords(subjects, opts) {
+		if !known[phrase] {
+			subjectKeywords = append(subjectKeywords, phrase)
+		}
+	}
+
+	known = make(map[string]bool)
+	for _, kw := range f.GetAllBodyKeywords() {
+		known[strings.ToLower(kw)] = true
+	}
+	for _, phrase := range rakeKeywords(bodies, opts) {
+		if !known[phrase] {
+			bodyKeywords = append(bodyKeywords, phrase)
+		}
+	}
+
+	f.AddLearnedFilters("subject_keyword", subjectKeywords)
+	f.AddLearnedFilters("body_keyword", bodyKeywords)
+
+	return subjectKeywords, bodyKeywords
+}
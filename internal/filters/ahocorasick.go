@@ -0,0 +1,106 @@
+package filters
+
+// ahoCorasick is a minimal multi-pattern string matcher: it finds every
+// pattern that occurs anywhere in a text in a single left-to-right scan,
+// rather than the O(patterns*len(text)) cost of matching each keyword with
+// its own strings.Contains pass. Patterns are matched case-insensitively and
+// each node's failure link is built with the standard BFS construction.
+type ahoCorasick struct {
+	root  *acNode
+	built bool
+}
+
+type acNode struct {
+	children map[byte]*acNode
+	fail     *acNode
+	// ids collects the MatchedFilterID(s) of every pattern ending at this
+	// node, including ones inherited via the failure link's own output set.
+	ids []MatchedFilterID
+}
+
+func newACNode() *acNode {
+	return &acNode{children: make(map[byte]*acNode)}
+}
+
+func newAhoCorasick() *ahoCorasick {
+	return &ahoCorasick{root: newACNode()}
+}
+
+// addPattern inserts a lowercased pattern into the trie, tagging its
+// terminal node with id. The same pattern can be added more than once (e.g.
+// by two different filters) and will report both ids on a match.
+func (a *ahoCorasick) addPattern(pattern string, id MatchedFilterID) {
+	node := a.root
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		child, ok := node.children[c]
+		if !ok {
+			child = newACNode()
+			node.children[c] = child
+		}
+		node = child
+	}
+	node.ids = append(node.ids, id)
+	a.built = false
+}
+
+// build computes failure links via BFS over the trie. It must run once after
+// all patterns are added and before the first match.
+func (a *ahoCorasick) build() {
+	queue := make([]*acNode, 0, len(a.root.children))
+	for _, child := range a.root.children {
+		child.fail = a.root
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for c, child := range node.children {
+			fail := node.fail
+			for fail != nil && fail.children[c] == nil {
+				fail = fail.fail
+			}
+			if fail == nil {
+				child.fail = a.root
+			} else {
+				child.fail = fail.children[c]
+			}
+			child.ids = append(child.ids, child.fail.ids...)
+			queue = append(queue, child)
+		}
+	}
+
+	a.built = true
+}
+
+// match scans text once (already expected to be lowercased by the caller)
+// and returns the deduplicated IDs of every pattern found anywhere in it.
+func (a *ahoCorasick) match(text string) []MatchedFilterID {
+	if !a.built {
+		a.build()
+	}
+
+	node := a.root
+	var found []MatchedFilterID
+	seen := make(map[MatchedFilterID]struct{})
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		for node != a.root && node.children[c] == nil {
+			node = node.fail
+		}
+		if child, ok := node.children[c]; ok {
+			node = child
+		}
+		for _, id := range node.ids {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				found = append(found, id)
+			}
+		}
+	}
+
+	return found
+}
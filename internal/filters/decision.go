@@ -0,0 +1,34 @@
+// Package filters turns confirmed learned_filters rows into an efficient,
+// hot-reloading matcher that can classify an email in a single pass, instead
+// of the filter package's pattern of every caller reloading and walking the
+// learned lists by hand (see internal/cli/sync.go's loadLearnedFilters).
+package filters
+
+// Decision is the outcome of classifying an email against the loaded filters.
+type Decision int
+
+const (
+	// DecisionNeutral means no loaded filter matched the email; the caller
+	// should fall through to its own default handling.
+	DecisionNeutral Decision = iota
+	// DecisionAllow means a whitelist filter matched.
+	DecisionAllow
+	// DecisionDeny means a blacklist, keyword, regex, or sender filter matched.
+	DecisionDeny
+)
+
+// String returns a human-readable name for the decision, mainly for logging.
+func (d Decision) String() string {
+	switch d {
+	case DecisionAllow:
+		return "allow"
+	case DecisionDeny:
+		return "deny"
+	default:
+		return "neutral"
+	}
+}
+
+// MatchedFilterID is the ID of a learned_filters row that fired during
+// classification.
+type MatchedFilterID string
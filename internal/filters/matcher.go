@@ -0,0 +1,208 @@
+package filters
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// DefaultTTL is how long a loaded snapshot of learned filters is trusted
+// before Classify transparently reloads it. Reload can also be called
+// explicitly (e.g. right after a filter is approved) to avoid waiting it out.
+const DefaultTTL = 5 * time.Minute
+
+type regexFilter struct {
+	id MatchedFilterID
+	re *regexp.Regexp
+}
+
+// snapshot holds one generation of loaded filters. Matcher swaps this
+// pointer wholesale on reload so Classify never observes a half-built state.
+type snapshot struct {
+	domainWhitelist map[string]MatchedFilterID
+	domainBlacklist map[string]MatchedFilterID
+	senderExact     map[string]MatchedFilterID
+	subjectRegex    []regexFilter
+	subjectAC       *ahoCorasick
+	bodyAC          *ahoCorasick
+}
+
+// Matcher classifies emails against the set of confirmed learned filters,
+// without every caller having to reload and walk the lists itself (see
+// internal/filter.Filter.AddLearnedFilters and internal/cli/sync.go's
+// loadLearnedFilters for the pattern this replaces). It loads filters at
+// startup, hot-reloads them once the TTL elapses, and can also be reloaded
+// on demand via Reload.
+type Matcher struct {
+	db  *database.DB
+	ttl time.Duration
+
+	mu       sync.RWMutex
+	snap     *snapshot
+	loadedAt time.Time
+}
+
+// New creates a Matcher backed by db. Call Reload (or Classify, which loads
+// lazily on first use) before relying on it to actually match anything.
+func New(db *database.DB) *Matcher {
+	return &Matcher{
+		db:  db,
+		ttl: DefaultTTL,
+	}
+}
+
+// SetTTL overrides the default hot-reload interval, mainly for tests that
+// want to force a reload without waiting.
+func (m *Matcher) SetTTL(ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ttl = ttl
+}
+
+// Reload fetches every confirmed (user or ai_confirmed) learned filter from
+// the database and rebuilds the lookup structures used by Classify.
+func (m *Matcher) Reload(ctx context.Context) error {
+	snap := &snapshot{
+		domainWhitelist: make(map[string]MatchedFilterID),
+		domainBlacklist: make(map[string]MatchedFilterID),
+		senderExact:     make(map[string]MatchedFilterID),
+		subjectAC:       newAhoCorasick(),
+		bodyAC:          newAhoCorasick(),
+	}
+
+	for _, filterType := range []string{
+		database.FilterTypeDomainWhitelist,
+		database.FilterTypeDomainBlacklist,
+		database.FilterTypeSubjectKeyword,
+		database.FilterTypeBodyKeyword,
+		database.FilterTypeSubjectBlacklist,
+		database.FilterTypeRegex,
+		database.FilterTypeSenderExact,
+	} {
+		userFilters, err := m.db.ListLearnedFilters(ctx, database.LearnedFilterListOptions{FilterType: &filterType, Source: stringPtr(database.FilterSourceUser)})
+		if err != nil {
+			return err
+		}
+		confirmedFilters, err := m.db.ListLearnedFilters(ctx, database.LearnedFilterListOptions{FilterType: &filterType, Source: stringPtr(database.FilterSourceAIConfirmed)})
+		if err != nil {
+			return err
+		}
+
+		for _, f := range append(userFilters, confirmedFilters...) {
+			id := MatchedFilterID(f.ID)
+			value := strings.ToLower(f.Value)
+
+			switch filterType {
+			case database.FilterTypeDomainWhitelist:
+				snap.domainWhitelist[value] = id
+			case database.FilterTypeDomainBlacklist:
+				snap.domainBlacklist[value] = id
+			case database.FilterTypeSenderExact:
+				snap.senderExact[value] = id
+			case database.FilterTypeSubjectKeyword, database.FilterTypeSubjectBlacklist:
+				snap.subjectAC.addPattern(value, id)
+			case database.FilterTypeBodyKeyword:
+				snap.bodyAC.addPattern(value, id)
+			case database.FilterTypeRegex:
+				re, err := regexp.Compile("(?i)" + f.Value)
+				if err != nil {
+					// A user-entered filter can contain an invalid pattern;
+					// skip it rather than failing the whole reload.
+					continue
+				}
+				snap.subjectRegex = append(snap.subjectRegex, regexFilter{id: id, re: re})
+			}
+		}
+	}
+
+	snap.subjectAC.build()
+	snap.bodyAC.build()
+
+	m.mu.Lock()
+	m.snap = snap
+	m.loadedAt = time.Now()
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *Matcher) currentSnapshot(ctx context.Context) (*snapshot, error) {
+	m.mu.RLock()
+	snap := m.snap
+	stale := snap == nil || time.Since(m.loadedAt) > m.ttl
+	m.mu.RUnlock()
+
+	if !stale {
+		return snap, nil
+	}
+	if err := m.Reload(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.snap, nil
+}
+
+// Classify evaluates e against the loaded filters and returns the decision
+// plus the IDs of every filter that fired. A whitelist match wins outright;
+// otherwise any blacklist, keyword, regex, or sender match denies. Every
+// filter that fires has its match_count/last_matched_at bumped via
+// database.DB.IncrementMatchCount.
+func (m *Matcher) Classify(ctx context.Context, e *email.Email) (Decision, []MatchedFilterID, error) {
+	snap, err := m.currentSnapshot(ctx)
+	if err != nil {
+		return DecisionNeutral, nil, err
+	}
+
+	domain := e.Domain()
+	sender := strings.ToLower(e.From.Email)
+	subjectLower := strings.ToLower(e.Subject)
+	bodyLower := strings.ToLower(e.Body)
+	if bodyLower == "" {
+		bodyLower = strings.ToLower(e.Snippet)
+	}
+
+	if id, ok := snap.domainWhitelist[domain]; ok {
+		m.recordMatch(ctx, id)
+		return DecisionAllow, []MatchedFilterID{id}, nil
+	}
+
+	var denied []MatchedFilterID
+
+	if id, ok := snap.domainBlacklist[domain]; ok {
+		denied = append(denied, id)
+	}
+	if id, ok := snap.senderExact[sender]; ok {
+		denied = append(denied, id)
+	}
+	denied = append(denied, snap.subjectAC.match(subjectLower)...)
+	denied = append(denied, snap.bodyAC.match(bodyLower)...)
+	for _, rf := range snap.subjectRegex {
+		if rf.re.MatchString(e.Subject) {
+			denied = append(denied, rf.id)
+		}
+	}
+
+	if len(denied) == 0 {
+		return DecisionNeutral, nil, nil
+	}
+
+	for _, id := range denied {
+		m.recordMatch(ctx, id)
+	}
+	return DecisionDeny, denied, nil
+}
+
+// recordMatch bumps the filter's usage counter. It logs nowhere and returns
+// nothing on failure - a failed counter update shouldn't change the
+// classification result the caller already has.
+func (m *Matcher) recordMatch(ctx context.Context, id MatchedFilterID) {
+	_ = m.db.IncrementMatchCount(ctx, string(id))
+}
+
+func stringPtr(s string) *string { return &s }
@@ -0,0 +1,197 @@
+package filters
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+func setupTestMatcher(t *testing.T) (*Matcher, *database.DB, func()) {
+	t.Helper()
+
+	tmpDir, err := os.MkdirTemp("", "jobsearch-filters-test-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+
+	db, err := database.Open(filepath.Join(tmpDir, "test.db"))
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		t.Fatalf("failed to open database: %v", err)
+	}
+
+	cleanup := func() {
+		db.Close()
+		os.RemoveAll(tmpDir)
+	}
+
+	return New(db), db, cleanup
+}
+
+func mustCreateFilter(t *testing.T, db *database.DB, filterType, value, source string) string {
+	t.Helper()
+	f := &database.LearnedFilter{FilterType: filterType, Value: value, Source: source}
+	if err := db.CreateLearnedFilter(context.Background(), f); err != nil {
+		t.Fatalf("failed to create learned filter: %v", err)
+	}
+	return f.ID
+}
+
+func TestClassifyDomainWhitelist(t *testing.T) {
+	m, db, cleanup := setupTestMatcher(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	id := mustCreateFilter(t, db, database.FilterTypeDomainWhitelist, "goodco.com", database.FilterSourceUser)
+
+	e := &email.Email{From: email.Address{Email: "recruiter@goodco.com"}, Subject: "Hello"}
+	decision, matched, err := m.Classify(ctx, e)
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if decision != DecisionAllow {
+		t.Fatalf("expected DecisionAllow, got %v", decision)
+	}
+	if len(matched) != 1 || string(matched[0]) != id {
+		t.Fatalf("expected match %s, got %v", id, matched)
+	}
+
+	f, err := db.GetLearnedFilter(ctx, id)
+	if err != nil {
+		t.Fatalf("GetLearnedFilter failed: %v", err)
+	}
+	if f.MatchCount != 1 {
+		t.Errorf("expected match_count 1, got %d", f.MatchCount)
+	}
+	if f.LastMatchedAt == nil {
+		t.Error("expected last_matched_at to be set")
+	}
+}
+
+func TestClassifyDomainBlacklist(t *testing.T) {
+	m, db, cleanup := setupTestMatcher(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	id := mustCreateFilter(t, db, database.FilterTypeDomainBlacklist, "spammer.com", database.FilterSourceAIConfirmed)
+
+	e := &email.Email{From: email.Address{Email: "bot@spammer.com"}, Subject: "Buy now"}
+	decision, matched, err := m.Classify(ctx, e)
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if decision != DecisionDeny {
+		t.Fatalf("expected DecisionDeny, got %v", decision)
+	}
+	if len(matched) != 1 || string(matched[0]) != id {
+		t.Fatalf("expected match %s, got %v", id, matched)
+	}
+}
+
+func TestClassifyKeywords(t *testing.T) {
+	m, db, cleanup := setupTestMatcher(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	subjectID := mustCreateFilter(t, db, database.FilterTypeSubjectKeyword, "unsubscribe", database.FilterSourceUser)
+	bodyID := mustCreateFilter(t, db, database.FilterTypeBodyKeyword, "limited time offer", database.FilterSourceUser)
+
+	e := &email.Email{
+		From:    email.Address{Email: "sales@unknown.com"},
+		Subject: "Please unsubscribe from this list",
+		Body:    "This is a limited time offer just for you.",
+	}
+	decision, matched, err := m.Classify(ctx, e)
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if decision != DecisionDeny {
+		t.Fatalf("expected DecisionDeny, got %v", decision)
+	}
+
+	seen := map[string]bool{}
+	for _, id := range matched {
+		seen[string(id)] = true
+	}
+	if !seen[subjectID] || !seen[bodyID] {
+		t.Fatalf("expected both %s and %s to match, got %v", subjectID, bodyID, matched)
+	}
+}
+
+func TestClassifyRegexAndSenderExact(t *testing.T) {
+	m, db, cleanup := setupTestMatcher(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	regexID := mustCreateFilter(t, db, database.FilterTypeRegex, `^re: re: re:`, database.FilterSourceUser)
+	senderID := mustCreateFilter(t, db, database.FilterTypeSenderExact, "noreply@junk.com", database.FilterSourceUser)
+
+	regexMatch := &email.Email{From: email.Address{Email: "someone@else.com"}, Subject: "RE: RE: RE: quick question"}
+	decision, matched, err := m.Classify(ctx, regexMatch)
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if decision != DecisionDeny || len(matched) != 1 || string(matched[0]) != regexID {
+		t.Fatalf("expected regex filter %s to fire, got %v/%v", regexID, decision, matched)
+	}
+
+	senderMatch := &email.Email{From: email.Address{Email: "noreply@junk.com"}, Subject: "Hi"}
+	decision, matched, err = m.Classify(ctx, senderMatch)
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if decision != DecisionDeny || len(matched) != 1 || string(matched[0]) != senderID {
+		t.Fatalf("expected sender filter %s to fire, got %v/%v", senderID, decision, matched)
+	}
+}
+
+func TestClassifyNeutral(t *testing.T) {
+	m, _, cleanup := setupTestMatcher(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	e := &email.Email{From: email.Address{Email: "someone@example.com"}, Subject: "Catching up"}
+	decision, matched, err := m.Classify(ctx, e)
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if decision != DecisionNeutral {
+		t.Fatalf("expected DecisionNeutral, got %v", decision)
+	}
+	if matched != nil {
+		t.Fatalf("expected no matches, got %v", matched)
+	}
+}
+
+func TestReloadPicksUpNewFilters(t *testing.T) {
+	m, db, cleanup := setupTestMatcher(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	e := &email.Email{From: email.Address{Email: "someone@newspam.com"}, Subject: "Hi"}
+	decision, _, err := m.Classify(ctx, e)
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if decision != DecisionNeutral {
+		t.Fatalf("expected DecisionNeutral before filter exists, got %v", decision)
+	}
+
+	mustCreateFilter(t, db, database.FilterTypeDomainBlacklist, "newspam.com", database.FilterSourceUser)
+
+	if err := m.Reload(ctx); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	decision, _, err = m.Classify(ctx, e)
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if decision != DecisionDeny {
+		t.Fatalf("expected DecisionDeny after reload, got %v", decision)
+	}
+}
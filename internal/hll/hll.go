@@ -0,0 +1,100 @@
+// Package hll implements a small HyperLogLog cardinality sketch, used where
+// the repo needs an approximate, idempotent "have I counted this before"
+// check without storing the full set of seen keys.
+package hll
+
+import (
+	"errors"
+	"hash/fnv"
+	"math"
+)
+
+// precision controls the number of registers (2^precision) and therefore the
+// tradeoff between sketch size and estimation error. 2^14 registers keeps the
+// serialized form small (16KB) while giving ~0.8% standard error.
+const precision = 14
+
+const numRegisters = 1 << precision
+
+// Sketch estimates the number of distinct items added to it.
+type Sketch struct {
+	registers [numRegisters]uint8
+}
+
+// New creates an empty sketch.
+func New() *Sketch {
+	return &Sketch{}
+}
+
+// Add records an item in the sketch. Adding the same bytes again is a no-op
+// for the purposes of Estimate (modulo the sketch's inherent error rate).
+func (s *Sketch) Add(data []byte) {
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	hash := h.Sum64()
+
+	idx := hash & (numRegisters - 1)
+	rest := hash >> precision
+	rho := leadingZeros(rest) + 1
+
+	if rho > s.registers[idx] {
+		s.registers[idx] = rho
+	}
+}
+
+// leadingZeros returns the position (1-indexed) of the least significant set
+// bit in the 64-precision bits of v, capped at 64-precision+1.
+func leadingZeros(v uint64) uint8 {
+	maxBits := uint8(64 - precision)
+	for i := uint8(0); i < maxBits; i++ {
+		if v&(1<<i) != 0 {
+			return i + 1
+		}
+	}
+	return maxBits + 1
+}
+
+// Estimate returns the approximate number of distinct items added so far.
+func (s *Sketch) Estimate() uint64 {
+	sum := 0.0
+	zeros := 0
+	for _, r := range s.registers {
+		sum += 1.0 / math.Pow(2, float64(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	alpha := 0.7213 / (1 + 1.079/float64(numRegisters))
+	raw := alpha * float64(numRegisters) * float64(numRegisters) / sum
+
+	// Small-range correction via linear counting
+	if raw <= 2.5*float64(numRegisters) && zeros > 0 {
+		return uint64(float64(numRegisters) * math.Log(float64(numRegisters)/float64(zeros)))
+	}
+
+	return uint64(raw)
+}
+
+// Marshal serializes the sketch to a compact byte slice for storage as a
+// SQLite BLOB.
+func (s *Sketch) Marshal() []byte {
+	buf := make([]byte, numRegisters)
+	copy(buf, s.registers[:])
+	return buf
+}
+
+// Unmarshal restores a sketch previously produced by Marshal. An empty or
+// nil input yields an empty sketch (matching the zero value of a never-seen
+// class total).
+func Unmarshal(data []byte) (*Sketch, error) {
+	s := &Sketch{}
+	if len(data) == 0 {
+		return s, nil
+	}
+	if len(data) != numRegisters {
+		return nil, errors.New("hll: unexpected sketch size")
+	}
+	copy(s.registers[:], data)
+	return s, nil
+}
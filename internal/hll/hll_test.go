@@ -0,0 +1,64 @@
+package hll
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSketch_EmptyEstimatesZero(t *testing.T) {
+	s := New()
+	if got := s.Estimate(); got != 0 {
+		t.Errorf("expected empty sketch to estimate 0, got %d", got)
+	}
+}
+
+func TestSketch_MarshalRoundTrip(t *testing.T) {
+	s := New()
+	for i := 0; i < 1000; i++ {
+		s.Add([]byte(fmt.Sprintf("item-%d", i)))
+	}
+
+	data := s.Marshal()
+	restored, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if restored.Estimate() != s.Estimate() {
+		t.Errorf("expected restored estimate %d, got %d", s.Estimate(), restored.Estimate())
+	}
+}
+
+func TestSketch_ApproximateCardinality(t *testing.T) {
+	s := New()
+	const n = 10000
+	for i := 0; i < n; i++ {
+		s.Add([]byte(fmt.Sprintf("unique-%d", i)))
+	}
+
+	got := s.Estimate()
+	// HyperLogLog at this precision should be within ~5% for n this size
+	lower, upper := uint64(n)*95/100, uint64(n)*105/100
+	if got < lower || got > upper {
+		t.Errorf("Estimate() = %d, want within [%d, %d]", got, lower, upper)
+	}
+}
+
+func TestSketch_RepeatedAddIsIdempotent(t *testing.T) {
+	s := New()
+	s.Add([]byte("same-message-id"))
+	first := s.Estimate()
+	s.Add([]byte("same-message-id"))
+	second := s.Estimate()
+
+	if first != second {
+		t.Errorf("expected estimate to stay stable on repeated Add, got %d then %d", first, second)
+	}
+}
+
+func TestUnmarshal_WrongSize(t *testing.T) {
+	_, err := Unmarshal([]byte{1, 2, 3})
+	if err == nil {
+		t.Error("expected error for malformed sketch data")
+	}
+}
@@ -0,0 +1,57 @@
+package inbound
+
+import "sync"
+
+// Event announces that a conversation changed as a result of processing
+// incoming mail, for anything that wants to react in near-real-time (the
+// MCP server's notifications/conversations_changed SSE stream, today).
+type Event struct {
+	ConversationID string
+	Reason         string // e.g. "incoming_mail"
+}
+
+// Bus is a small fan-out pub/sub for Events. A subscriber that falls behind
+// has events dropped rather than blocking the publisher, the same
+// trade-off mcp.Server already makes for its own SSE sessions.
+type Bus struct {
+	mu   sync.Mutex
+	subs map[int]chan Event
+	next int
+}
+
+// NewBus creates an empty event bus.
+func NewBus() *Bus {
+	return &Bus{subs: make(map[int]chan Event)}
+}
+
+// Subscribe returns a channel of future events and an unsubscribe func the
+// caller must invoke when it's done listening.
+func (b *Bus) Subscribe() (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.next
+	b.next++
+	ch := make(chan Event, 16)
+	b.subs[id] = ch
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs, id)
+	}
+}
+
+// Publish announces event to every current subscriber.
+func (b *Bus) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, ch := range b.subs {
+		select {
+		case ch <- event:
+		default:
+			// Subscriber fell behind; drop rather than block the publisher.
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package inbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/gmail"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+)
+
+// GmailHandler implements Handler for a single Gmail account: it fetches
+// only the messages added since the last processed historyId and feeds
+// them through the same tracker pipeline a CLI sync does, then publishes a
+// Bus event for every conversation that gained an email.
+type GmailHandler struct {
+	address  string
+	db       *database.DB
+	provider *gmail.Provider
+	tracker  *tracker.Tracker
+	bus      *Bus
+}
+
+// NewGmailHandler creates a Handler for address, backed by an
+// authenticated gmail.Provider and the Tracker that should ingest its
+// messages. bus may be nil if nothing needs change notifications.
+func NewGmailHandler(address string, db *database.DB, provider *gmail.Provider, t *tracker.Tracker, bus *Bus) *GmailHandler {
+	return &GmailHandler{address: address, db: db, provider: provider, tracker: t, bus: bus}
+}
+
+// Address implements Handler.
+func (h *GmailHandler) Address() string { return h.address }
+
+// HandlePush implements Handler, syncing from the pushed historyId.
+func (h *GmailHandler) HandlePush(ctx context.Context, cursor string) error {
+	return h.sync(ctx, cursor)
+}
+
+// Poll implements Handler, syncing from the stored watermark.
+func (h *GmailHandler) Poll(ctx context.Context) error {
+	return h.sync(ctx, "")
+}
+
+// sync fetches and processes messages added since the stored watermark (or
+// since pushCursor, if a push notification carried a newer one), then
+// advances the watermark to whatever historyId Gmail reports as current.
+func (h *GmailHandler) sync(ctx context.Context, pushCursor string) error {
+	watermark, err := h.db.GetMailWatermark(ctx, h.address)
+	if err != nil {
+		return fmt.Errorf("failed to load watermark: %w", err)
+	}
+
+	startCursor := pushCursor
+	if startCursor == "" && watermark != nil {
+		startCursor = watermark.Cursor
+	}
+
+	if startCursor == "" {
+		// First time seeing this address: seed the watermark from the
+		// account's current historyId instead of re-processing everything
+		// a prior "jobsearch sync" has already imported.
+		current, err := h.provider.CurrentHistoryID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to seed watermark: %w", err)
+		}
+		return h.db.SetMailWatermark(ctx, h.address, current)
+	}
+
+	records, newCursor, err := h.provider.ListHistory(ctx, startCursor)
+	if err != nil {
+		if gmail.IsHistoryExpired(err) {
+			// Gmail purged this historyId before we caught up - reseed from
+			// the current one rather than erroring forever. Whatever
+			// arrived in the gap won't be replayed here, but the next
+			// "jobsearch sync" bounded-query pass will still pick it up.
+			current, seedErr := h.provider.CurrentHistoryID(ctx)
+			if seedErr != nil {
+				return fmt.Errorf("failed to reseed watermark after expired history: %w", seedErr)
+			}
+			return h.db.SetMailWatermark(ctx, h.address, current)
+		}
+		return err
+	}
+
+	ids := make([]string, len(records))
+	for i, rec := range records {
+		ids[i] = rec.MessageID
+	}
+
+	changed, err := h.tracker.ProcessIncomingMessages(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	if err := h.db.SetMailWatermark(ctx, h.address, newCursor); err != nil {
+		return fmt.Errorf("failed to advance watermark: %w", err)
+	}
+
+	if h.bus != nil {
+		for _, convID := range changed {
+			h.bus.Publish(Event{ConversationID: convID, Reason: "incoming_mail"})
+		}
+	}
+
+	return nil
+}
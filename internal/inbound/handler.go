@@ -0,0 +1,59 @@
+// Package inbound runs the server-side entry points for near-real-time
+// sync: a push webhook and a fallback poll loop, both dispatching through a
+// Registry of per-address Handlers so neither needs to know which mail
+// backend delivered the event.
+package inbound
+
+import "context"
+
+// Handler processes incoming-mail events for one canonical mail address.
+// Implementations exist per provider (see GmailHandler) so future
+// providers (IMAP IDLE, Fastmail JMAP push) can register against the same
+// webhook and poller without either needing to know which backend
+// delivered the event.
+type Handler interface {
+	// Address returns the canonical mail address this handler serves.
+	Address() string
+
+	// HandlePush processes a push notification carrying the provider's
+	// opaque cursor (Gmail's historyId, or an equivalent for another
+	// provider).
+	HandlePush(ctx context.Context, cursor string) error
+
+	// Poll is the fallback path: it re-checks for anything new since the
+	// last processed cursor, for when no push arrived (or none is
+	// configured at all).
+	Poll(ctx context.Context) error
+}
+
+// Registry maps canonical addresses to their Handler, modeled on
+// syzkaller's mailingLists registry of address -> handler.
+type Registry struct {
+	handlers map[string]Handler
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{handlers: make(map[string]Handler)}
+}
+
+// Register adds h, keyed by h.Address(). A second Register call for the
+// same address replaces the first.
+func (r *Registry) Register(h Handler) {
+	r.handlers[h.Address()] = h
+}
+
+// Lookup returns the Handler registered for address, if any.
+func (r *Registry) Lookup(address string) (Handler, bool) {
+	h, ok := r.handlers[address]
+	return h, ok
+}
+
+// All returns every registered Handler, for the poller to sweep.
+func (r *Registry) All() []Handler {
+	handlers := make([]Handler, 0, len(r.handlers))
+	for _, h := range r.handlers {
+		handlers = append(handlers, h)
+	}
+	return handlers
+}
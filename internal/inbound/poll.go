@@ -0,0 +1,41 @@
+package inbound
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Poller runs Handler.Poll on every registered address on a fixed
+// interval, as a fallback for addresses with no push subscription, or
+// whose push notifications stopped arriving (Gmail watches expire after 7
+// days and must be renewed, which is outside this package's scope).
+type Poller struct {
+	reg      *Registry
+	interval time.Duration
+}
+
+// NewPoller creates a Poller that sweeps reg's handlers once per interval.
+func NewPoller(reg *Registry, interval time.Duration) *Poller {
+	return &Poller{reg: reg, interval: interval}
+}
+
+// Run polls every registered address once per interval until ctx is
+// canceled.
+func (p *Poller) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, h := range p.reg.All() {
+				if err := h.Poll(ctx); err != nil {
+					log.Printf("inbound: poll failed for %s: %v", h.Address(), err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package inbound
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// pushEnvelope is Google Cloud Pub/Sub's push delivery envelope.
+type pushEnvelope struct {
+	Message struct {
+		Data string `json:"data"`
+	} `json:"message"`
+}
+
+// pushPayload is the base64-decoded JSON Gmail's watch API publishes to
+// Pub/Sub on every mailbox change.
+type pushPayload struct {
+	EmailAddress string      `json:"emailAddress"`
+	HistoryID    json.Number `json:"historyId"`
+}
+
+// WebhookHandler returns an http.HandlerFunc for Gmail's Pub/Sub push
+// subscription. token must match the bearer token the subscription is
+// configured to send ("Enable authentication" in the Pub/Sub console); an
+// empty token disables the check, which is only appropriate behind a
+// private network. A push for an address nobody registered in reg is
+// acknowledged but otherwise ignored, since Pub/Sub retries undelivered
+// pushes and an unregistered address will never resolve.
+func WebhookHandler(reg *Registry, token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if token != "" && r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		var env pushEnvelope
+		if err := json.Unmarshal(body, &env); err != nil {
+			http.Error(w, "malformed push envelope", http.StatusBadRequest)
+			return
+		}
+
+		data, err := base64.StdEncoding.DecodeString(env.Message.Data)
+		if err != nil {
+			http.Error(w, "malformed push data", http.StatusBadRequest)
+			return
+		}
+
+		var payload pushPayload
+		if err := json.Unmarshal(data, &payload); err != nil {
+			http.Error(w, "malformed push payload", http.StatusBadRequest)
+			return
+		}
+
+		handler, ok := reg.Lookup(strings.ToLower(payload.EmailAddress))
+		if !ok {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		if err := handler.HandlePush(r.Context(), payload.HistoryID.String()); err != nil {
+			log.Printf("inbound: push handling failed for %s: %v", payload.EmailAddress, err)
+			http.Error(w, fmt.Sprintf("handler failed: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
@@ -0,0 +1,273 @@
+// Package jobs implements a persistent background job queue, backed by the
+// jobs table, for operations too long-running to block a CLI invocation or
+// an MCP tool call: sync, LLM reclassification, and backup/restore. A Pool
+// per job Type polls the queue with configurable concurrency, retrying
+// failures with exponential backoff and letting in-flight jobs finish on
+// shutdown rather than killing them mid-run.
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// Type identifies what a job does; each Type is handled by its own
+// registered Handler and given its own worker pool.
+type Type string
+
+const (
+	TypeSync          Type = "sync"
+	TypeRescanMessage Type = "rescan_message"
+	TypeRescanAll     Type = "rescan_all"
+	TypeBackup        Type = "backup"
+)
+
+// Priority orders jobs of the same Type within the queue - lower values
+// are claimed first.
+type Priority int
+
+const (
+	PriorityUserSync      Priority = 1
+	PriorityRescanMessage Priority = 2
+	PriorityRescanAll     Priority = 3
+	PriorityBackup        Priority = 4
+)
+
+// Status is a job's lifecycle state.
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+// Job is a row in the jobs table.
+type Job struct {
+	ID          string
+	Type        Type
+	Priority    Priority
+	Payload     json.RawMessage
+	ScheduledAt time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	Status      Status
+	Attempts    int
+	LastError   string
+	CreatedAt   time.Time
+}
+
+// Queue wraps the database with job CRUD and the atomic claim operation
+// Pool uses to hand out work without two workers grabbing the same row.
+type Queue struct {
+	db *database.DB
+}
+
+// NewQueue wraps db as a job Queue.
+func NewQueue(db *database.DB) *Queue {
+	return &Queue{db: db}
+}
+
+// CreateJob inserts a new queued job, scheduled to run at scheduledAt (use
+// time.Now() to run as soon as a worker is free).
+func (q *Queue) CreateJob(ctx context.Context, jobType Type, priority Priority, scheduledAt time.Time, payload interface{}) (*Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	job := &Job{
+		ID:          uuid.New().String(),
+		Type:        jobType,
+		Priority:    priority,
+		Payload:     data,
+		ScheduledAt: scheduledAt,
+		Status:      StatusQueued,
+		CreatedAt:   time.Now(),
+	}
+
+	_, err = q.db.ExecContext(ctx, `
+		INSERT INTO jobs (id, type, priority, payload, scheduled_at, status, attempts, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, 0, ?)
+	`, job.ID, string(job.Type), int(job.Priority), string(job.Payload), job.ScheduledAt, string(job.Status), job.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	return job, nil
+}
+
+// GetJob retrieves a single job by ID, or nil if it doesn't exist.
+func (q *Queue) GetJob(ctx context.Context, id string) (*Job, error) {
+	row := q.db.QueryRowContext(ctx, jobSelectColumns+" FROM jobs WHERE id = ?", id)
+	job, err := scanJob(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return job, err
+}
+
+// ListJobs returns jobs, optionally filtered by type and/or status, most
+// recently created first.
+func (q *Queue) ListJobs(ctx context.Context, jobType *Type, status *Status, limit int) ([]Job, error) {
+	query := jobSelectColumns + " FROM jobs WHERE 1=1"
+	var args []interface{}
+
+	if jobType != nil {
+		query += " AND type = ?"
+		args = append(args, string(*jobType))
+	}
+	if status != nil {
+		query += " AND status = ?"
+		args = append(args, string(*status))
+	}
+	query += " ORDER BY created_at DESC"
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *job)
+	}
+	return result, rows.Err()
+}
+
+// CancelJob marks a queued job cancelled so no worker picks it up. It has
+// no effect on a job that's already running, succeeded, or failed.
+func (q *Queue) CancelJob(ctx context.Context, id string) error {
+	result, err := q.db.ExecContext(ctx, `
+		UPDATE jobs SET status = ?, finished_at = ? WHERE id = ? AND status = ?
+	`, string(StatusCancelled), time.Now(), id, string(StatusQueued))
+	if err != nil {
+		return fmt.Errorf("failed to cancel job: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("job %s is not queued (already running or finished)", id)
+	}
+	return nil
+}
+
+// claimNext atomically claims the highest-priority, earliest-scheduled
+// queued job of the given type, marking it running. It returns nil, nil if
+// there's nothing to claim.
+func (q *Queue) claimNext(ctx context.Context, jobType Type, now time.Time) (*Job, error) {
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	row := tx.QueryRowContext(ctx, jobSelectColumns+`
+		FROM jobs
+		WHERE type = ? AND status = ? AND scheduled_at <= ?
+		ORDER BY priority ASC, scheduled_at ASC
+		LIMIT 1
+	`, string(jobType), string(StatusQueued), now)
+	job, err := scanJob(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE jobs SET status = ?, started_at = ?, attempts = attempts + 1 WHERE id = ?
+	`, string(StatusRunning), now, job.ID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	job.Status = StatusRunning
+	job.StartedAt = &now
+	job.Attempts++
+	return job, nil
+}
+
+// markSucceeded/markFailed finish a running job. A failure that hasn't hit
+// maxAttempts is rescheduled (still Status queued) after an exponential
+// backoff instead of being marked Failed.
+func (q *Queue) markSucceeded(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, `
+		UPDATE jobs SET status = ?, finished_at = ? WHERE id = ?
+	`, string(StatusSucceeded), time.Now(), id)
+	return err
+}
+
+func (q *Queue) markFailed(ctx context.Context, job *Job, jobErr error, maxAttempts int, backoff func(attempt int) time.Duration) error {
+	now := time.Now()
+	if job.Attempts < maxAttempts {
+		retryAt := now.Add(backoff(job.Attempts))
+		_, err := q.db.ExecContext(ctx, `
+			UPDATE jobs SET status = ?, started_at = NULL, scheduled_at = ?, last_error = ? WHERE id = ?
+		`, string(StatusQueued), retryAt, jobErr.Error(), job.ID)
+		return err
+	}
+
+	_, err := q.db.ExecContext(ctx, `
+		UPDATE jobs SET status = ?, finished_at = ?, last_error = ? WHERE id = ?
+	`, string(StatusFailed), now, jobErr.Error(), job.ID)
+	return err
+}
+
+const jobSelectColumns = `SELECT id, type, priority, payload, scheduled_at, started_at, finished_at, status, attempts, last_error, created_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanJob(s scanner) (*Job, error) {
+	var job Job
+	var typ, status string
+	var priority int
+	var payload string
+	var startedAt, finishedAt sql.NullTime
+	var lastError sql.NullString
+
+	if err := s.Scan(&job.ID, &typ, &priority, &payload, &job.ScheduledAt,
+		&startedAt, &finishedAt, &status, &job.Attempts, &lastError, &job.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	job.Type = Type(typ)
+	job.Status = Status(status)
+	job.Priority = Priority(priority)
+	job.Payload = json.RawMessage(payload)
+	if startedAt.Valid {
+		job.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+	if lastError.Valid {
+		job.LastError = lastError.String
+	}
+
+	return &job, nil
+}
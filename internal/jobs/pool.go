@@ -0,0 +1,123 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Handler executes the work for one claimed job. The returned error (if
+// any) drives the queue's retry/backoff decision; it is not surfaced to
+// the caller directly.
+type Handler func(ctx context.Context, job *Job) error
+
+// defaultMaxAttempts bounds how many attempts in total (the first run plus
+// its retries) a job gets before a failure marks it Failed for good.
+const defaultMaxAttempts = 5
+
+// defaultBackoff grows 30s, 1m, 2m, 4m, ... capped at 30 minutes - enough
+// to ride out a transient provider/API outage without hammering it.
+func defaultBackoff(attempt int) time.Duration {
+	d := 30 * time.Second
+	for i := 0; i < attempt; i++ {
+		d *= 2
+		if d > 30*time.Minute {
+			return 30 * time.Minute
+		}
+	}
+	return d
+}
+
+// Pool polls the queue for one job Type and runs claimed jobs through
+// Handler with up to Concurrency running at once. Create one Pool per
+// Type so each gets its own concurrency limit.
+type Pool struct {
+	queue        *Queue
+	jobType      Type
+	handler      Handler
+	concurrency  int
+	pollInterval time.Duration
+	maxAttempts  int
+	backoff      func(attempt int) time.Duration
+
+	wg sync.WaitGroup
+}
+
+// NewPool creates a worker pool for jobType. concurrency <= 0 is treated
+// as 1.
+func NewPool(queue *Queue, jobType Type, concurrency int, handler Handler) *Pool {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &Pool{
+		queue:        queue,
+		jobType:      jobType,
+		handler:      handler,
+		concurrency:  concurrency,
+		pollInterval: 2 * time.Second,
+		maxAttempts:  defaultMaxAttempts,
+		backoff:      defaultBackoff,
+	}
+}
+
+// Run starts concurrency worker goroutines that poll the queue until ctx
+// is cancelled. Run blocks until every in-flight job finishes, so a caller
+// can cancel ctx and then wait on Run's return to shut down cleanly.
+func (p *Pool) Run(ctx context.Context) {
+	for i := 0; i < p.concurrency; i++ {
+		p.wg.Add(1)
+		go p.worker(ctx)
+	}
+	p.wg.Wait()
+}
+
+func (p *Pool) worker(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.runOnce(ctx)
+		}
+	}
+}
+
+// runOnce claims and executes at most one job; it's separated from worker
+// so tests or a one-shot CLI caller can drive the loop manually instead of
+// waiting for the poll ticker.
+func (p *Pool) runOnce(ctx context.Context) {
+	job, err := p.queue.claimNext(ctx, p.jobType, time.Now())
+	if err != nil || job == nil {
+		return
+	}
+
+	if err := p.handler(ctx, job); err != nil {
+		_ = p.queue.markFailed(ctx, job, err, p.maxAttempts, p.backoff)
+		return
+	}
+	_ = p.queue.markSucceeded(ctx, job.ID)
+}
+
+// RunOne claims and runs a single job of this pool's type if one is ready,
+// returning whether a job was found. It's used by the CLI to execute a
+// job it just enqueued inline, in the same process, rather than spinning
+// up a persistent poller - the CLI is a one-shot process, so only the
+// long-running MCP server actually runs Pool.Run in the background.
+func (p *Pool) RunOne(ctx context.Context) bool {
+	job, err := p.queue.claimNext(ctx, p.jobType, time.Now())
+	if err != nil || job == nil {
+		return false
+	}
+
+	if err := p.handler(ctx, job); err != nil {
+		_ = p.queue.markFailed(ctx, job, err, p.maxAttempts, p.backoff)
+		return true
+	}
+	_ = p.queue.markSucceeded(ctx, job.ID)
+	return true
+}
@@ -2,29 +2,207 @@ package mcp
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/backup"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/filter"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/jobs"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/search"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/templates"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
 )
 
 func (s *Server) registerHandlers() {
 	s.handlers["list_conversations"] = s.handleListConversations
 	s.handlers["get_conversation"] = s.handleGetConversation
 	s.handlers["get_pending_actions"] = s.handleGetPendingActions
+	s.handlers["upcoming_interviews"] = s.handleUpcomingInterviews
 	s.handlers["search_conversations"] = s.handleSearchConversations
+	s.handlers["search_emails"] = s.handleSearchEmails
 	s.handlers["get_stats"] = s.handleGetStats
+	s.handlers["get_analytics"] = s.handleGetAnalytics
+	s.handlers["query"] = s.handleQuery
+	s.handlers["draft_reply"] = s.handleDraftReply
 	s.handlers["merge_conversations"] = s.handleMergeConversations
 	s.handlers["archive_conversation"] = s.handleArchiveConversation
+	s.handlers["mute_notifications"] = s.handleMuteNotifications
+	s.handlers["backup_export"] = s.handleBackupExport
+	s.handlers["backup_import"] = s.handleBackupImport
+	s.handlers["enqueue_job"] = s.handleEnqueueJob
+	s.handlers["list_jobs"] = s.handleListJobs
+	s.handlers["cancel_job"] = s.handleCancelJob
+	s.handlers["learn_keywords"] = s.handleLearnKeywords
+	s.handlers["reindex_search"] = s.handleReindexSearch
 }
 
+// runJobPools runs this server's persistent background job workers until
+// ctx is cancelled. It's started as a goroutine from Start/StartHTTP,
+// since those are the only long-running processes in this codebase - the
+// CLI enqueues a job and runs it inline itself (see internal/cli/sync.go)
+// rather than relying on a pool that only exists while an MCP server is up.
+//
+// Only backup currently has a handler here: sync and rescan jobs need a
+// provider that's already authenticated (Gmail's OAuth flow is interactive)
+// and a classifier client, neither of which the server has on hand, so
+// those jobs sit queued until something that does have them (the CLI)
+// claims and runs them instead.
+func (s *Server) runJobPools(ctx context.Context) {
+	backupPool := jobs.NewPool(s.jobQueue, jobs.TypeBackup, 1, s.runBackupJob)
+	backupPool.Run(ctx)
+}
+
+func (s *Server) runBackupJob(ctx context.Context, job *jobs.Job) error {
+	var payload struct {
+		Path string `json:"path"`
+	}
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid backup job payload: %w", err)
+	}
+	if payload.Path == "" {
+		return fmt.Errorf("backup job requires a path")
+	}
+
+	f, err := os.Create(payload.Path)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	_, err = backup.Export(ctx, s.db, s.config, s.config.Database.Path, f)
+	closeErr := f.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+type enqueueJobParams struct {
+	Type     string          `json:"type"`
+	Priority int             `json:"priority"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+func (s *Server) handleEnqueueJob(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p enqueueJobParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+
+	if p.Type == "" {
+		return nil, fmt.Errorf("type is required")
+	}
+	jobType := jobs.Type(p.Type)
+	priority := jobs.Priority(p.Priority)
+	if priority == 0 {
+		priority = defaultPriorityFor(jobType)
+	}
+
+	var payload interface{} = json.RawMessage(p.Payload)
+	if p.Payload == nil {
+		payload = struct{}{}
+	}
+
+	job, err := s.jobQueue.CreateJob(ctx, jobType, priority, time.Now(), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	return job, nil
+}
+
+// defaultPriorityFor is used when enqueue_job doesn't specify a priority,
+// so ad-hoc MCP-enqueued jobs sort the same as the equivalent built-in
+// caller (e.g. internal/cli/sync.go) would.
+func defaultPriorityFor(jobType jobs.Type) jobs.Priority {
+	switch jobType {
+	case jobs.TypeSync:
+		return jobs.PriorityUserSync
+	case jobs.TypeRescanMessage:
+		return jobs.PriorityRescanMessage
+	case jobs.TypeRescanAll:
+		return jobs.PriorityRescanAll
+	case jobs.TypeBackup:
+		return jobs.PriorityBackup
+	default:
+		return jobs.PriorityRescanAll
+	}
+}
+
+type listJobsParams struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Limit  int    `json:"limit"`
+}
+
+func (s *Server) handleListJobs(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p listJobsParams
+	if params != nil {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, fmt.Errorf("invalid parameters: %w", err)
+		}
+	}
+
+	var jobType *jobs.Type
+	if p.Type != "" {
+		t := jobs.Type(p.Type)
+		jobType = &t
+	}
+	var status *jobs.Status
+	if p.Status != "" {
+		st := jobs.Status(p.Status)
+		status = &st
+	}
+	limit := p.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	list, err := s.jobQueue.ListJobs(ctx, jobType, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return list, nil
+}
+
+type cancelJobParams struct {
+	ID string `json:"id"`
+}
+
+func (s *Server) handleCancelJob(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p cancelJobParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+	if p.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	if err := s.jobQueue.CancelJob(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"cancelled": true, "id": p.ID}, nil
+}
+
+// maxInlineBackupBytes bounds how large an archive backup_export will
+// return inline as base64 when the caller doesn't give a path; bigger
+// archives must be exported to a path instead.
+const maxInlineBackupBytes = 5 * 1024 * 1024
+
 type listConversationsParams struct {
 	Status          string `json:"status"`
 	Company         string `json:"company"`
 	SinceDays       int    `json:"since_days"`
 	Limit           int    `json:"limit"`
 	IncludeArchived bool   `json:"include_archived"`
+	// Criteria, if set, is used in place of the flat fields above, so callers
+	// get the full SearchCriteria feature set (StatusIn, confidence/layer
+	// filters, All/Any/Not nesting) from list_conversations too.
+	Criteria *database.SearchCriteria `json:"criteria"`
 }
 
 func (s *Server) handleListConversations(ctx context.Context, params json.RawMessage) (interface{}, error) {
@@ -35,6 +213,18 @@ func (s *Server) handleListConversations(ctx context.Context, params json.RawMes
 		}
 	}
 
+	if p.Criteria != nil {
+		crit := *p.Criteria
+		if crit.Limit == 0 {
+			crit.Limit = 20
+		}
+		convs, err := s.db.Query(ctx, crit)
+		if err != nil {
+			return nil, fmt.Errorf("database error: %w", err)
+		}
+		return convs, nil
+	}
+
 	opts := database.ListOptions{
 		IncludeArchived: p.IncludeArchived,
 	}
@@ -174,8 +364,26 @@ func (s *Server) handleGetPendingActions(ctx context.Context, params json.RawMes
 	return result, nil
 }
 
+// handleUpcomingInterviews returns every not-yet-cancelled interview
+// starting from now on, the same feed "jobsearch interviews upcoming"
+// prints, for an assistant that wants to remind the user what's coming up.
+func (s *Server) handleUpcomingInterviews(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	interviews, err := s.db.ListUpcomingInterviews(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return interviews, nil
+}
+
 type searchParams struct {
+	// Query is the legacy free-text search, parsed into a SearchCriteria via
+	// database.ParseQuery (e.g. "company:acme after:2024-01-01 waiting").
+	// Ignored if Criteria is set.
 	Query string `json:"query"`
+	// Criteria is a structured SearchCriteria, for callers that want full
+	// control (status filters, confidence ranges, All/Any/Not nesting)
+	// instead of the mini-language.
+	Criteria *database.SearchCriteria `json:"criteria"`
 }
 
 func (s *Server) handleSearchConversations(ctx context.Context, params json.RawMessage) (interface{}, error) {
@@ -184,11 +392,17 @@ func (s *Server) handleSearchConversations(ctx context.Context, params json.RawM
 		return nil, fmt.Errorf("invalid parameters: %w", err)
 	}
 
-	if p.Query == "" {
-		return nil, fmt.Errorf("query is required")
+	var crit database.SearchCriteria
+	switch {
+	case p.Criteria != nil:
+		crit = *p.Criteria
+	case p.Query != "":
+		crit = database.ParseQuery(p.Query)
+	default:
+		return nil, fmt.Errorf("either query or criteria is required")
 	}
 
-	results, err := s.db.Search(ctx, p.Query)
+	results, err := s.db.Query(ctx, crit)
 	if err != nil {
 		return nil, fmt.Errorf("search error: %w", err)
 	}
@@ -196,6 +410,125 @@ func (s *Server) handleSearchConversations(ctx context.Context, params json.RawM
 	return results, nil
 }
 
+type emailSearchParams struct {
+	Criteria database.EmailSearchCriteria `json:"criteria"`
+}
+
+// handleSearchEmails is the message-level counterpart to
+// handleSearchConversations: it searches the emails table directly (via
+// database.EmailSearchCriteria) instead of the conversations table, for
+// finding a specific message inside a thread rather than the thread as a
+// whole.
+func (s *Server) handleSearchEmails(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p emailSearchParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+
+	results, err := s.db.SearchEmails(ctx, p.Criteria)
+	if err != nil {
+		return nil, fmt.Errorf("search error: %w", err)
+	}
+
+	return results, nil
+}
+
+type queryParams struct {
+	Criteria search.Criteria `json:"criteria"`
+	// Target selects which table the criteria runs against: "conversations"
+	// (default) or "emails". Both share the same Criteria so a caller can
+	// express "waiting_on_them at fintech companies with confidence > 0.7
+	// in the last 14 days" once and run it either way.
+	Target string `json:"target"`
+}
+
+// handleQuery is the search.Criteria counterpart to
+// handleSearchConversations/handleSearchEmails: instead of the mini-language
+// or a single-table SearchCriteria/EmailSearchCriteria, it accepts the
+// unified Criteria struct that also drives filter.Match, for callers that
+// want to compose one non-trivial, multi-valued query and aren't picky
+// about which of the two existing search tools would otherwise parse it.
+func (s *Server) handleQuery(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p queryParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+
+	switch p.Target {
+	case "", "conversations":
+		results, err := s.db.SearchConversations(ctx, p.Criteria)
+		if err != nil {
+			return nil, fmt.Errorf("query error: %w", err)
+		}
+		return results, nil
+	case "emails":
+		results, err := s.db.QueryEmails(ctx, p.Criteria)
+		if err != nil {
+			return nil, fmt.Errorf("query error: %w", err)
+		}
+		return results, nil
+	default:
+		return nil, fmt.Errorf("unknown target %q: expected \"conversations\" or \"emails\"", p.Target)
+	}
+}
+
+type draftReplyParams struct {
+	Identifier string `json:"identifier"` // company name or conversation ID, same lookup as get_conversation
+	Template   string `json:"template"`   // "follow_up", "nudge", "decline", or "thank_you"
+	UserName   string `json:"user_name"`  // fills {user_name}; left blank if omitted
+}
+
+// handleDraftReply resolves identifier the same way handleGetConversation
+// does, then renders template against the conversation and its latest
+// email via templates.Render - the MCP counterpart to "jobsearch draft",
+// for a caller that wants the rendered subject+body without a Gmail send.
+func (s *Server) handleDraftReply(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p draftReplyParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+	if p.Identifier == "" {
+		return nil, fmt.Errorf("identifier is required")
+	}
+	if p.Template == "" {
+		return nil, fmt.Errorf("template is required")
+	}
+
+	conv, err := s.db.GetConversationByCompany(ctx, p.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if conv == nil {
+		conv, err = s.db.GetConversation(ctx, p.Identifier)
+		if err != nil {
+			return nil, fmt.Errorf("database error: %w", err)
+		}
+	}
+	if conv == nil {
+		return nil, fmt.Errorf("conversation not found: %s", p.Identifier)
+	}
+
+	emails, err := s.db.ListEmailsForConversation(ctx, conv.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get emails: %w", err)
+	}
+	var latest *database.Email
+	if len(emails) > 0 {
+		latest = &emails[len(emails)-1]
+	}
+
+	if err := templates.EnsureDefaults(s.config.Templates); err != nil {
+		return nil, fmt.Errorf("failed to materialize default templates: %w", err)
+	}
+
+	rendered, err := templates.Render(templates.Name(p.Template), s.config.Templates, conv, latest, p.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render template: %w", err)
+	}
+
+	return rendered, nil
+}
+
 type getStatsParamsExtended struct {
 	SinceDays int  `json:"since_days"`
 	Detailed  bool `json:"detailed"`
@@ -252,6 +585,34 @@ func (s *Server) handleGetStats(ctx context.Context, params json.RawMessage) (in
 	}, nil
 }
 
+type getAnalyticsParams struct {
+	SinceDays    int `json:"since_days"`
+	TopCompanies int `json:"top_companies"`
+}
+
+func (s *Server) handleGetAnalytics(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p getAnalyticsParams
+	if params != nil {
+		_ = json.Unmarshal(params, &p) // Ignore error, use defaults
+	}
+
+	var since *time.Time
+	if p.SinceDays > 0 {
+		t := time.Now().AddDate(0, 0, -p.SinceDays)
+		since = &t
+	}
+
+	analytics, err := s.db.GetAnalytics(ctx, database.AnalyticsOptions{
+		Since:        since,
+		TopCompanies: p.TopCompanies,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return analytics, nil
+}
+
 type mergeConversationsParams struct {
 	Target string `json:"target"`
 	Source string `json:"source"`
@@ -347,6 +708,48 @@ func (s *Server) handleArchiveConversation(ctx context.Context, params json.RawM
 	}, nil
 }
 
+type muteNotificationsParams struct {
+	ConversationID string `json:"conversation_id"`
+	Until          string `json:"until"`
+}
+
+func (s *Server) handleMuteNotifications(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p muteNotificationsParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+	if p.ConversationID == "" {
+		return nil, fmt.Errorf("conversation_id is required")
+	}
+	if p.Until == "" {
+		return nil, fmt.Errorf("until is required")
+	}
+
+	until, err := time.Parse(time.RFC3339, p.Until)
+	if err != nil {
+		return nil, fmt.Errorf("until must be an RFC3339 timestamp: %w", err)
+	}
+
+	conv, err := s.findConversation(ctx, p.ConversationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find conversation: %w", err)
+	}
+	if conv == nil {
+		return nil, fmt.Errorf("conversation not found: %s", p.ConversationID)
+	}
+
+	if err := s.db.MuteNotifications(ctx, conv.ID, until); err != nil {
+		return nil, fmt.Errorf("failed to mute notifications: %w", err)
+	}
+
+	return map[string]interface{}{
+		"success":         true,
+		"conversation_id": conv.ID,
+		"company":         conv.Company,
+		"muted_until":     until.Format(time.RFC3339),
+	}, nil
+}
+
 // findConversation finds a conversation by company name or ID
 func (s *Server) findConversation(ctx context.Context, identifier string) (*database.Conversation, error) {
 	// Try by company first
@@ -378,7 +781,14 @@ func (s *Server) handleReadResource(ctx context.Context, uri string) (string, er
 		return s.getResourceRecent(ctx)
 	case "jobsearch://companies":
 		return s.getResourceCompanies(ctx)
+	case "jobsearch://saved-searches":
+		return s.getResourceSavedSearches(ctx)
+	case "jobsearch://jobs":
+		return s.getResourceJobs(ctx)
 	default:
+		if strings.HasPrefix(uri, "jobsearch://search?") {
+			return s.getResourceSearch(ctx, uri)
+		}
 		return "", fmt.Errorf("unknown resource: %s", uri)
 	}
 }
@@ -527,3 +937,261 @@ func (s *Server) getResourceCompanies(ctx context.Context) (string, error) {
 
 	return result, nil
 }
+
+func (s *Server) getResourceSavedSearches(ctx context.Context) (string, error) {
+	searches, err := s.db.ListSavedSearches(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if len(searches) == 0 {
+		return "No saved searches yet. Save one with 'jobsearch search --save <name> <query>'.\n", nil
+	}
+
+	result := "Saved Searches\n==============\n\n"
+	for _, sv := range searches {
+		criteriaJSON, err := json.MarshalIndent(sv.Criteria, "  ", "  ")
+		if err != nil {
+			return "", err
+		}
+		result += fmt.Sprintf("%s (saved %s):\n  %s\n\n", sv.Name, sv.CreatedAt.Format("2006-01-02"), criteriaJSON)
+	}
+
+	return result, nil
+}
+
+// getResourceSearch runs the "q" query parameter of a jobsearch://search?q=...
+// URI through database.ParseQuery, the same field:value mini-language
+// 'jobsearch search' and search_conversations use, so an assistant can
+// subscribe to or re-read a live search as a resource instead of calling a
+// tool each time.
+func (s *Server) getResourceSearch(ctx context.Context, uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid search resource URI: %w", err)
+	}
+	q := parsed.Query().Get("q")
+	if q == "" {
+		return "", fmt.Errorf("jobsearch://search requires a non-empty q parameter")
+	}
+
+	convs, err := s.db.Query(ctx, database.ParseQuery(q))
+	if err != nil {
+		return "", err
+	}
+
+	if len(convs) == 0 {
+		return fmt.Sprintf("No conversations found matching: %s\n", q), nil
+	}
+
+	result := fmt.Sprintf("Search: %s\n%s\n\n", q, strings.Repeat("=", len("Search: ")+len(q)))
+	for _, c := range convs {
+		recruiter := ""
+		if c.RecruiterName != nil {
+			recruiter = fmt.Sprintf(" (%s)", *c.RecruiterName)
+		}
+		result += fmt.Sprintf("  - %s%s - %s - %d day(s) ago\n", c.Company, recruiter, c.Status, int(time.Since(c.LastActivityAt).Hours()/24))
+	}
+	return result, nil
+}
+
+func (s *Server) getResourceJobs(ctx context.Context) (string, error) {
+	list, err := s.jobQueue.ListJobs(ctx, nil, nil, 20)
+	if err != nil {
+		return "", err
+	}
+
+	if len(list) == 0 {
+		return "No jobs yet. Enqueue one with the enqueue_job tool, or run 'jobsearch sync'.\n", nil
+	}
+
+	result := "Jobs (most recent 20)\n=====================\n\n"
+	for _, j := range list {
+		result += fmt.Sprintf("- %s | %s | priority=%d | %s | attempts=%d", j.ID, j.Type, j.Priority, j.Status, j.Attempts)
+		if j.LastError != "" {
+			result += fmt.Sprintf(" | last_error=%s", j.LastError)
+		}
+		result += "\n"
+	}
+
+	return result, nil
+}
+
+type backupExportParams struct {
+	// Path, if set, is the filesystem path to write the archive to. If
+	// omitted, the archive is written to a temp file and returned inline as
+	// base64 when it's small enough (see maxInlineBackupBytes); larger
+	// archives must be exported to a path instead.
+	Path string `json:"path"`
+}
+
+type backupExportResult struct {
+	Path     string           `json:"path,omitempty"`
+	Data     string           `json:"data,omitempty"` // base64, only set when path was omitted
+	Manifest *backup.Manifest `json:"manifest"`
+}
+
+func (s *Server) handleBackupExport(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p backupExportParams
+	if params != nil {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, fmt.Errorf("invalid parameters: %w", err)
+		}
+	}
+
+	outputPath := p.Path
+	if outputPath == "" {
+		tmp, err := os.CreateTemp("", "jobsearch-backup-*.tar.gz")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create temp file: %w", err)
+		}
+		tmp.Close()
+		outputPath = tmp.Name()
+		defer os.Remove(outputPath)
+	}
+
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive: %w", err)
+	}
+
+	manifest, err := backup.Export(ctx, s.db, s.config, s.config.Database.Path, f)
+	closeErr := f.Close()
+	if err != nil {
+		return nil, fmt.Errorf("export failed: %w", err)
+	}
+	if closeErr != nil {
+		return nil, fmt.Errorf("failed to finalize archive: %w", closeErr)
+	}
+
+	result := backupExportResult{Manifest: manifest}
+	if p.Path != "" {
+		result.Path = p.Path
+		return result, nil
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	if len(data) > maxInlineBackupBytes {
+		return nil, fmt.Errorf("archive is %d bytes, too large to inline (limit %d); pass a path instead", len(data), maxInlineBackupBytes)
+	}
+	result.Data = base64.StdEncoding.EncodeToString(data)
+	return result, nil
+}
+
+type backupImportParams struct {
+	// Path is the filesystem path of the archive to import. Exactly one of
+	// Path/Data must be set.
+	Path string `json:"path"`
+	// Data is a base64-encoded archive, for callers that exported inline.
+	Data string `json:"data"`
+}
+
+// handleBackupImport atomically swaps in the imported database, as
+// described on backup.Import. Note this server's already-open database
+// connection keeps serving from the pre-import file until the process is
+// restarted - importing over MCP replaces the file on disk, not the
+// handle this server is holding.
+func (s *Server) handleBackupImport(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p backupImportParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+
+	archivePath := p.Path
+	if p.Data != "" {
+		data, err := base64.StdEncoding.DecodeString(p.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 data: %w", err)
+		}
+		tmp, err := os.CreateTemp("", "jobsearch-restore-*.tar.gz")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create temp file: %w", err)
+		}
+		defer os.Remove(tmp.Name())
+		if _, err := tmp.Write(data); err != nil {
+			tmp.Close()
+			return nil, fmt.Errorf("failed to write archive: %w", err)
+		}
+		if err := tmp.Close(); err != nil {
+			return nil, fmt.Errorf("failed to write archive: %w", err)
+		}
+		archivePath = tmp.Name()
+	}
+	if archivePath == "" {
+		return nil, fmt.Errorf("either path or data is required")
+	}
+
+	manifest, err := backup.Import(archivePath, s.config.Database.Path)
+	if err != nil {
+		return nil, fmt.Errorf("import failed: %w", err)
+	}
+
+	return struct {
+		Manifest *backup.Manifest `json:"manifest"`
+		Note     string           `json:"note"`
+	}{
+		Manifest: manifest,
+		Note:     "the running server is still serving the pre-import database; restart it to pick up the restored data",
+	}, nil
+}
+
+type learnKeywordsParams struct {
+	DryRun bool `json:"dry_run"`
+}
+
+// learnedFilterTypesForLoad lists every database.FilterType* that a
+// tracker.Learner should seed a *filter.Filter with before scoring new
+// suggestions against it, so learn_keywords doesn't re-suggest phrases
+// already confirmed. Mirrors internal/cli/sync.go's loadLearnedFilters,
+// which can't be called directly since it's unexported outside package cli.
+var learnedFilterTypesForLoad = []string{
+	database.FilterTypeDomainWhitelist,
+	database.FilterTypeDomainBlacklist,
+	database.FilterTypeSubjectBlacklist,
+	database.FilterTypeSubjectKeyword,
+	database.FilterTypeBodyKeyword,
+}
+
+func (s *Server) handleLearnKeywords(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var p learnKeywordsParams
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, fmt.Errorf("invalid parameters: %w", err)
+		}
+	}
+
+	f := filter.New(s.config.Filters)
+	for _, filterType := range learnedFilterTypesForLoad {
+		values, err := s.db.GetLearnedFiltersByType(ctx, filterType)
+		if err != nil || len(values) == 0 {
+			continue
+		}
+		f.AddLearnedFilters(filterType, values)
+	}
+
+	t := tracker.New(s.db, nil, f, nil, s.config)
+	subjectPhrases, bodyPhrases, err := t.LearnKeywords(ctx, p.DryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to learn keywords: %w", err)
+	}
+
+	return struct {
+		SubjectKeywords []string `json:"subject_keywords"`
+		BodyKeywords    []string `json:"body_keywords"`
+		DryRun          bool     `json:"dry_run"`
+	}{
+		SubjectKeywords: subjectPhrases,
+		BodyKeywords:    bodyPhrases,
+		DryRun:          p.DryRun,
+	}, nil
+}
+
+func (s *Server) handleReindexSearch(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	if err := s.db.RebuildFTS(ctx); err != nil {
+		return nil, fmt.Errorf("reindex failed: %w", err)
+	}
+	return map[string]interface{}{"rebuilt": true}, nil
+}
@@ -0,0 +1,155 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// sessionHeader is the header clients use to tell /rpc which /events
+// subscription should receive the response, and the header the server
+// echoes back on /events when a client doesn't supply one.
+const sessionHeader = "X-Session-Id"
+
+// Handler returns the /rpc and /events routes as an http.Handler, so a
+// caller that needs to share its port with other routes (e.g. "jobsearch
+// serve", which also exposes an inbound-mail webhook) can mount it
+// alongside them instead of calling StartHTTP directly. RunBackground must
+// still be called separately in that case.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rpc", s.handleRPC)
+	mux.HandleFunc("/events", s.handleEvents)
+	return mux
+}
+
+// RunBackground starts the job-pool workers and the bus-event broadcaster
+// StartHTTP normally starts on its own. Call it explicitly if you're
+// mounting Handler() on your own http.Server instead of calling StartHTTP.
+func (s *Server) RunBackground(ctx context.Context) {
+	go s.runJobPools(ctx)
+	go s.runBusBroadcasts(ctx)
+}
+
+// StartHTTP runs the MCP server as an HTTP+SSE daemon: POST /rpc accepts a
+// JSON-RPC request and returns 202 Accepted immediately, while the actual
+// response is delivered asynchronously to that request's session over a
+// GET /events SSE stream - this lets multiple clients share one daemon
+// without their tool calls and results getting mixed up.
+func (s *Server) StartHTTP(ctx context.Context, addr string) error {
+	s.RunBackground(ctx)
+
+	httpServer := &http.Server{
+		Addr:    addr,
+		Handler: s.Handler(),
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return httpServer.Shutdown(context.Background())
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+func (s *Server) handleRPC(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sessionID := r.Header.Get(sessionHeader)
+	if sessionID == "" {
+		http.Error(w, fmt.Sprintf("missing %s header; open /events first", sessionHeader), http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	s.sessionsMu.Lock()
+	ch, ok := s.sessions[sessionID]
+	s.sessionsMu.Unlock()
+	if !ok {
+		http.Error(w, "unknown session; open /events first", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	go func() {
+		response := s.handleMessage(ctx, string(body), sessionID)
+		if response == nil {
+			return
+		}
+		select {
+		case ch <- response:
+		default:
+			// Subscriber disconnected or fell behind; drop rather than block.
+		}
+	}()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	sessionID := r.URL.Query().Get("session")
+	if sessionID == "" {
+		sessionID = uuid.New().String()
+	}
+
+	ch := make(chan *jsonRPCResponse, 16)
+	s.sessionsMu.Lock()
+	s.sessions[sessionID] = ch
+	s.sessionsMu.Unlock()
+	defer func() {
+		s.sessionsMu.Lock()
+		delete(s.sessions, sessionID)
+		delete(s.subscriptions, sessionID)
+		s.sessionsMu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set(sessionHeader, sessionID)
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "event: session\ndata: {\"sessionId\":%q}\n\n", sessionID)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case response := <-ch:
+			data, err := json.Marshal(response)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: message\ndata: %s\n\n", data)
+			flusher.Flush()
+		}
+	}
+}
@@ -34,6 +34,24 @@ var ResourceDefinitions = []Resource{
 		Description: "All companies you're in conversation with",
 		MimeType:    "text/plain",
 	},
+	{
+		URI:         "jobsearch://saved-searches",
+		Name:        "Saved Searches",
+		Description: "Named SearchCriteria saved via 'jobsearch search --save', ready to re-run",
+		MimeType:    "text/plain",
+	},
+	{
+		URI:         "jobsearch://search?q=...",
+		Name:        "Search",
+		Description: "Live query against conversations via the \"q\" parameter, parsed with the same field:value mini-language as 'jobsearch search' and search_conversations, e.g. jobsearch://search?q=status:waiting_on_them%20stripe",
+		MimeType:    "text/plain",
+	},
+	{
+		URI:         "jobsearch://jobs",
+		Name:        "Background Jobs",
+		Description: "Recent sync, rescan, and backup jobs queued via enqueue_job or 'jobsearch sync', with status and attempts",
+		MimeType:    "text/plain",
+	},
 }
 
 // resourcesListResult is the response for resources/list
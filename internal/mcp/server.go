@@ -7,16 +7,36 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
 
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/inbound"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/jobs"
 )
 
-// Server implements an MCP server over stdio
+// Server implements an MCP server, over stdio (Start) or HTTP+SSE (StartHTTP)
 type Server struct {
 	db       *database.DB
 	config   *config.Config
 	handlers map[string]ToolHandler
+	jobQueue *jobs.Queue
+	bus      *inbound.Bus
+
+	// sessionsMu guards sessions and subscriptions. sessions routes each
+	// /rpc response (and, for the stdio transport, every response or
+	// notification) to the session that should receive it. subscriptions
+	// tracks which resource URIs each session asked for via
+	// resources/subscribe, so broadcastResourceUpdated only wakes sessions
+	// that actually asked for that URI.
+	sessionsMu    sync.Mutex
+	sessions      map[string]chan *jsonRPCResponse
+	subscriptions map[string]map[string]bool
+
+	// stdoutMu serializes writes to stdout between Start's own
+	// request/response loop and its notification-draining goroutine, so a
+	// notification can't interleave with a partially written response line.
+	stdoutMu sync.Mutex
 }
 
 // ToolHandler is a function that handles a tool call
@@ -32,11 +52,20 @@ type jsonRPCRequest struct {
 
 type jsonRPCResponse struct {
 	JSONRPC string      `json:"jsonrpc"`
-	ID      interface{} `json:"id"`
+	ID      interface{} `json:"id,omitempty"`
+	Method  string      `json:"method,omitempty"` // set only on server-initiated notifications
+	Params  interface{} `json:"params,omitempty"` // set only on server-initiated notifications
 	Result  interface{} `json:"result,omitempty"`
 	Error   *rpcError   `json:"error,omitempty"`
 }
 
+// conversationsChangedParams is the params payload of the
+// notifications/conversations_changed notification.
+type conversationsChangedParams struct {
+	ConversationID string `json:"conversationId"`
+	Reason         string `json:"reason"`
+}
+
 type rpcError struct {
 	Code    int         `json:"code"`
 	Message string      `json:"message"`
@@ -47,7 +76,18 @@ type initializeResult struct {
 	ProtocolVersion string `json:"protocolVersion"`
 	Capabilities    struct {
 		Tools     struct{} `json:"tools"`
-		Resources struct{} `json:"resources"`
+		Resources struct {
+			// Subscribe advertises resources/subscribe + resources/unsubscribe
+			// and the notifications/resources/updated events they enable (see
+			// handleResourcesSubscribe/broadcastResourceUpdated).
+			Subscribe bool `json:"subscribe"`
+			// ListChanged would advertise notifications/resources/list_changed,
+			// but ResourceDefinitions is a fixed, compiled-in list - nothing in
+			// this codebase adds or removes a resource at runtime, so there's
+			// no event that would ever fire it. Left false rather than
+			// advertised-but-silent.
+			ListChanged bool `json:"listChanged"`
+		} `json:"resources"`
 	} `json:"capabilities"`
 	ServerInfo struct {
 		Name    string `json:"name"`
@@ -55,6 +95,18 @@ type initializeResult struct {
 	} `json:"serverInfo"`
 }
 
+// subscribeResourceParams is the params for both resources/subscribe and
+// resources/unsubscribe - same shape, just a URI naming which resource.
+type subscribeResourceParams struct {
+	URI string `json:"uri"`
+}
+
+// resourceUpdatedParams is the params payload of the
+// notifications/resources/updated notification.
+type resourceUpdatedParams struct {
+	URI string `json:"uri"`
+}
+
 type toolsListResult struct {
 	Tools []Tool `json:"tools"`
 }
@@ -77,16 +129,177 @@ type contentItem struct {
 // New creates a new MCP server
 func New(db *database.DB, cfg *config.Config) *Server {
 	s := &Server{
-		db:       db,
-		config:   cfg,
-		handlers: make(map[string]ToolHandler),
+		db:            db,
+		config:        cfg,
+		handlers:      make(map[string]ToolHandler),
+		jobQueue:      jobs.NewQueue(db),
+		sessions:      make(map[string]chan *jsonRPCResponse),
+		subscriptions: make(map[string]map[string]bool),
 	}
 	s.registerHandlers()
 	return s
 }
 
+// SetBus wires bus as the source of notifications/conversations_changed
+// events broadcast to every session connected over StartHTTP. It has no
+// effect on the stdio transport (Start), which doesn't run the bus
+// broadcaster.
+func (s *Server) SetBus(bus *inbound.Bus) {
+	s.bus = bus
+}
+
+// broadcastConversationsChanged sends a notifications/conversations_changed
+// notification to every currently connected /events session, and - the
+// ResourceWatcher side of the same event - a notifications/resources/updated
+// notification to every session subscribed to one of resourceURIsForEvent's
+// affected resources.
+func (s *Server) broadcastConversationsChanged(event inbound.Event) {
+	notification := &jsonRPCResponse{
+		JSONRPC: "2.0",
+		Method:  "notifications/conversations_changed",
+		Params: conversationsChangedParams{
+			ConversationID: event.ConversationID,
+			Reason:         event.Reason,
+		},
+	}
+
+	s.sessionsMu.Lock()
+	for _, ch := range s.sessions {
+		select {
+		case ch <- notification:
+		default:
+			// Subscriber fell behind; drop rather than block.
+		}
+	}
+	s.sessionsMu.Unlock()
+
+	for _, uri := range resourceURIsForEvent(event) {
+		s.broadcastResourceUpdated(uri)
+	}
+}
+
+// resourceURIsForEvent maps an inbound.Event to the static resources whose
+// content a conversation state transition (new email, status change,
+// archive/merge - anything the inbound-mail pipeline publishes as an
+// inbound.Event) could have changed. jobsearch://saved-searches and
+// jobsearch://jobs aren't included: neither's content is derived from
+// conversation state, so no inbound.Event reason affects them.
+// jobsearch://search?q=... is also excluded - it's parameterized per read
+// rather than a single subscribable resource.
+func resourceURIsForEvent(event inbound.Event) []string {
+	uris := []string{"jobsearch://summary", "jobsearch://pending", "jobsearch://recent"}
+	if event.Reason == "incoming_mail" {
+		uris = append(uris, "jobsearch://companies")
+	}
+	return uris
+}
+
+// broadcastResourceUpdated sends a notifications/resources/updated
+// notification for uri to every session that subscribed to it via
+// resources/subscribe.
+func (s *Server) broadcastResourceUpdated(uri string) {
+	notification := &jsonRPCResponse{
+		JSONRPC: "2.0",
+		Method:  "notifications/resources/updated",
+		Params:  resourceUpdatedParams{URI: uri},
+	}
+
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	for sessionID, uris := range s.subscriptions {
+		if !uris[uri] {
+			continue
+		}
+		ch, ok := s.sessions[sessionID]
+		if !ok {
+			continue
+		}
+		select {
+		case ch <- notification:
+		default:
+			// Subscriber fell behind; drop rather than block.
+		}
+	}
+}
+
+// broadcastResourcesListChanged sends notifications/resources/list_changed
+// to every connected session. Nothing in this codebase currently calls it -
+// ResourceDefinitions is a fixed, compiled-in list - but it's kept available
+// (and the listChanged capability could be flipped on) for a future
+// resource source (e.g. per-user saved searches exposed as resources) that
+// does add/remove resources at runtime.
+func (s *Server) broadcastResourcesListChanged() {
+	notification := &jsonRPCResponse{
+		JSONRPC: "2.0",
+		Method:  "notifications/resources/list_changed",
+	}
+
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	for _, ch := range s.sessions {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
+
+// runBusBroadcasts forwards bus events to every connected session until ctx
+// is canceled, if a bus is configured.
+func (s *Server) runBusBroadcasts(ctx context.Context) {
+	if s.bus == nil {
+		return
+	}
+
+	events, unsubscribe := s.bus.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			s.broadcastConversationsChanged(event)
+		}
+	}
+}
+
+// stdioSessionID names the single implicit session a stdio client (Start)
+// has, so resources/subscribe has somewhere to record subscriptions even
+// though stdio, unlike StartHTTP, has no client-supplied session ID.
+const stdioSessionID = "stdio"
+
 // Start runs the MCP server on stdio
 func (s *Server) Start(ctx context.Context) error {
+	go s.runJobPools(ctx)
+
+	// Register the implicit stdio session and drain its notification
+	// channel (resources/updated, resources/list_changed, ...) to stdout
+	// alongside the request/response loop below, the same way StartHTTP's
+	// /events goroutine drains an HTTP session - stdoutMu keeps the two
+	// writers from interleaving a notification mid-response.
+	notifications := make(chan *jsonRPCResponse, 16)
+	s.sessionsMu.Lock()
+	s.sessions[stdioSessionID] = notifications
+	s.sessionsMu.Unlock()
+	defer func() {
+		s.sessionsMu.Lock()
+		delete(s.sessions, stdioSessionID)
+		delete(s.subscriptions, stdioSessionID)
+		s.sessionsMu.Unlock()
+	}()
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case notification := <-notifications:
+				s.writeStdout(notification)
+			}
+		}
+	}()
+
 	reader := bufio.NewReader(os.Stdin)
 
 	for {
@@ -104,18 +317,27 @@ func (s *Server) Start(ctx context.Context) error {
 			return fmt.Errorf("read error: %w", err)
 		}
 
-		response := s.handleMessage(ctx, line)
+		response := s.handleMessage(ctx, line, stdioSessionID)
 		if response != nil {
-			output, err := json.Marshal(response)
-			if err != nil {
-				continue
-			}
-			fmt.Println(string(output))
+			s.writeStdout(response)
 		}
 	}
 }
 
-func (s *Server) handleMessage(ctx context.Context, msg string) *jsonRPCResponse {
+// writeStdout marshals and prints resp, holding stdoutMu so it can't
+// interleave with a concurrent write from Start's notification-draining
+// goroutine.
+func (s *Server) writeStdout(resp *jsonRPCResponse) {
+	output, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+	s.stdoutMu.Lock()
+	defer s.stdoutMu.Unlock()
+	fmt.Println(string(output))
+}
+
+func (s *Server) handleMessage(ctx context.Context, msg string, sessionID string) *jsonRPCResponse {
 	var req jsonRPCRequest
 	if err := json.Unmarshal([]byte(msg), &req); err != nil {
 		return &jsonRPCResponse{
@@ -142,6 +364,10 @@ func (s *Server) handleMessage(ctx context.Context, msg string) *jsonRPCResponse
 		return s.handleResourcesList(req)
 	case "resources/read":
 		return s.handleResourcesRead(ctx, req)
+	case "resources/subscribe":
+		return s.handleResourcesSubscribe(req, sessionID)
+	case "resources/unsubscribe":
+		return s.handleResourcesUnsubscribe(req, sessionID)
 	default:
 		return &jsonRPCResponse{
 			JSONRPC: "2.0",
@@ -158,6 +384,7 @@ func (s *Server) handleInitialize(req jsonRPCRequest) *jsonRPCResponse {
 	result := initializeResult{
 		ProtocolVersion: "2024-11-05",
 	}
+	result.Capabilities.Resources.Subscribe = true
 	result.ServerInfo.Name = "jobsearch-mcp"
 	result.ServerInfo.Version = "0.1.0"
 
@@ -278,3 +505,51 @@ func (s *Server) handleResourcesRead(ctx context.Context, req jsonRPCRequest) *j
 		},
 	}
 }
+
+// handleResourcesSubscribe records sessionID's interest in params.uri, so a
+// future broadcastResourceUpdated(uri) wakes it with
+// notifications/resources/updated. sessionID is "stdio" for the stdio
+// transport (see Start) or the caller's X-Session-Id for StartHTTP.
+func (s *Server) handleResourcesSubscribe(req jsonRPCRequest, sessionID string) *jsonRPCResponse {
+	var params subscribeResourceParams
+	if err := json.Unmarshal(req.Params, &params); err != nil || params.URI == "" {
+		return &jsonRPCResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error:   &rpcError{Code: -32602, Message: "Invalid params: uri is required"},
+		}
+	}
+
+	s.sessionsMu.Lock()
+	uris, ok := s.subscriptions[sessionID]
+	if !ok {
+		uris = make(map[string]bool)
+		s.subscriptions[sessionID] = uris
+	}
+	uris[params.URI] = true
+	s.sessionsMu.Unlock()
+
+	return &jsonRPCResponse{JSONRPC: "2.0", ID: req.ID, Result: struct{}{}}
+}
+
+// handleResourcesUnsubscribe undoes a prior resources/subscribe for
+// params.uri. Unsubscribing from a URI never subscribed to is a no-op, not
+// an error.
+func (s *Server) handleResourcesUnsubscribe(req jsonRPCRequest, sessionID string) *jsonRPCResponse {
+	var params subscribeResourceParams
+	if err := json.Unmarshal(req.Params, &params); err != nil || params.URI == "" {
+		return &jsonRPCResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error:   &rpcError{Code: -32602, Message: "Invalid params: uri is required"},
+		}
+	}
+
+	s.sessionsMu.Lock()
+	if uris, ok := s.subscriptions[sessionID]; ok {
+		delete(uris, params.URI)
+	}
+	s.sessionsMu.Unlock()
+
+	return &jsonRPCResponse{JSONRPC: "2.0", ID: req.ID, Result: struct{}{}}
+}
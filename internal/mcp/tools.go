@@ -7,6 +7,65 @@ type Tool struct {
 	InputSchema map[string]interface{} `json:"inputSchema"`
 }
 
+// searchCriteriaSchema mirrors database.SearchCriteria's exported fields
+// (which the CLI's flag parsing and ParseQuery mini-language both compile
+// down to) so an assistant can build the same structured query the CLI does
+// instead of guessing at field names.
+var searchCriteriaSchema = map[string]interface{}{
+	"type":        "object",
+	"description": "A structured database.SearchCriteria object. Unset fields are ignored; All/Any/Not nest additional SearchCriteria objects for AND/OR/NOT composition.",
+	"properties": map[string]interface{}{
+		"ID":              map[string]interface{}{"type": "string", "description": "Exact conversation ID"},
+		"Company":         map[string]interface{}{"type": "string", "description": "Company name glob, e.g. \"Stripe*\""},
+		"Position":        map[string]interface{}{"type": "string", "description": "Position title glob, e.g. \"*Engineer*\""},
+		"Domain":          map[string]interface{}{"type": "string", "description": "Recruiter email domain, e.g. \"recruiter.com\""},
+		"Recruiter":       map[string]interface{}{"type": "string", "description": "Recruiter name or email glob, e.g. \"jane*\""},
+		"To":              map[string]interface{}{"type": "string", "description": "Recipient address glob, e.g. \"jobs+*@example.com\""},
+		"Subject":         map[string]interface{}{"type": "string", "description": "Email subject glob, distinct from FullText's broader match"},
+		"Status":          map[string]interface{}{"type": "string", "enum": []string{"waiting_on_me", "waiting_on_them", "stale", "active", "closed", "bounced", "interview_scheduled"}},
+		"StatusIn":        map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}, "description": "Match any of these statuses"},
+		"Direction":       map[string]interface{}{"type": "string", "enum": []string{"inbound", "outbound"}},
+		"Since":           map[string]interface{}{"type": "string", "format": "date-time", "description": "last_activity_at >= Since"},
+		"Before":          map[string]interface{}{"type": "string", "format": "date-time", "description": "last_activity_at <= Before"},
+		"HasRecruiter":    map[string]interface{}{"type": "boolean"},
+		"StaleOnly":       map[string]interface{}{"type": "boolean"},
+		"BouncedOnly":     map[string]interface{}{"type": "boolean"},
+		"ReviewSuggested": map[string]interface{}{"type": "boolean", "description": "Only conversations flagged for manual review"},
+		"MinEmailCount":   map[string]interface{}{"type": "integer"},
+		"MaxEmailCount":   map[string]interface{}{"type": "integer"},
+		"MinConfidence":   map[string]interface{}{"type": "number"},
+		"MaxConfidence":   map[string]interface{}{"type": "number"},
+		"Layer":           map[string]interface{}{"type": "string", "description": "Classification layer, e.g. \"whitelist\", \"keyword\", \"llm\""},
+		"IncludeArchived": map[string]interface{}{"type": "boolean"},
+		"ArchivedOnly":    map[string]interface{}{"type": "boolean"},
+		"FullText":        map[string]interface{}{"type": "string", "description": "Matches company/position/recruiter/subject"},
+		"All":             map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object"}, "description": "Sub-criteria AND-ed together"},
+		"Any":             map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object"}, "description": "Sub-criteria OR-ed together"},
+		"Not":             map[string]interface{}{"type": "object", "description": "Negated sub-criteria"},
+		"Limit":           map[string]interface{}{"type": "integer"},
+		"Offset":          map[string]interface{}{"type": "integer"},
+	},
+}
+
+// emailSearchCriteriaSchema mirrors database.EmailSearchCriteria's exported
+// fields, the message-level counterpart to searchCriteriaSchema.
+var emailSearchCriteriaSchema = map[string]interface{}{
+	"type":        "object",
+	"description": "A structured database.EmailSearchCriteria object. Unset fields are ignored.",
+	"properties": map[string]interface{}{
+		"ConversationID": map[string]interface{}{"type": "string", "description": "Only emails belonging to this conversation"},
+		"Direction":      map[string]interface{}{"type": "string", "enum": []string{"inbound", "outbound"}},
+		"Layer":          map[string]interface{}{"type": "string", "description": "Classification layer, e.g. \"whitelist\", \"keyword\", \"llm\""},
+		"MinConfidence":  map[string]interface{}{"type": "number"},
+		"MaxConfidence":  map[string]interface{}{"type": "number"},
+		"Since":          map[string]interface{}{"type": "string", "format": "date-time", "description": "date >= Since"},
+		"Before":         map[string]interface{}{"type": "string", "format": "date-time", "description": "date <= Before"},
+		"TextQuery":      map[string]interface{}{"type": "string", "description": "Matches subject/snippet/extracted_data"},
+		"Limit":          map[string]interface{}{"type": "integer"},
+		"Offset":         map[string]interface{}{"type": "integer"},
+	},
+}
+
 // ToolDefinitions contains all available MCP tools
 var ToolDefinitions = []Tool{
 	{
@@ -17,7 +76,7 @@ var ToolDefinitions = []Tool{
 			"properties": map[string]interface{}{
 				"status": map[string]interface{}{
 					"type":        "string",
-					"enum":        []string{"waiting_on_me", "waiting_on_them", "stale", "active", "closed", "all"},
+					"enum":        []string{"waiting_on_me", "waiting_on_them", "stale", "active", "closed", "bounced", "interview_scheduled", "all"},
 					"description": "Filter by conversation status. Use 'all' or omit for no filter.",
 				},
 				"company": map[string]interface{}{
@@ -36,6 +95,7 @@ var ToolDefinitions = []Tool{
 					"type":        "boolean",
 					"description": "Include archived conversations (default: false)",
 				},
+				"criteria": searchCriteriaSchema,
 			},
 		},
 	},
@@ -66,18 +126,37 @@ var ToolDefinitions = []Tool{
 			},
 		},
 	},
+	{
+		Name:        "upcoming_interviews",
+		Description: "Get every not-yet-cancelled interview starting from now on, soonest first. Interviews are detected automatically from calendar invites attached to synced mail.",
+		InputSchema: map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{},
+		},
+	},
 	{
 		Name:        "search_conversations",
-		Description: "Search across all conversations by company name, recruiter, position, or email subject.",
+		Description: "Search across all conversations by company name, recruiter, position, or email subject. Accepts either a free-text query (parsed with a field:value mini-language, e.g. \"company:acme after:2024-01-01 waiting\") or a structured criteria object.",
 		InputSchema: map[string]interface{}{
 			"type": "object",
 			"properties": map[string]interface{}{
 				"query": map[string]interface{}{
 					"type":        "string",
-					"description": "Search query text",
+					"description": "Search query text. Supports field:value terms (company:, domain:, recruiter:, status:, direction:, layer:, after:, before:, archived:, review_suggested:) mixed with bare full-text words; status: accepts a comma-separated list. Ignored if criteria is set.",
 				},
+				"criteria": searchCriteriaSchema,
 			},
-			"required": []string{"query"},
+		},
+	},
+	{
+		Name:        "search_emails",
+		Description: "Search across individual emails (rather than whole conversations) by conversation, direction, classification layer, date range, or text. Useful for finding a specific message inside a long thread.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"criteria": emailSearchCriteriaSchema,
+			},
+			"required": []string{"criteria"},
 		},
 	},
 	{
@@ -97,6 +176,65 @@ var ToolDefinitions = []Tool{
 			},
 		},
 	},
+	{
+		Name:        "get_analytics",
+		Description: "Get a deeper report on your job search than get_stats: response-time percentiles by direction, a conversations-per-week time series, top companies by email volume, a classification funnel, and per-week stale rate.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"since_days": map[string]interface{}{
+					"type":        "integer",
+					"description": "Only include activity from the last N days (response times, per-week series, top companies, and funnel; stale rate always covers all history)",
+				},
+				"top_companies": map[string]interface{}{
+					"type":        "integer",
+					"description": "How many companies to include in the top-companies ranking (default: 10)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "query",
+		Description: "Run a unified search.Criteria query against conversations or emails: multi-valued company/recruiter/status/direction filters, subject/body substring matches, classification, a confidence range, a date range, and has_position, combined with AND. The same criteria also drives filter.Match against an in-memory batch, so one JSON payload composes queries like \"waiting_on_them at fintech companies with confidence > 0.7 in the last 14 days\" regardless of where the data currently lives.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"target": map[string]interface{}{
+					"type":        "string",
+					"enum":        []string{"conversations", "emails"},
+					"description": "Which table to query (default: conversations)",
+				},
+				"criteria": map[string]interface{}{
+					"type":        "object",
+					"description": "search.Criteria fields: companies, recruiters, statuses, directions, subject_contains, body_contains, classification_in, confidence_min, confidence_max, date_after, date_before, has_position, layers, sort_by, sort_desc, limit, offset",
+				},
+			},
+			"required": []string{"criteria"},
+		},
+	},
+	{
+		Name:        "draft_reply",
+		Description: "Render a follow_up, nudge, decline, or thank_you template against a conversation and its latest email, returning a ready-to-send subject and plaintext/HTML body. Does not send anything.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"identifier": map[string]interface{}{
+					"type":        "string",
+					"description": "Company name or conversation ID",
+				},
+				"template": map[string]interface{}{
+					"type":        "string",
+					"enum":        []string{"follow_up", "nudge", "decline", "thank_you"},
+					"description": "Which built-in (or configured override) template to render",
+				},
+				"user_name": map[string]interface{}{
+					"type":        "string",
+					"description": "Fills the {user_name} placeholder in the signature",
+				},
+			},
+			"required": []string{"identifier", "template"},
+		},
+	},
 	{
 		Name:        "merge_conversations",
 		Description: "Merge two conversations into one. All emails from the source conversation are moved to the target.",
@@ -133,4 +271,134 @@ var ToolDefinitions = []Tool{
 			"required": []string{"identifier"},
 		},
 	},
+	{
+		Name:        "mute_notifications",
+		Description: "Snooze notify.Scheduler and rule-matched notifications for a conversation until a given time, without touching its own snoozed_until (which governs staleness, not notification delivery).",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"conversation_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Company name or conversation ID",
+				},
+				"until": map[string]interface{}{
+					"type":        "string",
+					"format":      "date-time",
+					"description": "RFC3339 timestamp; notifications for this conversation are suppressed until then",
+				},
+			},
+			"required": []string{"conversation_id", "until"},
+		},
+	},
+	{
+		Name:        "backup_export",
+		Description: "Export the database, a redacted copy of the config, and a manifest (schema version, database checksum, row counts) into a single tar.gz archive.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"path": map[string]interface{}{
+					"type":        "string",
+					"description": "Filesystem path to write the archive to. If omitted, the archive is returned inline as base64 (only for archives up to 5MB).",
+				},
+			},
+		},
+	},
+	{
+		Name:        "backup_import",
+		Description: "Restore the database from a backup_export archive. Refuses archives newer than this binary's schema; runs migrations forward for older ones. The running server keeps serving the pre-import database until restarted.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"path": map[string]interface{}{
+					"type":        "string",
+					"description": "Filesystem path of the archive to import. Exactly one of path/data must be set.",
+				},
+				"data": map[string]interface{}{
+					"type":        "string",
+					"description": "Base64-encoded archive, for callers that got one back inline from backup_export.",
+				},
+			},
+		},
+	},
+	{
+		Name:        "learn_keywords",
+		Description: "Run a RAKE (Rapid Automatic Keyword Extraction) pass over every non-archived, non-closed conversation's emails and stage newly discovered subject/body phrases as ai_suggested filters, the same staged suggestion + auto-promotion path 'jobsearch sync' uses for hand-picked recruiting phrases.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"dry_run": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Preview discovered phrases without staging them (default: false)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "reindex_search",
+		Description: "Rebuild the FTS5 full-text search index (emails_fts/conversations_fts) that search_conversations and 'jobsearch search'/'list' prefer for ranked keyword matches. Normal sync keeps it current via triggers; this is only needed after restoring a backup taken before FTS5 was enabled, or if the fts5 sqlite3 module becomes available on a binary that was previously built without it.",
+		InputSchema: map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{},
+		},
+	},
+	{
+		Name:        "enqueue_job",
+		Description: "Queue a background job (sync, rescan_message, rescan_all, backup) instead of running it inline. A persistent worker pool in the running MCP server picks it up (currently only 'backup' has a server-side handler; sync/rescan run when the CLI claims them, since those need an authenticated Gmail session the server doesn't hold).",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"type": map[string]interface{}{
+					"type":        "string",
+					"enum":        []string{"sync", "rescan_message", "rescan_all", "backup"},
+					"description": "Job type to enqueue",
+				},
+				"priority": map[string]interface{}{
+					"type":        "integer",
+					"description": "Lower runs first. Defaults to the standard priority for the job type if omitted.",
+				},
+				"payload": map[string]interface{}{
+					"type":        "object",
+					"description": "Job-specific payload, e.g. {\"path\": \"...\"} for a backup job.",
+				},
+			},
+			"required": []string{"type"},
+		},
+	},
+	{
+		Name:        "list_jobs",
+		Description: "List background jobs, optionally filtered by type and/or status, most recently created first.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"type": map[string]interface{}{
+					"type":        "string",
+					"enum":        []string{"sync", "rescan_message", "rescan_all", "backup"},
+					"description": "Filter by job type",
+				},
+				"status": map[string]interface{}{
+					"type":        "string",
+					"enum":        []string{"queued", "running", "succeeded", "failed", "cancelled"},
+					"description": "Filter by job status",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum number of results to return (default: 20)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "cancel_job",
+		Description: "Cancel a queued job so no worker picks it up. Has no effect on a job that's already running or finished.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"id": map[string]interface{}{
+					"type":        "string",
+					"description": "Job ID, as returned by enqueue_job or list_jobs",
+				},
+			},
+			"required": []string{"id"},
+		},
+	},
 }
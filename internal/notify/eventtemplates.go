@@ -0,0 +1,215 @@
+package notify
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//go:embed defaults/*.txt defaults/*.html
+var eventDefaultsFS embed.FS
+
+// eventKind groups several related EventTypes under one user-editable
+// template pair, the way "stale conversation" covers both
+// EventStaleReminder (fired inline by Tracker) and
+// EventStaleThresholdCrossed (fired by Scheduler's sweep) - a recipient
+// doesn't care which code path noticed, just that the thread went quiet.
+type eventKind string
+
+const (
+	kindInterviewScheduled eventKind = "interview_scheduled"
+	kindStaleConversation  eventKind = "stale_conversation"
+	kindRejectionDetected  eventKind = "rejection_detected"
+	kindWeeklySummary      eventKind = "weekly_summary"
+)
+
+var eventKindByType = map[EventType]eventKind{
+	EventInterviewScheduled:    kindInterviewScheduled,
+	EventStaleReminder:         kindStaleConversation,
+	EventStaleThresholdCrossed: kindStaleConversation,
+	EventRejectionDetected:     kindRejectionDetected,
+	EventWeeklySummary:         kindWeeklySummary,
+}
+
+// EventTemplateKinds lists every eventKind a user can override or preview
+// with "jobsearch notify templates test", in a fixed, stable order.
+var EventTemplateKinds = []string{
+	string(kindInterviewScheduled),
+	string(kindStaleConversation),
+	string(kindRejectionDetected),
+	string(kindWeeklySummary),
+}
+
+// EventRendered is a digest/alert template rendered against one Event,
+// ready to send or preview.
+type EventRendered struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// RenderEvent renders event using the {variable}-substitution template for
+// its eventKind - the override under templatesDir if one exists, the
+// shipped default otherwise. An event type with no eventKind (e.g. a plain
+// status change or a matched rule) has no digest-style template to
+// customize, so RenderEvent falls back to RenderPayload's short one-line
+// Go-template rendering instead.
+func RenderEvent(event Event, templatesDir string) (*EventRendered, error) {
+	kind, ok := eventKindByType[event.Type]
+	if !ok {
+		text, err := RenderPayload(event, "")
+		if err != nil {
+			return nil, err
+		}
+		return &EventRendered{Text: text}, nil
+	}
+
+	textSrc, err := loadEventTemplate(templatesDir, kind, "txt")
+	if err != nil {
+		return nil, err
+	}
+	htmlSrc, err := loadEventTemplate(templatesDir, kind, "html")
+	if err != nil {
+		return nil, err
+	}
+
+	vars := eventTemplateVars(event)
+	subject, body := splitEventSubject(substituteEventVars(textSrc, vars))
+
+	return &EventRendered{
+		Subject: subject,
+		Text:    body,
+		HTML:    substituteEventVars(htmlSrc, vars),
+	}, nil
+}
+
+// EnsureEventDefaults materializes every shipped default template under
+// templatesDir that doesn't already exist there, so a user who wants to
+// customize one has a real file to find and edit instead of having to
+// guess its shape from documentation. A blank templatesDir is a no-op -
+// every notifier just reads the embedded defaults directly.
+func EnsureEventDefaults(templatesDir string) error {
+	if templatesDir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(templatesDir, 0700); err != nil {
+		return fmt.Errorf("failed to create notify templates directory: %w", err)
+	}
+	for _, kind := range EventTemplateKinds {
+		for _, ext := range []string{"txt", "html"} {
+			path := eventTemplatePath(templatesDir, eventKind(kind), ext)
+			if _, err := os.Stat(path); err == nil {
+				continue
+			} else if !os.IsNotExist(err) {
+				return fmt.Errorf("failed to stat %s: %w", path, err)
+			}
+			content, err := eventDefaultsFS.ReadFile(defaultEventTemplateFile(eventKind(kind), ext))
+			if err != nil {
+				return fmt.Errorf("no built-in default for %s.%s: %w", kind, ext, err)
+			}
+			if err := os.WriteFile(path, content, 0600); err != nil {
+				return fmt.Errorf("failed to write default template %s: %w", path, err)
+			}
+		}
+	}
+	return nil
+}
+
+func eventTemplatePath(templatesDir string, kind eventKind, ext string) string {
+	return templatesDir + "/" + string(kind) + "." + ext
+}
+
+func defaultEventTemplateFile(kind eventKind, ext string) string {
+	return "defaults/" + string(kind) + "." + ext
+}
+
+func loadEventTemplate(templatesDir string, kind eventKind, ext string) (string, error) {
+	if templatesDir != "" {
+		path := eventTemplatePath(templatesDir, kind, ext)
+		data, err := os.ReadFile(path)
+		if err == nil {
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("failed to read template %s: %w", path, err)
+		}
+	}
+
+	data, err := eventDefaultsFS.ReadFile(defaultEventTemplateFile(kind, ext))
+	if err != nil {
+		return "", fmt.Errorf("no built-in default for %s.%s: %w", kind, ext, err)
+	}
+	return string(data), nil
+}
+
+// eventTemplateVars assembles the {variable} substitution set out of
+// whatever event carries - its Conversation, Email, Interview, and Message
+// fields are all optional, so a var with no source is simply left unset
+// (substituteEventVars leaves the placeholder untouched rather than
+// blanking it).
+func eventTemplateVars(event Event) map[string]string {
+	vars := map[string]string{
+		"message": event.Message,
+	}
+
+	if event.Conversation != nil {
+		vars["company"] = event.Conversation.Company
+		vars["status"] = string(event.Conversation.Status)
+		vars["days_since_reply"] = strconv.Itoa(event.Conversation.DaysSinceActivity())
+		vars["recruiter"] = "there"
+		if event.Conversation.RecruiterName != nil && *event.Conversation.RecruiterName != "" {
+			vars["recruiter"] = *event.Conversation.RecruiterName
+		}
+		if event.Conversation.Position != nil {
+			vars["position"] = *event.Conversation.Position
+		}
+	}
+
+	if event.Email != nil && event.Email.Subject != nil {
+		vars["subject"] = *event.Email.Subject
+	}
+
+	if event.Interview != nil {
+		vars["interview_summary"] = event.Interview.Summary
+		vars["next_interview_date"] = event.Interview.StartTime.Format("Mon Jan 2, 3:04 PM")
+		vars["location"] = "TBD"
+		if event.Interview.VideoURL != nil && *event.Interview.VideoURL != "" {
+			vars["location"] = *event.Interview.VideoURL
+		} else if event.Interview.Location != nil && *event.Interview.Location != "" {
+			vars["location"] = *event.Interview.Location
+		}
+	}
+
+	return vars
+}
+
+// substituteEventVars replaces every "{name}" in src with vars[name],
+// leaving unrecognized placeholders untouched - same convention as
+// templates.substitute, duplicated here rather than shared since the two
+// packages render unrelated things (outbound replies vs. notification
+// events) from unrelated variable sets.
+func substituteEventVars(src string, vars map[string]string) string {
+	out := src
+	for name, value := range vars {
+		out = strings.ReplaceAll(out, "{"+name+"}", value)
+	}
+	return out
+}
+
+// splitEventSubject pulls a leading "Subject: ..." line off text, the same
+// convention templates.splitSubject applies to outbound reply templates.
+func splitEventSubject(text string) (subject, body string) {
+	const prefix = "Subject: "
+	if !strings.HasPrefix(text, prefix) {
+		return "", text
+	}
+	nl := strings.IndexByte(text, '\n')
+	if nl < 0 {
+		return strings.TrimPrefix(text, prefix), ""
+	}
+	subject = strings.TrimSpace(strings.TrimPrefix(text[:nl], prefix))
+	rest := strings.TrimPrefix(text[nl+1:], "\n")
+	return subject, rest
+}
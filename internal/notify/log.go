@@ -0,0 +1,31 @@
+package notify
+
+import (
+	"context"
+	"log"
+)
+
+// LogNotifier writes each event to the standard logger instead of
+// delivering it anywhere, for dry-run and local development.
+type LogNotifier struct {
+	name     string
+	Template string // optional override, see TemplateFuncs
+}
+
+// NewLogNotifier creates a LogNotifier with the given registry name.
+func NewLogNotifier(name string) *LogNotifier {
+	return &LogNotifier{name: name}
+}
+
+// Name implements Notifier.
+func (n *LogNotifier) Name() string { return n.name }
+
+// Send implements Notifier.
+func (n *LogNotifier) Send(_ context.Context, event Event) error {
+	payload, err := RenderPayload(event, n.Template)
+	if err != nil {
+		return err
+	}
+	log.Printf("[notify:%s] %s", n.name, payload)
+	return nil
+}
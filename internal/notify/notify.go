@@ -0,0 +1,167 @@
+// Package notify fans conversation lifecycle events (a new recruiter
+// thread, a stage change, a stale reminder, a learned false positive, a
+// detected bounce) out to a set of configured Notifiers - SMTP, Slack,
+// a generic HTTP webhook, or a LogNotifier for dry-run.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// EventType identifies the kind of conversation lifecycle event being
+// notified.
+type EventType string
+
+const (
+	EventConversationCreated  EventType = "conversation_created"
+	EventStatusChanged        EventType = "status_changed"
+	EventStaleReminder        EventType = "stale_reminder"
+	EventFalsePositiveLearned EventType = "false_positive_learned"
+	EventBounceDetected       EventType = "bounce_detected"
+	EventRuleMatched          EventType = "rule_matched"
+	// EventStaleThresholdCrossed, EventWaitingOnMeReminder, and
+	// EventNewInboundFromWhitelistedDomain are fired by Scheduler rather
+	// than Tracker - they're purely a function of time passing (a
+	// conversation sitting untouched, a reminder window elapsing) rather
+	// than something Sync observed changing, so nothing would otherwise
+	// catch them between syncs.
+	EventStaleThresholdCrossed           EventType = "stale_threshold_crossed"
+	EventWaitingOnMeReminder             EventType = "waiting_on_me_reminder"
+	EventNewInboundFromWhitelistedDomain EventType = "new_inbound_from_whitelisted_domain"
+	// EventInterviewScheduled is fired by Tracker when a calendar invite
+	// (internal/calendar) leaves a conversation with a newly scheduled
+	// interview - see Tracker.handleInterviews.
+	EventInterviewScheduled EventType = "interview_scheduled"
+	// EventRejectionDetected is fired when a "#job reject" reply closes a
+	// conversation, or when a content transition rule (internal/transitions,
+	// see Tracker.applyContentTransition) moves one to closed.
+	EventRejectionDetected EventType = "rejection_detected"
+	// EventWeeklySummary is fired by Scheduler on its weekly cadence with
+	// aggregate counts across every tracked conversation, not any single
+	// one - see Event.Conversation being nil for this type.
+	EventWeeklySummary EventType = "weekly_summary"
+)
+
+// Event is a single typed conversation lifecycle event, emitted by Tracker
+// or Scheduler through a Registry of Notifiers.
+type Event struct {
+	Type         EventType
+	Conversation *database.Conversation
+	// Email is the conversation's latest email at the time the event
+	// fired, so a notifier can format a message with its subject instead
+	// of just the conversation's company/recruiter. May be nil for events
+	// with no natural "latest email" (e.g. EventFalsePositiveLearned).
+	Email *database.Email
+	// Interview carries interview-specific detail for
+	// EventInterviewScheduled, nil for every other event type. See
+	// eventTemplateVars for the placeholders it feeds.
+	Interview *database.Interview
+	Message   string // short human-readable detail, e.g. "acme.com bounced hard"
+}
+
+// Notifier delivers a single Event. Implementations are narrow (one Send
+// method, like tracker.ReplySender) so each transport can be exercised
+// without the others.
+type Notifier interface {
+	Name() string
+	Send(ctx context.Context, event Event) error
+}
+
+type registryEntry struct {
+	notifier Notifier
+	events   map[EventType]bool // nil means every event type
+}
+
+// Registry fans an Event out to the Notifiers registered for its type,
+// bounded by a worker pool so one slow notifier (a flaky Slack webhook,
+// say) runs concurrently with the rest instead of serializing behind it.
+type Registry struct {
+	entries []registryEntry
+	workers int
+}
+
+// NewRegistry creates a Registry whose Notify calls run at most workers
+// deliveries concurrently. workers < 1 is treated as 1.
+func NewRegistry(workers int) *Registry {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Registry{workers: workers}
+}
+
+// Register adds n to the registry, limited to the given event types. No
+// event types means n receives every event.
+func (r *Registry) Register(n Notifier, events ...EventType) {
+	var set map[EventType]bool
+	if len(events) > 0 {
+		set = make(map[EventType]bool, len(events))
+		for _, e := range events {
+			set[e] = true
+		}
+	}
+	r.entries = append(r.entries, registryEntry{notifier: n, events: set})
+}
+
+// Notify delivers event to every notifier registered for its type,
+// concurrently up to the registry's worker limit. It waits for all
+// deliveries to finish and joins their errors, but a notifier that's slow
+// doesn't hold up the others - only the caller's total wait is bounded by
+// the slowest one, not the sum of all.
+func (r *Registry) Notify(ctx context.Context, event Event) error {
+	var selected []Notifier
+	for _, e := range r.entries {
+		if e.events == nil || e.events[event.Type] {
+			selected = append(selected, e.notifier)
+		}
+	}
+	return r.deliver(ctx, event, selected)
+}
+
+// deliver fans event out to notifiers concurrently, bounded by the
+// registry's worker limit, and joins their errors. Shared by Notify
+// (selected by event type) and NotifyTo (selected by name).
+func (r *Registry) deliver(ctx context.Context, event Event, selected []Notifier) error {
+	if len(selected) == 0 {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, r.workers)
+	errCh := make(chan error, len(selected))
+
+	for _, n := range selected {
+		wg.Add(1)
+		go func(n Notifier) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+
+			if err := n.Send(ctx, event); err != nil {
+				errCh <- fmt.Errorf("%s: %w", n.Name(), err)
+			}
+		}(n)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	var errs []error
+	for err := range errCh {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
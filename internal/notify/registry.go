@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+)
+
+// BuildRegistry constructs a Registry from cfg, one Notifier per configured
+// entry, each limited to the event types it lists (or every event type, if
+// it lists none).
+func BuildRegistry(cfg config.NotifyConfig) (*Registry, error) {
+	reg := NewRegistry(cfg.Workers)
+
+	for _, entry := range cfg.Notifiers {
+		notifier, err := buildNotifier(entry, cfg.TemplatesDir)
+		if err != nil {
+			return nil, fmt.Errorf("notifier %q: %w", entry.Name, err)
+		}
+
+		events := make([]EventType, len(entry.Events))
+		for i, e := range entry.Events {
+			events[i] = EventType(e)
+		}
+		reg.Register(notifier, events...)
+	}
+
+	return reg, nil
+}
+
+// NotifyTo delivers event through the registered notifiers named in
+// names, ignoring each notifier's configured event-type filter - unlike
+// Notify, a rule targets notifiers explicitly rather than by event type.
+// An empty names delivers to every registered notifier. Deliveries run
+// concurrently up to the registry's worker limit, same as Notify.
+func (r *Registry) NotifyTo(ctx context.Context, event Event, names []string) error {
+	var selected []Notifier
+	if len(names) == 0 {
+		for _, e := range r.entries {
+			selected = append(selected, e.notifier)
+		}
+	} else {
+		wanted := make(map[string]bool, len(names))
+		for _, n := range names {
+			wanted[n] = true
+		}
+		for _, e := range r.entries {
+			if wanted[e.notifier.Name()] {
+				selected = append(selected, e.notifier)
+			}
+		}
+	}
+	return r.deliver(ctx, event, selected)
+}
+
+// BuildRules converts cfg's RuleEntry list into the Rule slice a
+// RuleEngine evaluates.
+func BuildRules(cfg config.NotifyConfig) []Rule {
+	rules := make([]Rule, len(cfg.Rules))
+	for i, entry := range cfg.Rules {
+		rules[i] = Rule{
+			Name:      entry.Name,
+			Condition: entry.Condition,
+			Notifiers: entry.Notifiers,
+			Throttle:  entry.ThrottleDuration(),
+		}
+	}
+	return rules
+}
+
+func buildNotifier(entry config.NotifierEntry, templatesDir string) (Notifier, error) {
+	switch entry.Type {
+	case "smtp":
+		return NewSMTPNotifier(entry.Name, SMTPNotifierConfig{
+			Host:         entry.SMTP.Host,
+			Port:         entry.SMTP.Port,
+			Username:     entry.SMTP.Username,
+			Password:     entry.SMTP.Password,
+			From:         entry.SMTP.From,
+			To:           entry.SMTP.To,
+			Template:     entry.Template,
+			TemplatesDir: templatesDir,
+		}), nil
+	case "slack":
+		return NewSlackNotifier(entry.Name, SlackNotifierConfig{
+			WebhookURL: entry.Slack.WebhookURL,
+			Template:   entry.Template,
+		}), nil
+	case "webhook":
+		return NewWebhookNotifier(entry.Name, WebhookNotifierConfig{
+			URL:      entry.Webhook.URL,
+			Template: entry.Template,
+		}), nil
+	case "telegram":
+		return NewTelegramNotifier(entry.Name, TelegramNotifierConfig{
+			BotToken: entry.Telegram.BotToken,
+			ChatID:   entry.Telegram.ChatID,
+			Template: entry.Template,
+		}), nil
+	case "log":
+		notifier := NewLogNotifier(entry.Name)
+		notifier.Template = entry.Template
+		return notifier, nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type %q", entry.Type)
+	}
+}
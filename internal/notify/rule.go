@@ -0,0 +1,202 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rule is a user-defined condition that, when it matches a RuleContext,
+// fires notify.EventRuleMatched through the notifiers it names. Condition
+// is a small fixed vocabulary rather than a general expression language:
+//
+//	stage == "waiting_on_them"
+//	company in watchlist
+//	days_since_last_reply > 14
+//	sender_domain in whitelist
+type Rule struct {
+	Name      string
+	Condition string
+	Notifiers []string      // notifier names to target; empty means every registered notifier
+	Throttle  time.Duration // minimum time between two fires of this rule; zero means no throttling
+}
+
+// RuleContext carries the facts a Rule's Condition is evaluated against.
+// Stage currently maps 1:1 to database.Conversation.Status; finer-grained
+// funnel-stage inference (see internal/cli/funnel.go) evaluates a
+// different, stats-only question today and isn't threaded through here.
+type RuleContext struct {
+	Stage              string
+	Company            string
+	DaysSinceLastReply int
+	SenderDomain       string
+	Watchlist          []string
+	Whitelist          []string
+}
+
+var conditionPattern = regexp.MustCompile(`^(\w+)\s*(==|!=|in|>=|<=|>|<)\s*(.+)$`)
+
+// Eval reports whether r.Condition holds for ctx. An unparseable or
+// unknown condition is a configuration error, not a silent false, so a
+// typo'd rule surfaces immediately via "jobsearch notify test" rather than
+// quietly never firing.
+func (r Rule) Eval(ctx RuleContext) (bool, error) {
+	m := conditionPattern.FindStringSubmatch(strings.TrimSpace(r.Condition))
+	if m == nil {
+		return false, fmt.Errorf("rule %q: unparseable condition %q", r.Name, r.Condition)
+	}
+	field, op, rhs := m[1], m[2], strings.TrimSpace(m[3])
+
+	switch field {
+	case "stage":
+		return compareString(ctx.Stage, op, unquote(rhs))
+	case "company":
+		if op == "in" && rhs == "watchlist" {
+			return containsFold(ctx.Watchlist, ctx.Company), nil
+		}
+		return compareString(ctx.Company, op, unquote(rhs))
+	case "sender_domain":
+		if op == "in" && rhs == "whitelist" {
+			return containsFold(ctx.Whitelist, ctx.SenderDomain), nil
+		}
+		return compareString(ctx.SenderDomain, op, unquote(rhs))
+	case "days_since_last_reply":
+		n, err := strconv.Atoi(rhs)
+		if err != nil {
+			return false, fmt.Errorf("rule %q: %q is not a number", r.Name, rhs)
+		}
+		return compareInt(ctx.DaysSinceLastReply, op, n)
+	default:
+		return false, fmt.Errorf("rule %q: unknown field %q", r.Name, field)
+	}
+}
+
+func compareString(got, op, want string) (bool, error) {
+	switch op {
+	case "==":
+		return strings.EqualFold(got, want), nil
+	case "!=":
+		return !strings.EqualFold(got, want), nil
+	default:
+		return false, fmt.Errorf("operator %q is not valid for a string field", op)
+	}
+}
+
+func compareInt(got int, op string, want int) (bool, error) {
+	switch op {
+	case "==":
+		return got == want, nil
+	case "!=":
+		return got != want, nil
+	case ">":
+		return got > want, nil
+	case ">=":
+		return got >= want, nil
+	case "<":
+		return got < want, nil
+	case "<=":
+		return got <= want, nil
+	default:
+		return false, fmt.Errorf("operator %q is not valid for a numeric field", op)
+	}
+}
+
+func containsFold(list []string, value string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// ThrottleStore persists the last time each named rule fired, so a
+// RuleEngine doesn't re-notify before the rule's throttle window elapses -
+// see database.NotifyRule, the implementation Tracker wires in.
+type ThrottleStore interface {
+	LastFired(ctx context.Context, ruleName string) (time.Time, bool, error)
+	RecordFired(ctx context.Context, ruleName string, at time.Time) error
+}
+
+// RuleEngine evaluates a fixed set of Rules against a RuleContext and
+// fires the matching, non-throttled ones through a Registry.
+type RuleEngine struct {
+	registry *Registry
+	rules    []Rule
+	store    ThrottleStore
+	now      func() time.Time
+}
+
+// NewRuleEngine creates a RuleEngine. store may be nil, in which case
+// throttling is skipped entirely (every match fires).
+func NewRuleEngine(registry *Registry, rules []Rule, store ThrottleStore) *RuleEngine {
+	return &RuleEngine{registry: registry, rules: rules, store: store, now: time.Now}
+}
+
+// Rules returns the engine's configured rules, e.g. for "jobsearch notify
+// test <rule>" to look one up by name without re-parsing config.
+func (e *RuleEngine) Rules() []Rule {
+	return e.rules
+}
+
+// Evaluate runs every active rule (active is the caller's responsibility -
+// see Tracker.evaluateRules, which filters against database.NotifyRule
+// before calling this) against ruleCtx, and for each one that matches and
+// isn't currently throttled, delivers event through its named notifiers
+// and records the fire time. It returns the names of rules that fired.
+func (e *RuleEngine) Evaluate(ctx context.Context, ruleCtx RuleContext, event Event) ([]string, error) {
+	var fired []string
+	var errs []error
+
+	for _, rule := range e.rules {
+		ok, err := rule.Eval(ruleCtx)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		if rule.Throttle > 0 && e.store != nil {
+			last, found, err := e.store.LastFired(ctx, rule.Name)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if found && e.now().Sub(last) < rule.Throttle {
+				continue
+			}
+		}
+
+		ruleEvent := event
+		ruleEvent.Type = EventRuleMatched
+		if ruleEvent.Message == "" {
+			ruleEvent.Message = fmt.Sprintf("rule %q matched", rule.Name)
+		}
+
+		if err := e.registry.NotifyTo(ctx, ruleEvent, rule.Notifiers); err != nil {
+			errs = append(errs, fmt.Errorf("rule %q: %w", rule.Name, err))
+			continue
+		}
+		if e.store != nil {
+			if err := e.store.RecordFired(ctx, rule.Name, e.now()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		fired = append(fired, rule.Name)
+	}
+
+	return fired, errors.Join(errs...)
+}
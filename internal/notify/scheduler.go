@@ -0,0 +1,206 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// schedulerCooldown is the minimum time between two Scheduler fires of the
+// same event for the same conversation, so a long-stale thread doesn't
+// re-notify on every sweep.
+const schedulerCooldown = 24 * time.Hour
+
+// waitingOnMeReminderAfter is how long a conversation can sit in
+// waiting_on_me before Scheduler fires EventWaitingOnMeReminder.
+const waitingOnMeReminderAfter = 24 * time.Hour
+
+// weeklySummaryInterval is how often Scheduler fires EventWeeklySummary.
+const weeklySummaryInterval = 7 * 24 * time.Hour
+
+// Scheduler periodically scans the database for conversations that have
+// crossed a time-based notification threshold that Tracker's inline
+// notify.Event calls - fired the moment Sync changes a status - wouldn't
+// otherwise catch until the next sync: gone stale, sat waiting on me too
+// long, or received new inbound mail from a whitelisted domain. Each fire
+// is skipped for a conversation currently muted (database.DB.IsMuted, see
+// the mute_notifications MCP tool) and throttled by schedulerCooldown/
+// waitingOnMeReminderAfter via database.DB's notification_fires table.
+type Scheduler struct {
+	db        *database.DB
+	registry  *Registry
+	interval  time.Duration
+	whitelist []string
+	lastSweep time.Time
+	// lastWeeklySummary is kept in-memory, like lastSweep, rather than in
+	// the notification_fires table - that table's conversation_id column
+	// has a NOT NULL foreign key into conversations, and a weekly summary
+	// isn't about any single conversation. A process restart simply fires
+	// the next summary one interval later than it otherwise would.
+	lastWeeklySummary time.Time
+	now               func() time.Time
+}
+
+// NewScheduler creates a Scheduler that sweeps the database once per
+// interval. whitelist is the set of recruiter-email domains (see
+// config.FilterConfig.DomainWhitelist) that make a new inbound email worth
+// its own notification.
+func NewScheduler(db *database.DB, registry *Registry, interval time.Duration, whitelist []string) *Scheduler {
+	return &Scheduler{db: db, registry: registry, interval: interval, whitelist: whitelist, now: time.Now}
+}
+
+// Run sweeps the database once per s.interval until ctx is canceled, the
+// same loop shape as inbound.Poller.Run.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sweep(ctx); err != nil {
+				log.Printf("notify: scheduler sweep failed: %v", err)
+			}
+		}
+	}
+}
+
+// sweep runs the three per-conversation time-based checks once, then the
+// weekly summary when weeklySummaryInterval has passed. A failure in one check
+// doesn't stop the others - each is independent, so the errors are joined
+// rather than returned on first failure.
+func (s *Scheduler) sweep(ctx context.Context) error {
+	now := s.now()
+	var errs []error
+
+	stale, err := s.db.Query(ctx, database.SearchCriteria{StaleOnly: true})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for i := range stale {
+		if err := s.maybeFire(ctx, &stale[i], EventStaleThresholdCrossed, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	waitingOnMe := database.StatusWaitingOnMe
+	waiting, err := s.db.Query(ctx, database.SearchCriteria{Status: &waitingOnMe})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for i := range waiting {
+		if now.Sub(waiting[i].LastActivityAt) < waitingOnMeReminderAfter {
+			continue
+		}
+		if err := s.maybeFire(ctx, &waiting[i], EventWaitingOnMeReminder, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	since := s.lastSweep
+	if since.IsZero() {
+		since = now.Add(-s.interval)
+	}
+	inbound := database.DirectionInbound
+	for _, domain := range s.whitelist {
+		domain := domain
+		convs, err := s.db.Query(ctx, database.SearchCriteria{Domain: &domain, Since: &since, Direction: &inbound})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for i := range convs {
+			if err := s.maybeFire(ctx, &convs[i], EventNewInboundFromWhitelistedDomain, now); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	s.lastSweep = now
+
+	if s.lastWeeklySummary.IsZero() {
+		s.lastWeeklySummary = now
+	} else if now.Sub(s.lastWeeklySummary) >= weeklySummaryInterval {
+		if err := s.fireWeeklySummary(ctx, now); err != nil {
+			errs = append(errs, err)
+		} else {
+			s.lastWeeklySummary = now
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// fireWeeklySummary tallies every tracked conversation by status plus the
+// number of upcoming interviews, and delivers the result as one
+// EventWeeklySummary with no Conversation - a roll-up of everything rather
+// than an alert about any one thread.
+func (s *Scheduler) fireWeeklySummary(ctx context.Context, now time.Time) error {
+	convs, err := s.db.Query(ctx, database.SearchCriteria{})
+	if err != nil {
+		return err
+	}
+
+	counts := map[database.ConversationStatus]int{}
+	for _, c := range convs {
+		counts[c.Status]++
+	}
+
+	upcoming, err := s.db.ListUpcomingInterviews(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf(
+		"Active: %d | Waiting on you: %d | Waiting on them: %d | Stale: %d | Interviews scheduled: %d | Upcoming interviews: %d | Closed: %d",
+		counts[database.StatusActive], counts[database.StatusWaitingOnMe], counts[database.StatusWaitingOnThem],
+		counts[database.StatusStale], counts[database.StatusInterviewScheduled], len(upcoming), counts[database.StatusClosed],
+	)
+
+	return s.registry.Notify(ctx, Event{Type: EventWeeklySummary, Message: message})
+}
+
+// maybeFire delivers event for conv through s.registry, unless conv is
+// currently muted or the same event already fired for it within the
+// relevant cooldown.
+func (s *Scheduler) maybeFire(ctx context.Context, conv *database.Conversation, eventType EventType, now time.Time) error {
+	muted, err := s.db.IsMuted(ctx, conv.ID)
+	if err != nil {
+		return err
+	}
+	if muted {
+		return nil
+	}
+
+	cooldown := schedulerCooldown
+	if eventType == EventWaitingOnMeReminder {
+		cooldown = waitingOnMeReminderAfter
+	}
+
+	lastFired, err := s.db.GetNotificationFire(ctx, conv.ID, string(eventType))
+	if err != nil {
+		return err
+	}
+	if !lastFired.IsZero() && now.Sub(lastFired) < cooldown {
+		return nil
+	}
+
+	emails, err := s.db.ListEmailsForConversation(ctx, conv.ID)
+	if err != nil {
+		return err
+	}
+	var latest *database.Email
+	if len(emails) > 0 {
+		latest = &emails[len(emails)-1]
+	}
+
+	if err := s.registry.Notify(ctx, Event{Type: eventType, Conversation: conv, Email: latest}); err != nil {
+		return err
+	}
+	return s.db.RecordNotificationFire(ctx, conv.ID, string(eventType), now)
+}
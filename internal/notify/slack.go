@@ -0,0 +1,66 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SlackNotifierConfig configures delivery of a rendered Event to a Slack
+// incoming webhook.
+type SlackNotifierConfig struct {
+	WebhookURL string
+	Template   string // optional override, see TemplateFuncs
+}
+
+// SlackNotifier posts a rendered Event to a Slack incoming webhook.
+type SlackNotifier struct {
+	name   string
+	cfg    SlackNotifierConfig
+	client *http.Client
+}
+
+// NewSlackNotifier creates a SlackNotifier with the given registry name.
+func NewSlackNotifier(name string, cfg SlackNotifierConfig) *SlackNotifier {
+	return &SlackNotifier{name: name, cfg: cfg, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Name implements Notifier.
+func (n *SlackNotifier) Name() string { return n.name }
+
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// Send implements Notifier.
+func (n *SlackNotifier) Send(ctx context.Context, event Event) error {
+	text, err := RenderPayload(event, n.cfg.Template)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(slackPayload{Text: text})
+	if err != nil {
+		return fmt.Errorf("failed to marshal slack payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to slack: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
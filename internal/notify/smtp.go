@@ -0,0 +1,101 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+)
+
+// SMTPNotifierConfig configures delivery of a rendered Event as an email
+// over SMTP.
+type SMTPNotifierConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       string
+	Template string // optional override, see TemplateFuncs
+
+	// TemplatesDir is config.NotifyConfig.TemplatesDir, threaded through so
+	// Send can render event-kind-specific text+HTML templates
+	// (RenderEvent) instead of the single-line Template/defaultTemplate
+	// payload - email is the one channel this system sends rich digest
+	// content to, so it's the one that reads this.
+	TemplatesDir string
+}
+
+// SMTPNotifier delivers an Event as an email via an SMTP relay - plain text,
+// or multipart text+HTML when RenderEvent supplies an HTML body.
+type SMTPNotifier struct {
+	name string
+	cfg  SMTPNotifierConfig
+}
+
+// NewSMTPNotifier creates an SMTPNotifier with the given registry name.
+func NewSMTPNotifier(name string, cfg SMTPNotifierConfig) *SMTPNotifier {
+	return &SMTPNotifier{name: name, cfg: cfg}
+}
+
+// Name implements Notifier.
+func (n *SMTPNotifier) Name() string { return n.name }
+
+// Send implements Notifier.
+//
+// Event types with a dedicated eventKind (interview scheduled, stale
+// conversation, rejection detected, weekly summary) render through
+// RenderEvent, so n.cfg.TemplatesDir's overrides - and its HTML variant -
+// take effect; every other event type keeps rendering through
+// RenderPayload/n.cfg.Template exactly as before.
+func (n *SMTPNotifier) Send(_ context.Context, event Event) error {
+	var rendered *EventRendered
+	if _, ok := eventKindByType[event.Type]; ok {
+		r, err := RenderEvent(event, n.cfg.TemplatesDir)
+		if err != nil {
+			return err
+		}
+		rendered = r
+	} else {
+		text, err := RenderPayload(event, n.cfg.Template)
+		if err != nil {
+			return err
+		}
+		rendered = &EventRendered{Text: text}
+	}
+
+	subject := rendered.Subject
+	if subject == "" {
+		subject = fmt.Sprintf("[jobsearch] %s", EventSummary(event))
+	}
+
+	var auth smtp.Auth
+	if n.cfg.Username != "" {
+		auth = smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)
+	}
+
+	addr := fmt.Sprintf("%s:%d", n.cfg.Host, n.cfg.Port)
+	msg := buildMIMEMessage(n.cfg.From, n.cfg.To, subject, rendered.Text, rendered.HTML)
+	if err := smtp.SendMail(addr, auth, n.cfg.From, []string{n.cfg.To}, msg); err != nil {
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+	return nil
+}
+
+// buildMIMEMessage formats an RFC 822 message. When html is empty it's a
+// plain single-part text email, same as before RenderEvent existed; when
+// both text and html are set it's multipart/alternative, so the recipient's
+// mail client picks whichever it renders best.
+func buildMIMEMessage(from, to, subject, text, html string) []byte {
+	if html == "" {
+		return []byte(fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n", from, to, subject, text))
+	}
+
+	const boundary = "jobsearch-notify-boundary"
+	return []byte(fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: multipart/alternative; boundary=%q\r\n\r\n"+
+			"--%s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s\r\n"+
+			"--%s\r\nContent-Type: text/html; charset=utf-8\r\n\r\n%s\r\n"+
+			"--%s--\r\n",
+		from, to, subject, boundary, boundary, text, boundary, html, boundary,
+	))
+}
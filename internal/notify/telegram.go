@@ -0,0 +1,70 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TelegramNotifierConfig configures delivery of a rendered Event through
+// the Telegram Bot API's sendMessage method.
+type TelegramNotifierConfig struct {
+	BotToken string
+	ChatID   string
+	Template string // optional override, see TemplateFuncs
+}
+
+// TelegramNotifier posts a rendered Event to a Telegram chat via a bot.
+type TelegramNotifier struct {
+	name   string
+	cfg    TelegramNotifierConfig
+	client *http.Client
+}
+
+// NewTelegramNotifier creates a TelegramNotifier with the given registry
+// name.
+func NewTelegramNotifier(name string, cfg TelegramNotifierConfig) *TelegramNotifier {
+	return &TelegramNotifier{name: name, cfg: cfg, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Name implements Notifier.
+func (n *TelegramNotifier) Name() string { return n.name }
+
+type telegramPayload struct {
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+// Send implements Notifier.
+func (n *TelegramNotifier) Send(ctx context.Context, event Event) error {
+	text, err := RenderPayload(event, n.cfg.Template)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(telegramPayload{ChatID: n.cfg.ChatID, Text: text})
+	if err != nil {
+		return fmt.Errorf("failed to marshal telegram payload: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", n.cfg.BotToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build telegram request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to telegram: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("telegram sendMessage returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// TemplateFuncs are the helpers exposed to a notifier's payload template,
+// so a user-supplied override (config.NotifierEntry.Template) can reuse the same
+// building blocks the default template does without the binary needing to
+// be recompiled.
+var TemplateFuncs = template.FuncMap{
+	"eventSummary": EventSummary,
+}
+
+// defaultTemplate is used when a notifier has no Template override
+// configured.
+const defaultTemplate = `{{.Conversation.Company}}: {{eventSummary .}}`
+
+// EventSummary renders a short human-readable line for event, e.g. "new
+// conversation" or "bounced (hard)".
+func EventSummary(event Event) string {
+	switch event.Type {
+	case EventConversationCreated:
+		return "new conversation"
+	case EventStatusChanged:
+		return fmt.Sprintf("status changed to %s", event.Conversation.Status)
+	case EventStaleReminder:
+		return fmt.Sprintf("stale for %d days", event.Conversation.DaysSinceActivity())
+	case EventFalsePositiveLearned:
+		return "marked as false positive"
+	case EventBounceDetected:
+		return "bounce detected"
+	case EventStaleThresholdCrossed:
+		return fmt.Sprintf("stale for %d days", event.Conversation.DaysSinceActivity())
+	case EventWaitingOnMeReminder:
+		return "still waiting on your reply after 24h"
+	case EventNewInboundFromWhitelistedDomain:
+		return "new message from a whitelisted domain"
+	case EventInterviewScheduled:
+		return "interview scheduled"
+	case EventRejectionDetected:
+		return "marked as rejected"
+	case EventWeeklySummary:
+		return "weekly summary"
+	case EventRuleMatched:
+		if event.Message != "" {
+			return event.Message
+		}
+		return "rule matched"
+	default:
+		if event.Message != "" {
+			return event.Message
+		}
+		return string(event.Type)
+	}
+}
+
+// RenderPayload renders event as text, using tmplText if non-empty or
+// defaultTemplate otherwise. tmplText is parsed with TemplateFuncs
+// available, so a custom override can call the same helpers the built-in
+// template does.
+func RenderPayload(event Event, tmplText string) (string, error) {
+	if tmplText == "" {
+		tmplText = defaultTemplate
+	}
+
+	tmpl, err := template.New("notify").Funcs(TemplateFuncs).Parse(tmplText)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse notifier template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, event); err != nil {
+		return "", fmt.Errorf("failed to render notifier template: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,74 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// dbThrottleStore implements ThrottleStore against database.DB's
+// notify_rules table.
+type dbThrottleStore struct {
+	db *database.DB
+}
+
+// NewDBThrottleStore creates a ThrottleStore backed by db.
+func NewDBThrottleStore(db *database.DB) ThrottleStore {
+	return &dbThrottleStore{db: db}
+}
+
+// LastFired implements ThrottleStore.
+func (s *dbThrottleStore) LastFired(ctx context.Context, ruleName string) (time.Time, bool, error) {
+	rule, err := s.db.GetNotifyRule(ctx, ruleName)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if rule == nil || rule.LastFiredAt == nil {
+		return time.Time{}, false, nil
+	}
+	return *rule.LastFiredAt, true, nil
+}
+
+// RecordFired implements ThrottleStore.
+func (s *dbThrottleStore) RecordFired(ctx context.Context, ruleName string, at time.Time) error {
+	return s.db.RecordNotifyRuleFired(ctx, ruleName, at)
+}
+
+// ActiveRules syncs cfg's configured rules into db (so "jobsearch rules
+// list/approve/reject" always reflects config.toml, creating new rows as
+// active by default) and returns only the ones not rejected. A rule whose
+// condition doesn't parse is dropped with an error rather than left to
+// fail silently every time RuleEngine.Evaluate runs.
+func ActiveRules(ctx context.Context, db *database.DB, cfg config.NotifyConfig) ([]Rule, error) {
+	all := BuildRules(cfg)
+
+	var active []Rule
+	var errs []error
+	for _, rule := range all {
+		if _, err := rule.Eval(RuleContext{}); err != nil {
+			errs = append(errs, fmt.Errorf("skipping invalid rule %q: %w", rule.Name, err))
+			continue
+		}
+		if err := db.UpsertNotifyRule(ctx, rule.Name, rule.Condition); err != nil {
+			errs = append(errs, fmt.Errorf("rule %q: %w", rule.Name, err))
+			continue
+		}
+		state, err := db.GetNotifyRule(ctx, rule.Name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %q: %w", rule.Name, err))
+			continue
+		}
+		if state != nil && !state.Active {
+			continue
+		}
+		active = append(active, rule)
+	}
+
+	if len(errs) > 0 {
+		return active, fmt.Errorf("%d of %d notify rules could not be loaded", len(errs), len(all))
+	}
+	return active, nil
+}
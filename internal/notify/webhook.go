@@ -0,0 +1,76 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookNotifierConfig configures delivery of an Event as a JSON POST to
+// an arbitrary HTTP endpoint.
+type WebhookNotifierConfig struct {
+	URL      string
+	Template string // optional override, see TemplateFuncs; applied to the "message" field only
+}
+
+// webhookPayload is the JSON body posted to URL: the raw event plus its
+// rendered, human-readable summary.
+type webhookPayload struct {
+	Type           EventType `json:"type"`
+	ConversationID string    `json:"conversation_id,omitempty"`
+	Message        string    `json:"message"`
+}
+
+// WebhookNotifier posts an Event as JSON to an arbitrary HTTP endpoint.
+type WebhookNotifier struct {
+	name   string
+	cfg    WebhookNotifierConfig
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a WebhookNotifier with the given registry
+// name.
+func NewWebhookNotifier(name string, cfg WebhookNotifierConfig) *WebhookNotifier {
+	return &WebhookNotifier{name: name, cfg: cfg, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Name implements Notifier.
+func (n *WebhookNotifier) Name() string { return n.name }
+
+// Send implements Notifier.
+func (n *WebhookNotifier) Send(ctx context.Context, event Event) error {
+	message, err := RenderPayload(event, n.cfg.Template)
+	if err != nil {
+		return err
+	}
+
+	payload := webhookPayload{Type: event.Type, Message: message}
+	if event.Conversation != nil {
+		payload.ConversationID = event.Conversation.ID
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// csvColumns is the stable default column set "--format csv" uses when the
+// caller hasn't overridden it via SetCSVColumns/--columns.
+var csvColumns = []string{"id", "company", "recruiter", "position", "status", "emails", "direction", "last_activity"}
+
+// SetCSVColumns overrides the CSV formatter's column set/order for the rest
+// of this process. There's no per-call Output option today - 'jobsearch
+// list --format csv --columns id,company' sets this once, the same way
+// outputFmt itself is a single global flag, before calling Output.
+func SetCSVColumns(columns []string) {
+	if len(columns) > 0 {
+		csvColumns = columns
+	}
+}
+
+func init() {
+	RegisterFormat("csv", formatterFunc(csvWrite))
+}
+
+// csvWrite flattens a conversation list to csvColumns. Unlike json/yaml/
+// ndjson, CSV only makes sense for the one genuinely tabular result type
+// these commands return - a single *database.Conversation or *Stats has no
+// natural row/column shape to flatten to.
+func csvWrite(w io.Writer, data interface{}) error {
+	convs, ok := data.([]database.Conversation)
+	if !ok {
+		return fmt.Errorf("csv output only supports a list of conversations, got %T", data)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+	for _, c := range convs {
+		row := make([]string, len(csvColumns))
+		for i, col := range csvColumns {
+			row[i] = csvField(c, col)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func csvField(c database.Conversation, column string) string {
+	switch column {
+	case "id":
+		return c.ID
+	case "company":
+		return c.Company
+	case "recruiter":
+		if c.RecruiterName != nil && *c.RecruiterName != "" {
+			return *c.RecruiterName
+		}
+		if c.RecruiterEmail != nil {
+			return *c.RecruiterEmail
+		}
+		return ""
+	case "position":
+		if c.Position != nil {
+			return *c.Position
+		}
+		return ""
+	case "status":
+		return formatStatusShort(c.Status)
+	case "emails":
+		return strconv.Itoa(c.EmailCount)
+	case "direction":
+		return string(c.Direction)
+	case "last_activity":
+		return c.LastActivityAt.Format("2006-01-02")
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,29 @@
+package output
+
+import "io"
+
+// Formatter writes data in one particular output format. Output/OutputTo
+// dispatch to whichever Formatter is registered under a --format value via
+// RegisterFormat; json.go/table.go/csv.go/yaml.go/ndjson.go each register
+// their own in an init().
+type Formatter interface {
+	Write(w io.Writer, data interface{}) error
+}
+
+// formatterFunc adapts a plain function to Formatter, the same
+// func-as-interface pattern http.HandlerFunc uses.
+type formatterFunc func(w io.Writer, data interface{}) error
+
+func (f formatterFunc) Write(w io.Writer, data interface{}) error {
+	return f(w, data)
+}
+
+var formatters = make(map[string]Formatter)
+
+// RegisterFormat makes a Formatter available under name, the value callers
+// pass as --format/Output's format argument. Registering an already-used
+// name overwrites the earlier Formatter, so a caller (e.g. a future MCP
+// tool response format) can deliberately override a built-in.
+func RegisterFormat(name string, f Formatter) {
+	formatters[name] = f
+}
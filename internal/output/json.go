@@ -30,14 +30,23 @@ func JSONCompactTo(w io.Writer, data interface{}) error {
 	return encoder.Encode(data)
 }
 
-// Output writes data in the specified format
+func init() {
+	RegisterFormat("json", formatterFunc(func(w io.Writer, data interface{}) error {
+		return JSONTo(w, data)
+	}))
+}
+
+// Output writes data in the specified format to stdout.
 func Output(format string, data interface{}) error {
-	switch format {
-	case "json":
-		return JSON(data)
-	case "table", "":
-		return Table(data)
-	default:
+	return OutputTo(os.Stdout, format, data)
+}
+
+// OutputTo writes data in the specified format to w, looking it up in the
+// Formatter registry RegisterFormat populates - see formatter.go.
+func OutputTo(w io.Writer, format string, data interface{}) error {
+	f, ok := formatters[format]
+	if !ok {
 		return fmt.Errorf("unknown output format: %s", format)
 	}
+	return f.Write(w, data)
 }
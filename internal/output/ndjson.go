@@ -0,0 +1,44 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+func init() {
+	RegisterFormat("ndjson", formatterFunc(ndjsonWrite))
+}
+
+// ndjsonWrite streams one JSON object per line. For a slice/array (the
+// common case - []database.Conversation from list/search) each element gets
+// its own line; anything else is written as a single line, same as "jobsearch
+// get" printing one object under --format json.
+func ndjsonWrite(w io.Writer, data interface{}) error {
+	encoder := json.NewEncoder(w)
+
+	if items, ok := toSlice(data); ok {
+		for i := 0; i < items.Len(); i++ {
+			if err := encoder.Encode(items.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return encoder.Encode(data)
+}
+
+func toSlice(data interface{}) (reflect.Value, bool) {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		return v, true
+	}
+	return reflect.Value{}, false
+}
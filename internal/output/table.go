@@ -11,6 +11,15 @@ import (
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
 )
 
+func init() {
+	RegisterFormat("table", formatterFunc(func(w io.Writer, data interface{}) error {
+		return TableTo(w, data)
+	}))
+	RegisterFormat("", formatterFunc(func(w io.Writer, data interface{}) error {
+		return TableTo(w, data)
+	}))
+}
+
 // Table writes data as a formatted table to stdout
 func Table(data interface{}) error {
 	return TableTo(os.Stdout, data)
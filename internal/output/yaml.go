@@ -0,0 +1,208 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterFormat("yaml", formatterFunc(yamlWrite))
+}
+
+// yamlWrite renders data as YAML. There's no third-party YAML dependency in
+// this module, so rather than hand-roll a struct-tag walker we round-trip
+// through encoding/json - marshal to JSON, unmarshal into a generic
+// interface{} tree, then emit that tree as YAML. This reuses the same
+// json struct tags every other format already honors and keeps the
+// formatter dependency-free, the same way table.go hand-rolls per-type
+// formatting instead of pulling in a generic templating library.
+func yamlWrite(w io.Writer, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	var tree interface{}
+	if err := json.Unmarshal(raw, &tree); err != nil {
+		return err
+	}
+
+	return writeYAMLNode(w, tree, 0)
+}
+
+func writeYAMLNode(w io.Writer, node interface{}, indent int) error {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			_, err := fmt.Fprintln(w, "{}")
+			return err
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := writeYAMLEntry(w, k, v[k], indent); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		if len(v) == 0 {
+			_, err := fmt.Fprintln(w, "[]")
+			return err
+		}
+		for _, item := range v {
+			if err := writeYAMLListItem(w, item, indent); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		_, err := fmt.Fprintln(w, yamlScalar(v))
+		return err
+	}
+}
+
+func writeYAMLEntry(w io.Writer, key string, value interface{}, indent int) error {
+	prefix := strings.Repeat("  ", indent)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			_, err := fmt.Fprintf(w, "%s%s: {}\n", prefix, key)
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s%s:\n", prefix, key); err != nil {
+			return err
+		}
+		return writeYAMLNode(w, v, indent+1)
+	case []interface{}:
+		if len(v) == 0 {
+			_, err := fmt.Fprintf(w, "%s%s: []\n", prefix, key)
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s%s:\n", prefix, key); err != nil {
+			return err
+		}
+		return writeYAMLNode(w, v, indent)
+	default:
+		_, err := fmt.Fprintf(w, "%s%s: %s\n", prefix, key, yamlScalar(v))
+		return err
+	}
+}
+
+func writeYAMLListItem(w io.Writer, item interface{}, indent int) error {
+	prefix := strings.Repeat("  ", indent)
+	switch v := item.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			_, err := fmt.Fprintf(w, "%s- {}\n", prefix)
+			return err
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for i, k := range keys {
+			entryPrefix := "- "
+			if i > 0 {
+				entryPrefix = "  "
+			}
+			switch val := v[k].(type) {
+			case map[string]interface{}:
+				if len(val) == 0 {
+					if _, err := fmt.Fprintf(w, "%s%s%s: {}\n", prefix, entryPrefix, k); err != nil {
+						return err
+					}
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "%s%s%s:\n", prefix, entryPrefix, k); err != nil {
+					return err
+				}
+				if err := writeYAMLNode(w, val, indent+2); err != nil {
+					return err
+				}
+			case []interface{}:
+				if len(val) == 0 {
+					if _, err := fmt.Fprintf(w, "%s%s%s: []\n", prefix, entryPrefix, k); err != nil {
+						return err
+					}
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "%s%s%s:\n", prefix, entryPrefix, k); err != nil {
+					return err
+				}
+				if err := writeYAMLNode(w, val, indent+2); err != nil {
+					return err
+				}
+			default:
+				if _, err := fmt.Fprintf(w, "%s%s%s: %s\n", prefix, entryPrefix, k, yamlScalar(val)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case []interface{}:
+		if len(v) == 0 {
+			_, err := fmt.Fprintf(w, "%s- []\n", prefix)
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s-\n", prefix); err != nil {
+			return err
+		}
+		return writeYAMLNode(w, v, indent+1)
+	default:
+		_, err := fmt.Fprintf(w, "%s- %s\n", prefix, yamlScalar(v))
+		return err
+	}
+}
+
+func yamlScalar(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return yamlQuoteString(val)
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		if val == float64(int64(val)) {
+			return strconv.FormatInt(int64(val), 10)
+		}
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+func yamlQuoteString(s string) string {
+	if s == "" {
+		return `""`
+	}
+	needsQuote := false
+	switch s {
+	case "null", "true", "false", "~":
+		needsQuote = true
+	}
+	if !needsQuote {
+		for _, r := range s {
+			if strings.ContainsRune(":#-[]{}&*!|>'\"%@`\n\t", r) {
+				needsQuote = true
+				break
+			}
+		}
+	}
+	if !needsQuote && (s[0] == ' ' || s[len(s)-1] == ' ') {
+		needsQuote = true
+	}
+	if !needsQuote {
+		return s
+	}
+	return strconv.Quote(s)
+}
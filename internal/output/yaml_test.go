@@ -0,0 +1,64 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestYAMLWrite_EmptyCollectionsStayInline(t *testing.T) {
+	data := []map[string]interface{}{
+		{
+			"id":   "conv-1",
+			"tags": []string{},
+			"meta": map[string]string{},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := yamlWrite(&buf, data); err != nil {
+		t.Fatalf("yamlWrite failed: %v", err)
+	}
+
+	want := "- id: \"conv-1\"\n  meta: {}\n  tags: []\n"
+	if buf.String() != want {
+		t.Errorf("yamlWrite() =\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestYAMLWrite_NestedEmptyList(t *testing.T) {
+	data := []interface{}{
+		[]interface{}{},
+		[]interface{}{"a"},
+	}
+
+	var buf bytes.Buffer
+	if err := yamlWrite(&buf, data); err != nil {
+		t.Fatalf("yamlWrite failed: %v", err)
+	}
+
+	want := "- []\n-\n  - a\n"
+	if buf.String() != want {
+		t.Errorf("yamlWrite() =\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestYAMLWrite_ScalarsAndQuoting(t *testing.T) {
+	data := map[string]interface{}{
+		"count":   3,
+		"rate":    1.5,
+		"enabled": true,
+		"missing": nil,
+		"plain":   "Acme",
+		"tricky":  "yes: maybe",
+	}
+
+	var buf bytes.Buffer
+	if err := yamlWrite(&buf, data); err != nil {
+		t.Fatalf("yamlWrite failed: %v", err)
+	}
+
+	want := "count: 3\nenabled: true\nmissing: null\nplain: Acme\nrate: 1.5\ntricky: \"yes: maybe\"\n"
+	if buf.String() != want {
+		t.Errorf("yamlWrite() =\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,113 @@
+// Package reminder schedules one-off follow-ups against a conversation
+// ("remind me in 3d if no reply") and fires them through a pluggable Sink
+// once due, independent of sync - a reminder's fire_at is arbitrary
+// wall-clock time, not something a sync run touches. Model and sweep loop
+// mirror notify.Scheduler.
+package reminder
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// Sink delivers one due Reminder. Implementations are narrow (one Fire
+// method, like notify.Notifier and tracker.ReplySender) so each delivery
+// path can be exercised without the others.
+type Sink interface {
+	Fire(ctx context.Context, r database.Reminder, conv *database.Conversation) error
+}
+
+// Manager sweeps the database for due reminders on a tick and dispatches
+// each to the Sink registered for its Action.
+type Manager struct {
+	db       *database.DB
+	sinks    map[database.ReminderAction]Sink
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewManager creates a Manager that sweeps for due reminders once per
+// interval, with DesktopSink registered by default (the other actions need
+// an explicit RegisterSink call since they need a provider/config Manager
+// doesn't own).
+func NewManager(db *database.DB, interval time.Duration) *Manager {
+	return &Manager{
+		db:       db,
+		sinks:    map[database.ReminderAction]Sink{database.ReminderActionDesktop: NewDesktopSink()},
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// RegisterSink wires sink as the delivery path for action, replacing any
+// existing registration (e.g. the default DesktopSink).
+func (m *Manager) RegisterSink(action database.ReminderAction, sink Sink) {
+	m.sinks[action] = sink
+}
+
+// Run sweeps the database once per m.interval until ctx is canceled, the
+// same loop shape as notify.Scheduler.Run.
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.Sweep(ctx); err != nil {
+				log.Printf("reminder: sweep failed: %v", err)
+			}
+		}
+	}
+}
+
+// Sweep pops every reminder due at or before now and dispatches it. A
+// reminder whose AnchorStatus no longer matches the conversation's current
+// status is cancelled instead of fired - the conversation moved on (e.g.
+// the other side already replied) since the reminder was scheduled, so
+// firing it would be stale noise. Exported so "jobsearch reminders" or a
+// one-off cron invocation can force a sweep without waiting for Run's
+// ticker.
+func (m *Manager) Sweep(ctx context.Context) error {
+	due, err := m.db.ListDueReminders(ctx, m.now())
+	if err != nil {
+		return err
+	}
+
+	for _, r := range due {
+		conv, err := m.db.GetConversation(ctx, r.ConversationID)
+		if err != nil || conv == nil {
+			log.Printf("reminder: skipping %s, conversation %s not found", r.ID, r.ConversationID)
+			continue
+		}
+
+		if r.AnchorStatus != "" && conv.Status != r.AnchorStatus {
+			if err := m.db.CancelReminder(ctx, r.ID); err != nil {
+				log.Printf("reminder: failed to auto-cancel %s: %v", r.ID, err)
+			}
+			continue
+		}
+
+		sink, ok := m.sinks[r.Action]
+		if !ok {
+			log.Printf("reminder: no sink registered for action %q, skipping %s", r.Action, r.ID)
+			continue
+		}
+
+		if err := sink.Fire(ctx, r, conv); err != nil {
+			log.Printf("reminder: failed to fire %s via %s: %v", r.ID, r.Action, err)
+			continue
+		}
+
+		if err := m.db.MarkReminderFired(ctx, r.ID); err != nil {
+			log.Printf("reminder: failed to mark %s fired: %v", r.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package reminder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/templates"
+)
+
+// DesktopSink writes a due reminder to the standard logger, the same
+// stand-in notify.LogNotifier uses in place of an actual OS-level
+// notification - there's no GUI/notify-send integration in this codebase
+// to deliver one through.
+type DesktopSink struct{}
+
+// NewDesktopSink creates a DesktopSink.
+func NewDesktopSink() *DesktopSink { return &DesktopSink{} }
+
+// Fire implements Sink.
+func (s *DesktopSink) Fire(_ context.Context, r database.Reminder, conv *database.Conversation) error {
+	note := r.Note
+	if note == "" {
+		note = "follow up"
+	}
+	log.Printf("[reminder] %s: %s (conversation %s)", conv.Company, note, conv.ID)
+	return nil
+}
+
+// webhookParams is the shape reminder.Params decodes into for a
+// "webhook" action reminder.
+type webhookParams struct {
+	URL string `json:"url"`
+}
+
+// WebhookSink posts a due reminder as JSON to the URL in its params,
+// mirroring notify.WebhookNotifier but per-reminder rather than
+// per-registry-entry.
+type WebhookSink struct {
+	client *http.Client
+}
+
+// NewWebhookSink creates a WebhookSink.
+func NewWebhookSink() *WebhookSink {
+	return &WebhookSink{client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type webhookPayload struct {
+	ReminderID     string `json:"reminder_id"`
+	ConversationID string `json:"conversation_id"`
+	Company        string `json:"company"`
+	Note           string `json:"note,omitempty"`
+}
+
+// Fire implements Sink.
+func (s *WebhookSink) Fire(ctx context.Context, r database.Reminder, conv *database.Conversation) error {
+	var params webhookParams
+	if err := json.Unmarshal([]byte(r.Params), &params); err != nil {
+		return fmt.Errorf("invalid webhook params: %w", err)
+	}
+	if params.URL == "" {
+		return fmt.Errorf("webhook reminder %s has no url", r.ID)
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		ReminderID:     r.ID,
+		ConversationID: conv.ID,
+		Company:        conv.Company,
+		Note:           r.Note,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, params.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// draftReplyParams is the shape reminder.Params decodes into for a
+// "draft-reply" action reminder.
+type draftReplyParams struct {
+	Template string `json:"template"`
+	UserName string `json:"user_name,omitempty"`
+}
+
+// DraftReplySink renders one of templates.Render's built-in reply
+// templates against the conversation's latest email and sends it through
+// ReplySender - the same path "jobsearch draft" uses. There's no Gmail
+// Drafts-API integration in this codebase (email.ReplySender sends
+// immediately), so this sends the reply rather than leaving it as an
+// actual Gmail draft.
+type DraftReplySink struct {
+	db          *database.DB
+	cfg         config.TemplatesConfig
+	replySender email.ReplySender
+}
+
+// NewDraftReplySink creates a DraftReplySink that renders against cfg and
+// sends through replySender, materializing cfg's default template files if
+// they don't already exist (same as "jobsearch draft").
+func NewDraftReplySink(db *database.DB, cfg config.TemplatesConfig, replySender email.ReplySender) *DraftReplySink {
+	if err := templates.EnsureDefaults(cfg); err != nil {
+		log.Printf("reminder: failed to materialize default templates: %v", err)
+	}
+	return &DraftReplySink{db: db, cfg: cfg, replySender: replySender}
+}
+
+// Fire implements Sink.
+func (s *DraftReplySink) Fire(ctx context.Context, r database.Reminder, conv *database.Conversation) error {
+	var params draftReplyParams
+	if err := json.Unmarshal([]byte(r.Params), &params); err != nil {
+		return fmt.Errorf("invalid draft-reply params: %w", err)
+	}
+	templateName := params.Template
+	if templateName == "" {
+		templateName = string(templates.FollowUp)
+	}
+
+	emails, err := s.db.ListEmailsForConversation(ctx, conv.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load emails: %w", err)
+	}
+	if len(emails) == 0 {
+		return fmt.Errorf("conversation %s has no emails to reply to", conv.ID)
+	}
+	latest := emails[len(emails)-1]
+
+	rendered, err := templates.Render(templates.Name(templateName), s.cfg, conv, &latest, params.UserName)
+	if err != nil {
+		return err
+	}
+
+	return s.replySender.SendReply(ctx, conv.ID, rendered.Text, emailFromDB(latest))
+}
+
+// emailFromDB converts a stored database.Email into the minimal
+// email.Email SendReply needs for threading, mirroring cli.emailFromDB.
+func emailFromDB(e database.Email) email.Email {
+	headers := make(map[string]string)
+	if e.MessageID != nil {
+		headers["Message-ID"] = "<" + *e.MessageID + ">"
+	}
+	if refs, err := e.GetReferences(); err == nil && len(refs) > 0 {
+		wrapped := make([]string, len(refs))
+		for i, r := range refs {
+			wrapped[i] = "<" + r + ">"
+		}
+		headers["References"] = strings.Join(wrapped, " ")
+	}
+
+	subject := ""
+	if e.Subject != nil {
+		subject = *e.Subject
+	}
+	fromName := ""
+	if e.FromName != nil {
+		fromName = *e.FromName
+	}
+
+	return email.Email{
+		ID:       e.GmailID,
+		ThreadID: e.ThreadID,
+		Subject:  subject,
+		From:     email.Address{Name: fromName, Email: e.FromAddress},
+		Headers:  headers,
+	}
+}
@@ -0,0 +1,73 @@
+// Package search defines a single, backend-neutral query description -
+// Criteria - that both a SQL query builder (database.QueryEmails) and an
+// in-memory matcher (filter.Match) can drive from the same struct, so an
+// MCP caller can express one non-trivial query ("waiting_on_them at
+// fintech companies with confidence > 0.7 in the last 14 days") without
+// needing to know whether it'll run against the database or against a
+// batch of emails already in memory.
+//
+// Criteria fields deliberately use plain strings rather than
+// database.ConversationStatus/Direction or filter.Layer: those packages
+// would otherwise have to import each other through this one (database
+// and filter need to consume Criteria, so Criteria can't depend back on
+// either). Callers pass the same string values those types already use,
+// e.g. "waiting_on_them", "outbound", "whitelist".
+package search
+
+import "time"
+
+// Criteria is a multi-valued, composable query description. Every slice
+// field is OR-ed internally and AND-ed against the other fields: e.g.
+// Companies: ["acme", "initech"], Statuses: ["waiting_on_them"] matches
+// conversations at either company that are also waiting_on_them.
+type Criteria struct {
+	Companies  []string `json:"companies,omitempty"`
+	Recruiters []string `json:"recruiters,omitempty"`
+	// Statuses holds database.ConversationStatus values (e.g.
+	// "waiting_on_them", "stale").
+	Statuses []string `json:"statuses,omitempty"`
+	// Directions holds database.Direction values ("inbound"/"outbound").
+	Directions      []string `json:"directions,omitempty"`
+	SubjectContains []string `json:"subject_contains,omitempty"`
+	BodyContains    []string `json:"body_contains,omitempty"`
+	// ClassificationIn holds emails.classification values, e.g.
+	// "whitelist", "keyword", "llm".
+	ClassificationIn []string   `json:"classification_in,omitempty"`
+	ConfidenceMin    *float64   `json:"confidence_min,omitempty"`
+	ConfidenceMax    *float64   `json:"confidence_max,omitempty"`
+	DateAfter        *time.Time `json:"date_after,omitempty"`
+	DateBefore       *time.Time `json:"date_before,omitempty"`
+	// HasPosition filters on whether conversations.position is set, nil
+	// means don't filter on it either way.
+	HasPosition *bool `json:"has_position,omitempty"`
+	// Layers holds filter.Layer values, e.g. "whitelist", "keyword",
+	// "bayes" - which filtering layer made the include/exclude decision.
+	Layers []string `json:"layers,omitempty"`
+
+	// SortBy is a field name the backend understands, e.g. "last_activity_at"
+	// or "confidence". An unrecognized value falls back to that backend's
+	// default order rather than erroring.
+	SortBy   string `json:"sort_by,omitempty"`
+	SortDesc bool   `json:"sort_desc,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+	Offset   int    `json:"offset,omitempty"`
+}
+
+// IsZero reports whether c has no predicates set at all (sort/pagination
+// fields don't count), so callers can tell "match everything" apart from
+// "match nothing" without comparing every field by hand.
+func (c Criteria) IsZero() bool {
+	return len(c.Companies) == 0 &&
+		len(c.Recruiters) == 0 &&
+		len(c.Statuses) == 0 &&
+		len(c.Directions) == 0 &&
+		len(c.SubjectContains) == 0 &&
+		len(c.BodyContains) == 0 &&
+		len(c.ClassificationIn) == 0 &&
+		c.ConfidenceMin == nil &&
+		c.ConfidenceMax == nil &&
+		c.DateAfter == nil &&
+		c.DateBefore == nil &&
+		c.HasPosition == nil &&
+		len(c.Layers) == 0
+}
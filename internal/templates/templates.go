@@ -0,0 +1,212 @@
+// Package templates renders outbound reply messages (follow-up, nudge,
+// decline, thank-you) from {variable}-style placeholders, resolved from a
+// Conversation and its latest Email, into both a plaintext and an HTML
+// body ready to hand to an email.Provider's Send.
+//
+// Placeholders are plain "{name}" substrings rather than Go's text/template
+// syntax, since these files are meant to be hand-edited by a
+// non-programmer user overriding config.TemplatesConfig - there's no
+// control flow to learn, just fill-in-the-blank fields.
+package templates
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+//go:embed defaults/*.txt defaults/*.html
+var defaultsFS embed.FS
+
+// Name identifies one of the four built-in templates.
+type Name string
+
+const (
+	FollowUp Name = "follow_up"
+	Nudge    Name = "nudge"
+	Decline  Name = "decline"
+	ThankYou Name = "thank_you"
+)
+
+// Rendered is a template's output, ready to send or to preview.
+type Rendered struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// paths returns name's configured (text, html) override paths from cfg.
+func paths(cfg config.TemplatesConfig, name Name) (text, html string, err error) {
+	switch name {
+	case FollowUp:
+		return cfg.FollowUpText, cfg.FollowUpHTML, nil
+	case Nudge:
+		return cfg.NudgeText, cfg.NudgeHTML, nil
+	case Decline:
+		return cfg.DeclineText, cfg.DeclineHTML, nil
+	case ThankYou:
+		return cfg.ThankYouText, cfg.ThankYouHTML, nil
+	default:
+		return "", "", fmt.Errorf("unknown template %q: expected follow_up, nudge, decline, or thank_you", name)
+	}
+}
+
+// EnsureDefaults materializes the built-in template for every path in cfg
+// that doesn't already exist on disk, so a fresh install has working
+// templates a user can find and edit in place instead of discovering them
+// buried in the binary.
+func EnsureDefaults(cfg config.TemplatesConfig) error {
+	for _, name := range []Name{FollowUp, Nudge, Decline, ThankYou} {
+		text, html, err := paths(cfg, name)
+		if err != nil {
+			return err
+		}
+		if err := materialize(text, string(name)+".txt"); err != nil {
+			return err
+		}
+		if err := materialize(html, string(name)+".html"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func materialize(path, defaultFile string) error {
+	if path == "" {
+		return nil
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+
+	content, err := defaultsFS.ReadFile("defaults/" + defaultFile)
+	if err != nil {
+		return fmt.Errorf("no built-in default for %s: %w", defaultFile, err)
+	}
+
+	if err := os.MkdirAll(pathDir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create template directory: %w", err)
+	}
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		return fmt.Errorf("failed to write default template %s: %w", path, err)
+	}
+	return nil
+}
+
+func pathDir(path string) string {
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i]
+	}
+	return "."
+}
+
+// Render loads name's text and HTML templates (the configured override if
+// its path exists, the built-in default otherwise) and substitutes
+// {company}, {recruiter_name}, {position}, {days_since_activity},
+// {last_subject}, and {user_name} from conv, latestEmail, and userName.
+// The text variant's first line is treated as "Subject: ..." and split
+// off into Rendered.Subject; the HTML variant has no subject line.
+func Render(name Name, cfg config.TemplatesConfig, conv *database.Conversation, latestEmail *database.Email, userName string) (*Rendered, error) {
+	textPath, htmlPath, err := paths(cfg, name)
+	if err != nil {
+		return nil, err
+	}
+
+	textSrc, err := load(textPath, string(name)+".txt")
+	if err != nil {
+		return nil, err
+	}
+	htmlSrc, err := load(htmlPath, string(name)+".html")
+	if err != nil {
+		return nil, err
+	}
+
+	vars := templateVars(conv, latestEmail, userName)
+	subject, body := splitSubject(substitute(textSrc, vars))
+
+	return &Rendered{
+		Subject: subject,
+		Text:    body,
+		HTML:    substitute(htmlSrc, vars),
+	}, nil
+}
+
+func load(path, defaultFile string) (string, error) {
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err == nil {
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("failed to read template %s: %w", path, err)
+		}
+	}
+
+	data, err := defaultsFS.ReadFile("defaults/" + defaultFile)
+	if err != nil {
+		return "", fmt.Errorf("no built-in default for %s: %w", defaultFile, err)
+	}
+	return string(data), nil
+}
+
+// templateVars assembles the {variable} substitution set out of conv and
+// latestEmail.
+func templateVars(conv *database.Conversation, latestEmail *database.Email, userName string) map[string]string {
+	recruiterName := "there"
+	if conv.RecruiterName != nil && *conv.RecruiterName != "" {
+		recruiterName = *conv.RecruiterName
+	}
+	position := "the role"
+	if conv.Position != nil && *conv.Position != "" {
+		position = *conv.Position
+	}
+	lastSubject := ""
+	if latestEmail != nil && latestEmail.Subject != nil {
+		lastSubject = *latestEmail.Subject
+	}
+
+	return map[string]string{
+		"company":             conv.Company,
+		"recruiter_name":      recruiterName,
+		"position":            position,
+		"days_since_activity": strconv.Itoa(conv.DaysSinceActivity()),
+		"last_subject":        lastSubject,
+		"user_name":           userName,
+	}
+}
+
+// substitute replaces every "{name}" in src with vars[name], leaving
+// unrecognized placeholders untouched so a typo in an override shows up
+// literally instead of silently vanishing.
+func substitute(src string, vars map[string]string) string {
+	out := src
+	for name, value := range vars {
+		out = strings.ReplaceAll(out, "{"+name+"}", value)
+	}
+	return out
+}
+
+// splitSubject pulls a leading "Subject: ..." line off text (the
+// convention all four built-in .txt templates follow), returning the
+// remaining body with the blank line that follows it trimmed too. Text with
+// no such line is returned unchanged with an empty subject.
+func splitSubject(text string) (subject, body string) {
+	const prefix = "Subject: "
+	if !strings.HasPrefix(text, prefix) {
+		return "", text
+	}
+	nl := strings.IndexByte(text, '\n')
+	if nl < 0 {
+		return strings.TrimPrefix(text, prefix), ""
+	}
+	subject = strings.TrimSpace(strings.TrimPrefix(text[:nl], prefix))
+	rest := strings.TrimPrefix(text[nl+1:], "\n")
+	return subject, rest
+}
@@ -0,0 +1,180 @@
+// Package threading implements the JWZ (Jamie Zawinski) email threading
+// algorithm: given a set of messages' Message-ID/In-Reply-To/References
+// headers, it reconstructs the reply trees ("threads") those headers
+// describe. internal/tracker uses it to group emails into conversations by
+// their actual reply chain instead of (or in addition to) Gmail's thread ID
+// and company-name matching, which both miss cases like a recruiter CCing a
+// new address mid-thread or forwarding a message from a different account.
+package threading
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Message is the threading-relevant subset of an email: its own Message-ID
+// plus the In-Reply-To/References headers describing its ancestors.
+type Message struct {
+	ID         string // Message-ID, normalized (no surrounding <>)
+	InReplyTo  string // Message-ID this replies to, if any
+	References []string
+	Subject    string
+	Date       time.Time
+}
+
+// Container is a node in the tree JWZ builds. A container with a nil
+// Message is an "empty" placeholder standing in for an ancestor that was
+// referenced but never actually seen.
+type Container struct {
+	ID       string
+	Message  *Message
+	Parent   *Container
+	Children []*Container
+}
+
+// BuildThreads runs the two-pass JWZ algorithm over msgs and returns the
+// resulting root containers (messages with no known parent, plus the
+// synthetic empty containers standing in for missing ancestors).
+func BuildThreads(msgs []Message) []*Container {
+	byID := make(map[string]*Container, len(msgs))
+
+	getContainer := func(id string) *Container {
+		if c, ok := byID[id]; ok {
+			return c
+		}
+		c := &Container{ID: id}
+		byID[id] = c
+		return c
+	}
+
+	// Pass 1: create a container for every message (filling in its
+	// Message if we have one) and thread it under its full ancestor
+	// chain, creating empty placeholder containers for ancestors we
+	// haven't seen a message for.
+	for i := range msgs {
+		m := &msgs[i]
+		if m.ID == "" {
+			continue
+		}
+		c := getContainer(m.ID)
+		c.Message = m
+
+		var prev *Container
+		for _, ancestorID := range ancestorChain(m) {
+			if ancestorID == "" || ancestorID == m.ID {
+				continue
+			}
+			cur := getContainer(ancestorID)
+			if prev != nil {
+				addChild(prev, cur)
+			}
+			prev = cur
+		}
+		if prev != nil {
+			addChild(prev, c)
+		}
+	}
+
+	// Pass 2: collect roots (containers with no parent).
+	var roots []*Container
+	for _, c := range byID {
+		if c.Parent == nil {
+			roots = append(roots, c)
+		}
+	}
+	return roots
+}
+
+// ancestorChain returns m's ancestor Message-IDs, oldest first, preferring
+// the full References header and falling back to a single-element chain
+// from In-Reply-To when References is unavailable (some forwarded or
+// mangled messages drop one but not the other).
+func ancestorChain(m *Message) []string {
+	if len(m.References) > 0 {
+		return m.References
+	}
+	if m.InReplyTo != "" {
+		return []string{m.InReplyTo}
+	}
+	return nil
+}
+
+// addChild attaches child under parent unless child already has a parent
+// (an earlier, presumably more specific, link wins) or doing so would
+// create a cycle - either because parent already descends from child, or
+// (the mirror case, from two messages whose headers reference each other)
+// child already has parent as one of its ancestors.
+func addChild(parent, child *Container) {
+	if parent == child || child.Parent != nil || isAncestor(child, parent) || isAncestor(parent, child) {
+		return
+	}
+	child.Parent = parent
+	parent.Children = append(parent.Children, child)
+}
+
+// isAncestor reports whether candidate is somewhere above node in the tree.
+func isAncestor(node, candidate *Container) bool {
+	for p := node.Parent; p != nil; p = p.Parent {
+		if p == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+// Root walks up from c to its topmost ancestor.
+func Root(c *Container) *Container {
+	for c.Parent != nil {
+		c = c.Parent
+	}
+	return c
+}
+
+var subjectPrefix = regexp.MustCompile(`(?i)^(re|fwd?)\s*:\s*`)
+
+// NormalizeSubject strips repeated Re:/Fwd:/Fw: reply prefixes and
+// surrounding whitespace, so "Re: Re: Fwd: Backend Engineer role" and
+// "Backend Engineer role" are recognized as the same thread subject.
+func NormalizeSubject(subject string) string {
+	s := strings.TrimSpace(subject)
+	for {
+		trimmed := subjectPrefix.ReplaceAllString(s, "")
+		trimmed = strings.TrimSpace(trimmed)
+		if trimmed == s {
+			return s
+		}
+		s = trimmed
+	}
+}
+
+var messageIDToken = regexp.MustCompile(`<[^<>]+>`)
+
+// ParseMessageIDs extracts every "<...>" token from a raw header value
+// (e.g. a References header listing several ancestors), in order,
+// stripping the angle brackets.
+func ParseMessageIDs(header string) []string {
+	matches := messageIDToken.FindAllString(header, -1)
+	ids := make([]string, len(matches))
+	for i, m := range matches {
+		ids[i] = strings.Trim(m, "<>")
+	}
+	return ids
+}
+
+// SynthesizeMessageID derives a stable, deterministic Message-ID for
+// messages that arrived without one (seen from some providers and
+// malformed forwards), so they can still be threaded and deduplicated
+// across syncs. It is a pure function of the inputs, not wall-clock time,
+// so re-running it for the same email always yields the same ID.
+func SynthesizeMessageID(fromAddress string, date time.Time, subject string) string {
+	h := sha256.New()
+	h.Write([]byte(strings.ToLower(strings.TrimSpace(fromAddress))))
+	h.Write([]byte("|"))
+	h.Write([]byte(date.UTC().Format(time.RFC3339)))
+	h.Write([]byte("|"))
+	h.Write([]byte(NormalizeSubject(subject)))
+	return hex.EncodeToString(h.Sum(nil))[:24] + "@synthesized.jobsearch-mcp.local"
+}
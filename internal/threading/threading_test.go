@@ -0,0 +1,134 @@
+package threading
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildThreads(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		msgs      []Message
+		wantRoots int
+		wantChild map[string]string // childID -> expected root ID
+	}{
+		{
+			name:      "single message has no parent",
+			msgs:      []Message{{ID: "a", Date: base}},
+			wantRoots: 1,
+		},
+		{
+			name: "direct reply links via in-reply-to",
+			msgs: []Message{
+				{ID: "a", Date: base},
+				{ID: "b", InReplyTo: "a", References: []string{"a"}, Date: base.Add(time.Hour)},
+			},
+			wantRoots: 1,
+			wantChild: map[string]string{"b": "a"},
+		},
+		{
+			name: "full references chain threads through a missing ancestor",
+			msgs: []Message{
+				{ID: "a", Date: base},
+				// "b" is never observed directly, only referenced.
+				{ID: "c", InReplyTo: "b", References: []string{"a", "b"}, Date: base.Add(2 * time.Hour)},
+			},
+			wantRoots: 1,
+			wantChild: map[string]string{"c": "a"},
+		},
+		{
+			name: "unrelated messages form separate roots",
+			msgs: []Message{
+				{ID: "a", Date: base},
+				{ID: "b", Date: base.Add(time.Hour)},
+			},
+			wantRoots: 2,
+		},
+		{
+			name: "a cyclical references header does not loop",
+			msgs: []Message{
+				{ID: "a", InReplyTo: "b", References: []string{"b"}, Date: base},
+				{ID: "b", InReplyTo: "a", References: []string{"a"}, Date: base.Add(time.Hour)},
+			},
+			wantRoots: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roots := BuildThreads(tt.msgs)
+			if len(roots) != tt.wantRoots {
+				t.Fatalf("got %d roots, want %d", len(roots), tt.wantRoots)
+			}
+
+			byID := make(map[string]*Container)
+			var collect func(*Container)
+			collect = func(c *Container) {
+				byID[c.ID] = c
+				for _, child := range c.Children {
+					collect(child)
+				}
+			}
+			for _, r := range roots {
+				collect(r)
+			}
+
+			for childID, wantRootID := range tt.wantChild {
+				c, ok := byID[childID]
+				if !ok {
+					t.Fatalf("container %q not found in tree", childID)
+				}
+				if got := Root(c).ID; got != wantRootID {
+					t.Errorf("Root(%q) = %q, want %q", childID, got, wantRootID)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeSubject(t *testing.T) {
+	tests := []struct {
+		subject string
+		want    string
+	}{
+		{"Backend Engineer role", "Backend Engineer role"},
+		{"Re: Backend Engineer role", "Backend Engineer role"},
+		{"RE: Re: Fwd: Backend Engineer role", "Backend Engineer role"},
+		{"  Fw: Backend Engineer role  ", "Backend Engineer role"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeSubject(tt.subject); got != tt.want {
+			t.Errorf("NormalizeSubject(%q) = %q, want %q", tt.subject, got, tt.want)
+		}
+	}
+}
+
+func TestParseMessageIDs(t *testing.T) {
+	header := "<a@x.com> <b@x.com>  <c@x.com>"
+	got := ParseMessageIDs(header)
+	want := []string{"a@x.com", "b@x.com", "c@x.com"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d ids, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("id[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSynthesizeMessageIDIsStable(t *testing.T) {
+	date := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	a := SynthesizeMessageID("recruiter@example.com", date, "Re: Backend Engineer role")
+	b := SynthesizeMessageID("recruiter@example.com", date, "Backend Engineer role")
+	if a != b {
+		t.Errorf("SynthesizeMessageID should ignore reply prefixes when normalizing subject: %q != %q", a, b)
+	}
+
+	c := SynthesizeMessageID("other@example.com", date, "Backend Engineer role")
+	if a == c {
+		t.Errorf("SynthesizeMessageID should differ for a different sender")
+	}
+}
@@ -0,0 +1,283 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// reasonTokenCount is how many of an Explain result's top tokens are named
+// in bayesExclusionReason - enough to be useful in a one-line Reason
+// without turning into a dump of the whole token list.
+const reasonTokenCount = 5
+
+// interestingTokenCount caps how many of an email's tokens actually
+// contribute to its score, Paul Graham-style: most words in an email
+// (greetings, signatures, boilerplate) sit close to p=0.5 and carry no
+// signal either way, so keeping only the tokens furthest from neutral
+// avoids them diluting the handful that do.
+const interestingTokenCount = 15
+
+// bodyTokenChars bounds how much of the email body is tokenized, since the
+// first few hundred characters carry most of the classification signal and
+// tokenizing whole bodies is wasted work.
+const bodyTokenChars = 300
+
+// minTokenLength drops tokens too short to carry signal (and punctuation fragments)
+const minTokenLength = 3
+
+var tokenPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+var stopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "are": true, "you": true,
+	"your": true, "with": true, "that": true, "this": true, "from": true,
+	"have": true, "was": true, "were": true, "been": true, "will": true,
+	"can": true, "has": true, "not": true, "but": true, "all": true,
+	"our": true, "out": true, "about": true, "into": true, "they": true,
+}
+
+// BayesClassifier is a Naive Bayes text classifier that learns per-token
+// good/junk probabilities from emails the Learner has already seen, via
+// LearnFromEmail and LearnFromFeedback. It replaces the fixed substring
+// lists that previously gated filter suggestions at a single confidence
+// threshold.
+type BayesClassifier struct {
+	db *database.DB
+}
+
+// NewBayesClassifier creates a classifier backed by the given database
+func NewBayesClassifier(db *database.DB) *BayesClassifier {
+	return &BayesClassifier{db: db}
+}
+
+// tokenize lowercases and splits subject + sender domain + snippet + the
+// first bodyTokenChars of the body into a deduplicated token set, dropping
+// stopwords and very short tokens. The snippet carries signal even when
+// body is empty (it's often omitted for privacy), so it's included
+// unconditionally rather than just as a body fallback.
+func tokenize(subject, domain, snippet, body string) []string {
+	if len(body) > bodyTokenChars {
+		body = body[:bodyTokenChars]
+	}
+
+	text := strings.ToLower(subject + " " + domain + " " + snippet + " " + body)
+	raw := tokenPattern.Split(text, -1)
+
+	seen := make(map[string]bool, len(raw))
+	var tokens []string
+	for _, tok := range raw {
+		if len(tok) < minTokenLength || stopwords[tok] || seen[tok] {
+			continue
+		}
+		seen[tok] = true
+		tokens = append(tokens, tok)
+	}
+	return tokens
+}
+
+// tokenizeEmail tokenizes a provider-agnostic email
+func tokenizeEmail(e *email.Email) []string {
+	return tokenize(e.Subject, e.Domain(), e.Snippet, e.Body)
+}
+
+// Train updates the token buckets and class totals for the given class
+// ("good" or "junk"). Training the same message twice (e.g. a sync retry,
+// or feedback revisiting an already-learned email) is a no-op: db.MarkSeen
+// records the message ID exactly, so repeated calls don't inflate
+// probabilities.
+func (b *BayesClassifier) Train(ctx context.Context, e *email.Email, class string) error {
+	total, err := b.db.GetBayesClassTotal(ctx, class)
+	if err != nil {
+		return err
+	}
+
+	isNew, err := b.db.MarkSeen(ctx, "bayes:"+class, e.ID)
+	if err != nil {
+		return err
+	}
+	if !isNew {
+		return nil
+	}
+
+	for _, tok := range tokenizeEmail(e) {
+		if err := b.db.IncrementBayesToken(ctx, tok, class); err != nil {
+			return err
+		}
+	}
+	total.MessageCount++
+
+	return b.db.SaveBayesClassTotal(ctx, class, total.MessageCount)
+}
+
+// Classify scores an email against the trained good/junk models and returns
+// the normalized probability that it belongs to the "good" (job-related)
+// class, along with the predicted label.
+func (b *BayesClassifier) Classify(ctx context.Context, e *email.Email) (score float64, label string, err error) {
+	score, label, _, err = b.score(ctx, e)
+	return score, label, err
+}
+
+// TokenContribution describes one token's effect on a Classify/Explain
+// result. LogOdds is the token's log(good) - log(junk) term: positive
+// pushes the score toward "good", negative toward "junk".
+type TokenContribution struct {
+	Token     string  `json:"token"`
+	GoodCount int     `json:"good_count"`
+	JunkCount int     `json:"junk_count"`
+	LogOdds   float64 `json:"log_odds"`
+}
+
+// ExplainResult is the per-token breakdown behind a Classify call, for
+// debugging why the classifier leaned one way.
+type ExplainResult struct {
+	Score  float64             `json:"score"`
+	Label  string              `json:"label"`
+	Tokens []TokenContribution `json:"tokens"`
+}
+
+// Explain scores an email exactly like Classify, but also returns each
+// token's contribution to the final score.
+func (b *BayesClassifier) Explain(ctx context.Context, e *email.Email) (*ExplainResult, error) {
+	score, label, tokens, err := b.score(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+	return &ExplainResult{Score: score, Label: label, Tokens: tokens}, nil
+}
+
+// score is the shared implementation behind Classify and Explain.
+func (b *BayesClassifier) score(ctx context.Context, e *email.Email) (score float64, label string, tokens []TokenContribution, err error) {
+	goodTotal, err := b.db.GetBayesClassTotal(ctx, database.BayesClassGood)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	junkTotal, err := b.db.GetBayesClassTotal(ctx, database.BayesClassJunk)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	totalMessages := goodTotal.MessageCount + junkTotal.MessageCount
+	if totalMessages == 0 {
+		// No training data yet - can't say anything useful
+		return 0.5, database.BayesClassGood, nil, nil
+	}
+
+	goodTokenTotal, err := b.db.GetBayesTokenTotal(ctx, database.BayesClassGood)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	junkTokenTotal, err := b.db.GetBayesTokenTotal(ctx, database.BayesClassJunk)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	vocabSize, err := b.db.GetBayesVocabSize(ctx)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	toks := tokenizeEmail(e)
+	counts, err := b.db.GetBayesTokenCounts(ctx, toks)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	interesting := mostInterestingTokens(toks, counts, goodTokenTotal, junkTokenTotal, vocabSize)
+
+	logGood := math.Log(float64(goodTotal.MessageCount+1) / float64(totalMessages+2))
+	logJunk := math.Log(float64(junkTotal.MessageCount+1) / float64(totalMessages+2))
+
+	contributions := make([]TokenContribution, 0, len(interesting))
+	for _, tok := range interesting {
+		c := counts[tok]
+		goodTerm := math.Log(float64(c.Good+1) / float64(goodTokenTotal+vocabSize))
+		junkTerm := math.Log(float64(c.Junk+1) / float64(junkTokenTotal+vocabSize))
+		logGood += goodTerm
+		logJunk += junkTerm
+		contributions = append(contributions, TokenContribution{
+			Token:     tok,
+			GoodCount: c.Good,
+			JunkCount: c.Junk,
+			LogOdds:   goodTerm - junkTerm,
+		})
+	}
+
+	// Sort by descending magnitude so Explain's contributions - and any
+	// Reason built from them - lead with the strongest signal first.
+	sort.Slice(contributions, func(i, j int) bool {
+		return math.Abs(contributions[i].LogOdds) > math.Abs(contributions[j].LogOdds)
+	})
+
+	score = 1 / (1 + math.Exp(logJunk-logGood))
+
+	label = database.BayesClassJunk
+	if score >= 0.5 {
+		label = database.BayesClassGood
+	}
+	return score, label, contributions, nil
+}
+
+// mostInterestingTokens dedupes toks and returns up to interestingTokenCount
+// of them, ranked by how far their smoothed P(good|token) sits from a
+// neutral 0.5 - the tokens score() should actually spend its log-odds sum
+// on, per interestingTokenCount's Graham-style rationale.
+func mostInterestingTokens(toks []string, counts map[string]database.BayesTokenCounts, goodTokenTotal, junkTokenTotal, vocabSize int) []string {
+	type candidate struct {
+		tok  string
+		dist float64
+	}
+
+	seen := make(map[string]bool, len(toks))
+	candidates := make([]candidate, 0, len(toks))
+	for _, tok := range toks {
+		if seen[tok] {
+			continue
+		}
+		seen[tok] = true
+
+		c := counts[tok]
+		pGood := float64(c.Good+1) / float64(goodTokenTotal+vocabSize)
+		pJunk := float64(c.Junk+1) / float64(junkTokenTotal+vocabSize)
+		p := pGood / (pGood + pJunk)
+		candidates = append(candidates, candidate{tok: tok, dist: math.Abs(0.5 - p)})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].dist > candidates[j].dist
+	})
+
+	if len(candidates) > interestingTokenCount {
+		candidates = candidates[:interestingTokenCount]
+	}
+
+	out := make([]string, len(candidates))
+	for i, c := range candidates {
+		out[i] = c.tok
+	}
+	return out
+}
+
+// bayesExclusionReason renders a filter.Result.Reason for an email the
+// Bayes classifier auto-excluded, naming the strongest contributing tokens
+// (tokens is already sorted by descending |LogOdds|, see score()).
+func bayesExclusionReason(pSpam float64, tokens []TokenContribution) string {
+	n := len(tokens)
+	if n > reasonTokenCount {
+		n = reasonTokenCount
+	}
+
+	names := make([]string, n)
+	for i := 0; i < n; i++ {
+		names[i] = tokens[i].Token
+	}
+
+	if len(names) == 0 {
+		return fmt.Sprintf("Bayes classifier scored %.0f%% spam", pSpam*100)
+	}
+	return fmt.Sprintf("Bayes classifier scored %.0f%% spam (top signals: %s)", pSpam*100, strings.Join(names, ", "))
+}
@@ -0,0 +1,136 @@
+package tracker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+func setupTestDB(t *testing.T) *database.DB {
+	t.Helper()
+
+	tmpDir, err := os.MkdirTemp("", "jobsearch-bayes-test-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(tmpDir) })
+
+	db, err := database.Open(filepath.Join(tmpDir, "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func newTestEmail(id, subject, body string) *email.Email {
+	return &email.Email{
+		ID:      id,
+		Subject: subject,
+		From:    email.Address{Email: "recruiter@example.com"},
+		Body:    body,
+	}
+}
+
+func TestBayesClassifier_ClassifyUntrainedIsNeutral(t *testing.T) {
+	db := setupTestDB(t)
+	b := NewBayesClassifier(db)
+
+	score, label, err := b.Classify(context.Background(), newTestEmail("1", "hello", "world"))
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if score != 0.5 || label != database.BayesClassGood {
+		t.Errorf("Classify() on untrained model = (%v, %q), want (0.5, %q)", score, label, database.BayesClassGood)
+	}
+}
+
+func TestBayesClassifier_TrainSkewsClassification(t *testing.T) {
+	db := setupTestDB(t)
+	b := NewBayesClassifier(db)
+	ctx := context.Background()
+
+	for i := 0; i < 10; i++ {
+		e := newTestEmail("good-"+strconv.Itoa(i), "interview scheduled", "We'd love to move forward with your application")
+		if err := b.Train(ctx, e, database.BayesClassGood); err != nil {
+			t.Fatalf("Train(good) failed: %v", err)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		e := newTestEmail("junk-"+strconv.Itoa(i), "unsubscribe newsletter", "Limited time offer, buy now and save")
+		if err := b.Train(ctx, e, database.BayesClassJunk); err != nil {
+			t.Fatalf("Train(junk) failed: %v", err)
+		}
+	}
+
+	goodScore, goodLabel, err := b.Classify(ctx, newTestEmail("test-good", "interview scheduled", "We'd love to move forward"))
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if goodLabel != database.BayesClassGood {
+		t.Errorf("Classify(job-like email) label = %q, want %q (score %v)", goodLabel, database.BayesClassGood, goodScore)
+	}
+
+	junkScore, junkLabel, err := b.Classify(ctx, newTestEmail("test-junk", "unsubscribe newsletter", "Limited time offer, buy now and save"))
+	if err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if junkLabel != database.BayesClassJunk {
+		t.Errorf("Classify(spam-like email) label = %q, want %q (score %v)", junkLabel, database.BayesClassJunk, junkScore)
+	}
+}
+
+// TestBayesClassifier_TrainIsIdempotent guards against the bug where Train
+// used an HLL cardinality-estimate delta to detect an already-trained
+// message: a genuinely new ID landing in an already-populated bucket with
+// an equal-or-lower register never moved the estimate, so repeat training
+// calls (and, worse, brand new messages) were silently dropped about 1 in 7
+// times. Training the same message many times must never move the class
+// totals or token counts past their first-training values.
+func TestBayesClassifier_TrainIsIdempotent(t *testing.T) {
+	db := setupTestDB(t)
+	b := NewBayesClassifier(db)
+	ctx := context.Background()
+
+	e := newTestEmail("dup-1", "interview scheduled", "We'd love to move forward with your application")
+
+	if err := b.Train(ctx, e, database.BayesClassGood); err != nil {
+		t.Fatalf("Train failed: %v", err)
+	}
+
+	total, err := db.GetBayesClassTotal(ctx, database.BayesClassGood)
+	if err != nil {
+		t.Fatalf("GetBayesClassTotal failed: %v", err)
+	}
+	if total.MessageCount != 1 {
+		t.Fatalf("MessageCount after first Train = %d, want 1", total.MessageCount)
+	}
+
+	for i := 0; i < 5; i++ {
+		if err := b.Train(ctx, e, database.BayesClassGood); err != nil {
+			t.Fatalf("Train (repeat %d) failed: %v", i, err)
+		}
+	}
+
+	total, err = db.GetBayesClassTotal(ctx, database.BayesClassGood)
+	if err != nil {
+		t.Fatalf("GetBayesClassTotal failed: %v", err)
+	}
+	if total.MessageCount != 1 {
+		t.Errorf("MessageCount after repeat Train calls = %d, want 1 (training the same message must be a no-op)", total.MessageCount)
+	}
+
+	counts, err := db.GetBayesTokenCounts(ctx, []string{"interview"})
+	if err != nil {
+		t.Fatalf("GetBayesTokenCounts failed: %v", err)
+	}
+	if counts["interview"].Good != 1 {
+		t.Errorf("token count for %q = %d, want 1 (repeat training must not inflate token counts)", "interview", counts["interview"].Good)
+	}
+}
@@ -0,0 +1,169 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/bounce"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
+)
+
+// HandleBounce checks whether e is a delivery-status notification and, if
+// so, records it and applies its effect to the conversation whose outbound
+// email it reports failing for: it's marked with the bounced health flag
+// and, once config.Tracking.HardBounceLimit hard bounces to the same
+// address have accumulated, moved to database.StatusBounced so the
+// tracker stops suggesting follow-ups to a dead address. handled is false
+// if e isn't a bounce at all, so the caller can fall through to its normal
+// filter/classify pipeline.
+func (t *Tracker) HandleBounce(ctx context.Context, e *email.Email) (handled bool, err error) {
+	report, ok := bounce.Detect(e)
+	if !ok {
+		return false, nil
+	}
+
+	conv, err := t.findBouncedConversation(ctx, e, report)
+	if err != nil {
+		return true, fmt.Errorf("failed to correlate bounce to a conversation: %w", err)
+	}
+	if conv == nil {
+		return true, fmt.Errorf("no conversation found for bounce from %s", report.Recipient)
+	}
+
+	return true, t.applyBounceReport(ctx, conv, report, e.Date)
+}
+
+// ApplyWebhookBounce applies report the same way HandleBounce does for a
+// DSN found in the inbox, but for a bounce reported out-of-band by a
+// provider webhook (bounce.ParseSES/ParseSendGrid) instead of detected in
+// synced mail. There's no inbox message to fall back to for correlation,
+// so only report.OriginalMessageID can locate the conversation; a webhook
+// payload that doesn't carry one can't be applied.
+func (t *Tracker) ApplyWebhookBounce(ctx context.Context, report *bounce.Report, receivedAt time.Time) error {
+	conv, err := t.findBouncedConversation(ctx, nil, report)
+	if err != nil {
+		return fmt.Errorf("failed to correlate bounce to a conversation: %w", err)
+	}
+	if conv == nil {
+		return fmt.Errorf("no conversation found for bounce from %s", report.Recipient)
+	}
+	return t.applyBounceReport(ctx, conv, report, receivedAt)
+}
+
+// applyBounceReport is the part HandleBounce and ApplyWebhookBounce share
+// once a bounce has been correlated to conv: record it, flag conv's bounce
+// health, and - once config.Tracking.HardBounceLimit hard bounces to the
+// same address have accumulated - both move conv to database.StatusBounced
+// and add the address's domain directly to the live filter.Filter's
+// learned blacklist via AddLearnedFilters, so later messages in this same
+// run stop reaching the inbox without waiting for the learner's staged
+// suggestion to be reviewed and promoted.
+func (t *Tracker) applyBounceReport(ctx context.Context, conv *database.Conversation, report *bounce.Report, receivedAt time.Time) error {
+	var diagnostic *string
+	if report.Diagnostic != "" {
+		diagnostic = &report.Diagnostic
+	}
+	if err := t.db.CreateBounce(ctx, &database.Bounce{
+		ConversationID: conv.ID,
+		Recipient:      report.Recipient,
+		Type:           string(report.Type),
+		Diagnostic:     diagnostic,
+		ReceivedAt:     receivedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to record bounce: %w", err)
+	}
+
+	conv.Bounced = true
+	bounceType := string(report.Type)
+	conv.BounceType = &bounceType
+	if report.Recipient != "" {
+		conv.BounceAddress = &report.Recipient
+	}
+
+	if report.Type == bounce.Hard && report.Recipient != "" {
+		hardBounces, err := t.db.CountHardBounces(ctx, report.Recipient)
+		if err != nil {
+			return fmt.Errorf("failed to count hard bounces: %w", err)
+		}
+		if hardBounces >= t.config.Tracking.HardBounceLimit {
+			conv.Status = database.StatusBounced
+			t.filter.AddLearnedFilters("domain_blacklist", []string{email.Address{Email: report.Recipient}.Domain()})
+		}
+	}
+
+	if err := t.db.UpdateConversation(ctx, conv); err != nil {
+		return fmt.Errorf("failed to update conversation: %w", err)
+	}
+
+	if t.notifier != nil {
+		_ = t.notifier.Notify(ctx, notify.Event{
+			Type:         notify.EventBounceDetected,
+			Conversation: conv,
+			Message:      fmt.Sprintf("%s bounce from %s", report.Type, report.Recipient),
+		})
+	}
+
+	if report.Type != bounce.Hard || t.learner == nil || report.Recipient == "" {
+		return nil
+	}
+
+	domain := email.Address{Email: report.Recipient}.Domain()
+	if err := t.learner.LearnFromBounce(ctx, domain, conv.ID, false); err != nil {
+		return fmt.Errorf("failed to learn from bounce: %w", err)
+	}
+
+	return nil
+}
+
+// handleBounces routes every delivery-status notification in emails to
+// HandleBounce and returns the remaining emails that aren't bounces, so
+// SyncWithOptions doesn't run them through the normal filter/classify
+// pipeline.
+func (t *Tracker) handleBounces(ctx context.Context, result *SyncResult, emails []email.Email) []email.Email {
+	remaining := emails[:0]
+	for _, e := range emails {
+		handled, err := t.HandleBounce(ctx, &e)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("bounce handling failed: %w", err))
+		}
+		if handled {
+			result.BouncesDetected++
+		} else {
+			remaining = append(remaining, e)
+		}
+	}
+	return remaining
+}
+
+// findBouncedConversation locates the conversation that sent the bounced
+// email. It prefers correlating via the original Message-ID a DSN
+// reproduces (works across every provider, and is the only signal a
+// webhook-reported bounce carries), falling back to the thread the bounce
+// notification itself arrived in - Gmail keeps a DSN grouped with the
+// original outbound message in the same thread - when e is non-nil and
+// the DSN didn't reproduce a Message-ID.
+func (t *Tracker) findBouncedConversation(ctx context.Context, e *email.Email, report *bounce.Report) (*database.Conversation, error) {
+	if report.OriginalMessageID != "" {
+		orig, err := t.db.GetEmailByMessageID(ctx, report.OriginalMessageID)
+		if err != nil {
+			return nil, err
+		}
+		if orig != nil {
+			return t.db.GetConversation(ctx, orig.ConversationID)
+		}
+	}
+	if e == nil || e.ThreadID == "" {
+		return nil, nil
+	}
+	return t.db.GetConversationByThreadID(ctx, e.ThreadID)
+}
+
+// BouncedConversations lists conversations currently flagged with a
+// delivery failure, so the user can act on them (resend, find an
+// alternate contact, or let a hard bounce's blacklist suggestion stand).
+func (t *Tracker) BouncedConversations(ctx context.Context) ([]database.Conversation, error) {
+	return t.db.Query(ctx, database.SearchCriteria{BouncedOnly: true, IncludeArchived: true})
+}
@@ -0,0 +1,174 @@
+package tracker
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
+)
+
+// commandPrefix marks a line as a tracker command ("#job <command> [arg]").
+const commandPrefix = "#job"
+
+// CommandProcessor applies "#job ..." commands parsed from incoming mail to
+// the conversation they target. It's the part of HandleIncomingMail that
+// doesn't care how the conversation was resolved, so it can be tested (or
+// reused, e.g. from a future chat-based control surface) without a parsed
+// email at all.
+type CommandProcessor struct {
+	db *database.DB
+	// notifier is optional - nil simply skips firing
+	// notify.EventRejectionDetected, the same "nil notifier is a no-op"
+	// convention Tracker itself follows.
+	notifier *notify.Registry
+}
+
+// NewCommandProcessor creates a CommandProcessor backed by the given
+// database, firing events on notifier if non-nil.
+func NewCommandProcessor(db *database.DB, notifier *notify.Registry) *CommandProcessor {
+	return &CommandProcessor{db: db, notifier: notifier}
+}
+
+// ParseCommand finds the first "#job ..." line in body, ignoring quoted
+// reply text, and splits it into a lowercased command and its remaining
+// argument. ok is false if no command line was found.
+func ParseCommand(body string) (cmd, arg string, ok bool) {
+	for _, line := range stripQuoted(body) {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, commandPrefix) {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, commandPrefix))
+		if len(fields) == 0 {
+			continue
+		}
+		return strings.ToLower(fields[0]), strings.Join(fields[1:], " "), true
+	}
+	return "", "", false
+}
+
+// stripQuoted returns body's lines with quoted reply text removed: anything
+// from the first "On ... wrote:" header onward, and any line starting with
+// the conventional ">" quote marker.
+func stripQuoted(body string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "On ") && strings.HasSuffix(trimmed, "wrote:") {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Apply mutates the conversation identified by convID according to cmd/arg
+// and saves it, returning a short confirmation. An unrecognized command (or
+// one missing a required argument) doesn't error - it returns a help stub
+// instead, so a typo in a reply doesn't look like silent failure.
+//
+// interview/offer/reject map onto the existing ConversationStatus enum
+// rather than a dedicated pipeline stage: interview/offer both mean "still
+// in play" (StatusActive), reject/close both mean "done" (StatusClosed).
+func (p *CommandProcessor) Apply(ctx context.Context, convID, cmd, arg string) (string, error) {
+	conv, err := p.db.GetConversation(ctx, convID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load conversation: %w", err)
+	}
+	if conv == nil {
+		return "", fmt.Errorf("conversation not found: %s", convID)
+	}
+
+	switch cmd {
+	case "interview", "offer":
+		conv.Status = database.StatusActive
+	case "reject", "close":
+		conv.Status = database.StatusClosed
+		if cmd == "reject" && p.notifier != nil {
+			_ = p.notifier.Notify(ctx, notify.Event{Type: notify.EventRejectionDetected, Conversation: conv})
+		}
+	case "snooze":
+		dur, err := parseSnoozeDuration(arg)
+		if err != nil {
+			return helpText(), nil
+		}
+		until := time.Now().Add(dur)
+		conv.SnoozedUntil = &until
+	case "company":
+		if arg == "" {
+			return helpText(), nil
+		}
+		conv.Company = arg
+	case "unsubscribe":
+		if conv.RecruiterEmail == nil || *conv.RecruiterEmail == "" {
+			return "No recruiter email on this conversation to unsubscribe.", nil
+		}
+		if err := p.db.CreateOptOut(ctx, *conv.RecruiterEmail, "command", &conv.ID); err != nil {
+			return "", fmt.Errorf("failed to record opt-out: %w", err)
+		}
+		if err := p.db.ClearDigestQueueForConversation(ctx, conv.ID); err != nil {
+			return "", fmt.Errorf("failed to clear queued digest events: %w", err)
+		}
+		conv.Status = database.StatusClosed
+	default:
+		return helpText(), nil
+	}
+
+	if err := p.db.UpdateConversation(ctx, conv); err != nil {
+		return "", fmt.Errorf("failed to update conversation: %w", err)
+	}
+
+	return confirmation(conv, cmd), nil
+}
+
+// parseSnoozeDuration parses a suffix-duration like "7d", "12h", or "45m".
+// Days aren't a standard time.ParseDuration unit, so "d" is handled
+// separately; everything else is delegated to time.ParseDuration.
+func parseSnoozeDuration(arg string) (time.Duration, error) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return 0, fmt.Errorf("snooze requires a duration, e.g. \"7d\"")
+	}
+	if days, isDays := strings.CutSuffix(arg, "d"); isDays {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid snooze duration: %s", arg)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(arg)
+}
+
+func helpText() string {
+	return `Didn't recognize that command. Reply with one of:
+  #job interview        mark as interviewing
+  #job offer             mark as offer received
+  #job reject            mark as rejected
+  #job close             close the conversation
+  #job snooze <dur>      e.g. "#job snooze 7d"
+  #job company <name>    rename the conversation
+  #job unsubscribe       opt the recruiter out and close the conversation`
+}
+
+func confirmation(conv *database.Conversation, cmd string) string {
+	switch cmd {
+	case "snooze":
+		return fmt.Sprintf("Snoozed %s until %s.", conv.Company, conv.SnoozedUntil.Format("2006-01-02"))
+	case "company":
+		return fmt.Sprintf("Renamed conversation to %s.", conv.Company)
+	case "unsubscribe":
+		return fmt.Sprintf("Opted out %s and closed the conversation.", *conv.RecruiterEmail)
+	default:
+		return fmt.Sprintf("Marked %s as %s.", conv.Company, conv.Status)
+	}
+}
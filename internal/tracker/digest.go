@@ -0,0 +1,48 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/digest"
+)
+
+// DigestOptions configures a single RunDigest call. The interval between
+// calls (immediate, 15min, hourly, daily, ...) is config.Digest.Interval;
+// RunDigest itself just drains whatever is queued right now, so it's up to
+// whatever scheduler calls it to only do so that often.
+type DigestOptions struct {
+	// UserEmail selects whose digest_queue to drain. Empty defaults to the
+	// Tracker's own synced user.
+	UserEmail string
+}
+
+// RunDigest drains the digest_queue accumulated by Sync/updateAllStatuses
+// since the last digest and sends one combined email instead of one
+// notification per event. It's a no-op if digest batching is disabled in
+// config, or if no ReplySender has been configured - there's no transport
+// to batch toward, so queued events are left for whenever one is.
+func (t *Tracker) RunDigest(ctx context.Context, opts DigestOptions) (*digest.Rendered, error) {
+	if !t.config.Digest.Enabled || t.replySender == nil {
+		return nil, nil
+	}
+
+	userEmail := opts.UserEmail
+	if userEmail == "" {
+		userEmail = t.userEmail
+	}
+
+	secret, err := LoadOrCreateReplySecret(t.config.Reply.SecretPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reply secret: %w", err)
+	}
+	domain := t.config.Reply.Domain
+
+	return digest.Run(ctx, t.db, t.replySender, digest.Options{
+		UserEmail: userEmail,
+		ReplyTo:   userEmail,
+		ReplyAddr: func(conversationID string) string {
+			return EncodeReplyAddress(secret, conversationID, domain)
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/commands"
+)
+
+// handleInboxCommands scans emails for "#jobsearch ..." self-reply
+// directives (see internal/email/commands) and applies each to the
+// conversation its Message-ID threads to, removing it from the slice so
+// it isn't also filtered/classified as a new recruiter message.
+func (t *Tracker) handleInboxCommands(ctx context.Context, result *SyncResult, emails []email.Email) []email.Email {
+	remaining := emails[:0]
+	for _, e := range emails {
+		handled, err := t.applyInboxCommand(ctx, &e)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("inbox command failed: %w", err))
+		}
+		if handled {
+			result.CommandsApplied++
+		} else {
+			remaining = append(remaining, e)
+		}
+	}
+	return remaining
+}
+
+// applyInboxCommand applies a single "#jobsearch ..." directive in e, if e
+// is a self-sent reply (From equal to the authenticated user) carrying one
+// and threading to a known conversation. handled is false if e isn't a
+// command reply at all, so the caller falls through to the normal
+// filter/classify pipeline.
+func (t *Tracker) applyInboxCommand(ctx context.Context, e *email.Email) (handled bool, err error) {
+	if !e.IsFromMe(t.userEmail) {
+		return false, nil
+	}
+	directive, ok := commands.Parse(e.Body)
+	if !ok {
+		return false, nil
+	}
+
+	messageID, inReplyTo, references := messageThreadHeaders(e)
+
+	applied, err := t.db.EmailCommandApplied(ctx, messageID)
+	if err != nil {
+		return true, fmt.Errorf("failed to check command idempotency: %w", err)
+	}
+	if applied {
+		return true, nil
+	}
+
+	conv, err := t.findInboxCommandConversation(ctx, e, inReplyTo, references)
+	if err != nil {
+		return true, fmt.Errorf("failed to correlate command to a conversation: %w", err)
+	}
+	if conv == nil {
+		return true, fmt.Errorf("no conversation found for #jobsearch command from message %s", messageID)
+	}
+
+	if err := t.applyDirective(ctx, conv, directive); err != nil {
+		return true, fmt.Errorf("failed to apply #jobsearch %s: %w", directive.Command, err)
+	}
+
+	if err := t.db.CreateEmailCommand(ctx, &database.EmailCommand{
+		MessageID:      messageID,
+		ConversationID: conv.ID,
+		Command:        directive.Command,
+		Arg:            directive.Arg,
+	}); err != nil {
+		return true, fmt.Errorf("failed to record applied command: %w", err)
+	}
+
+	return true, nil
+}
+
+// findInboxCommandConversation locates the conversation a self-sent
+// command reply belongs to, the same JWZ-then-thread-ID fallback order
+// findOrCreateConversation uses for ordinary replies.
+func (t *Tracker) findInboxCommandConversation(ctx context.Context, e *email.Email, inReplyTo string, references []string) (*database.Conversation, error) {
+	for _, ancestorID := range ancestorLookupOrder(inReplyTo, references) {
+		ancestor, err := t.db.GetEmailByMessageID(ctx, ancestorID)
+		if err != nil {
+			return nil, err
+		}
+		if ancestor != nil {
+			return t.db.GetConversation(ctx, ancestor.ConversationID)
+		}
+	}
+	if e.ThreadID == "" {
+		return nil, nil
+	}
+	return t.db.GetConversationByThreadID(ctx, e.ThreadID)
+}
+
+// applyDirective applies a single parsed commands.Directive to conv,
+// dispatching through the same tracker/database methods the CLI's archive
+// and mark-spam commands use.
+func (t *Tracker) applyDirective(ctx context.Context, conv *database.Conversation, d commands.Directive) error {
+	switch d.Command {
+	case "archive":
+		_, err := t.db.ArchiveConversation(ctx, conv.ID)
+		return err
+
+	case "mark-spam":
+		if err := t.MarkFalsePositive(ctx, conv.ID); err != nil {
+			return err
+		}
+		_, err := t.db.ArchiveConversation(ctx, conv.ID)
+		return err
+
+	case "snooze":
+		dur, err := parseSnoozeDuration(d.Arg)
+		if err != nil {
+			return err
+		}
+		until := time.Now().Add(dur)
+		conv.SnoozedUntil = &until
+		return t.db.UpdateConversation(ctx, conv)
+
+	case "status":
+		status := database.ConversationStatus(strings.ToLower(strings.TrimSpace(d.Arg)))
+		if !isKnownStatus(status) {
+			return fmt.Errorf("unrecognized status %q", d.Arg)
+		}
+		conv.Status = status
+		return t.db.UpdateConversation(ctx, conv)
+
+	default:
+		return fmt.Errorf("unrecognized command %q", d.Command)
+	}
+}
+
+// isKnownStatus reports whether s is one of the ConversationStatus values
+// a "#jobsearch status=..." directive is allowed to set.
+func isKnownStatus(s database.ConversationStatus) bool {
+	switch s {
+	case database.StatusActive, database.StatusWaitingOnMe, database.StatusWaitingOnThem,
+		database.StatusStale, database.StatusClosed, database.StatusBounced, database.StatusInterviewScheduled:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/filter"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/transitions"
+)
+
+// ReplySender posts a short confirmation back to the sender of a command
+// email. It's a narrow interface, rather than a dependency on email.Provider,
+// so HandleIncomingMail can be exercised without a live mail connection.
+type ReplySender interface {
+	SendReply(ctx context.Context, to, subject, body string) error
+}
+
+// SetReplySender configures where HandleIncomingMail posts its confirmation
+// replies. Without one, HandleIncomingMail still applies the command but
+// skips the reply.
+func (t *Tracker) SetReplySender(r ReplySender) {
+	t.replySender = r
+}
+
+// SetNotifier configures the notify.Registry that conversation lifecycle
+// events (new conversation, status change, stale reminder, learned false
+// positive, detected bounce) are fanned out through. Without one, those
+// events are simply not emitted.
+func (t *Tracker) SetNotifier(r *notify.Registry) {
+	t.notifier = r
+}
+
+// SetRuleEngine configures the notify.RuleEngine that conversation changes
+// are evaluated against (see Tracker.evaluateRules in rules.go). Without
+// one, rule evaluation is simply skipped.
+func (t *Tracker) SetRuleEngine(e *notify.RuleEngine) {
+	t.ruleEngine = e
+}
+
+// SetTransitionRules configures the content-based status transition rules
+// (see internal/transitions and Tracker.applyContentTransition) evaluated
+// against every inbound email. Without any, content-based transitions are
+// simply skipped.
+func (t *Tracker) SetTransitionRules(rules []transitions.Rule) {
+	t.transitionRules = rules
+}
+
+// HandleIncomingMail treats a raw RFC 5322 message as a "#job ..." command
+// reply: it recovers the target conversation from the HMAC-signed
+// jobs+<convID>-<sig>@domain address it was sent to (see EncodeReplyAddress),
+// applies the command via a CommandProcessor, and - if a ReplySender is
+// configured - posts a short confirmation back to the sender.
+func (t *Tracker) HandleIncomingMail(ctx context.Context, raw []byte) error {
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		return fmt.Errorf("failed to parse message: %w", err)
+	}
+
+	convID, ok := t.replyConversationID(msg.Header)
+	if !ok {
+		return fmt.Errorf("message is not addressed to a jobsearch reply address")
+	}
+
+	body, err := io.ReadAll(msg.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read message body: %w", err)
+	}
+
+	cmd, arg, _ := ParseCommand(string(body))
+
+	reply, err := NewCommandProcessor(t.db, t.notifier).Apply(ctx, convID, cmd, arg)
+	if err != nil {
+		return err
+	}
+
+	if t.replySender == nil {
+		return nil
+	}
+
+	from, err := msg.Header.AddressList("From")
+	if err != nil || len(from) == 0 {
+		return fmt.Errorf("failed to determine reply recipient: %w", err)
+	}
+
+	return t.replySender.SendReply(ctx, from[0].Address, "Re: "+msg.Header.Get("Subject"), reply)
+}
+
+// ProcessIncomingMessages fetches providerIDs from the configured provider
+// and runs each through the same filter-then-store pipeline as Sync,
+// skipping LLM classification (a push/poll handler runs far more often than
+// a manual sync and shouldn't wait on it). It's meant for callers like
+// internal/inbound that already know which specific messages are new,
+// rather than discovering them via FetchEmails. It returns the IDs of
+// conversations that gained an email, in processing order, with duplicates
+// where more than one message landed in the same conversation.
+func (t *Tracker) ProcessIncomingMessages(ctx context.Context, providerIDs []string) ([]string, error) {
+	if len(providerIDs) == 0 {
+		return nil, nil
+	}
+
+	if t.userEmail == "" {
+		userEmail, err := t.provider.GetUserEmail(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user email: %w", err)
+		}
+		t.userEmail = userEmail
+		t.filter.SetUserEmail(userEmail)
+	}
+
+	var changed []string
+	for _, id := range providerIDs {
+		e, err := t.provider.GetEmail(ctx, id)
+		if err != nil {
+			return changed, fmt.Errorf("failed to fetch message %s: %w", id, err)
+		}
+		if e == nil {
+			continue
+		}
+
+		result := t.filter.Apply(e)
+		if !result.Include {
+			continue
+		}
+
+		pe := processedEmail{FilteredEmail: filter.FilteredEmail{Email: *e, Result: result}}
+		convID, _, err := t.processEmail(ctx, &pe)
+		if err != nil {
+			return changed, fmt.Errorf("failed to process message %s: %w", id, err)
+		}
+		if convID != "" {
+			changed = append(changed, convID)
+		}
+	}
+
+	return changed, nil
+}
+
+// replyConversationID checks To and Cc for an address generated by
+// EncodeReplyAddress, returning the first conversation ID that verifies.
+func (t *Tracker) replyConversationID(h mail.Header) (string, bool) {
+	secret, err := LoadOrCreateReplySecret(t.config.Reply.SecretPath)
+	if err != nil {
+		return "", false
+	}
+
+	for _, field := range []string{"To", "Cc"} {
+		addrs, err := h.AddressList(field)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if convID, ok := DecodeReplyAddress(secret, addr.Address); ok {
+				return convID, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,115 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/calendar"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// handleInterviews records every calendar event attached to an email
+// (email.Email.Events, populated from its text/calendar parts) against
+// conv, and moves conv to database.StatusInterviewScheduled if any of them
+// leaves it with a still-scheduled interview. It's called from
+// processEmail once conv is known, the same place bounce/command handling
+// is pulled out earlier in SyncWithOptions - a calendar invite updates the
+// conversation it arrived in rather than being a conversation in its own
+// right.
+//
+// It returns the Interview that newly put conv into
+// database.StatusInterviewScheduled, or nil if conv was already in that
+// status (or none of events left it scheduled) - the caller uses this to
+// decide whether to fire notify.EventInterviewScheduled.
+func (t *Tracker) handleInterviews(ctx context.Context, conv *database.Conversation, events []calendar.Event) (*database.Interview, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	wasScheduled := conv.Status == database.StatusInterviewScheduled
+	loc := t.config.Tracking.Location()
+	hasScheduled := false
+	var scheduled *database.Interview
+
+	for _, ev := range events {
+		start, end := ev.Start, ev.End
+		if ev.Floating {
+			start = relocate(start, loc)
+			end = relocate(end, loc)
+		}
+
+		status := "scheduled"
+		if strings.EqualFold(ev.Method, "CANCEL") {
+			status = "cancelled"
+		}
+
+		iv := &database.Interview{
+			ConversationID: conv.ID,
+			UID:            ev.UID,
+			Sequence:       ev.Sequence,
+			Summary:        ev.Summary,
+			StartTime:      start,
+			EndTime:        end,
+			AllDay:         ev.AllDay,
+			Status:         status,
+		}
+		if ev.Location != "" {
+			iv.Location = &ev.Location
+		}
+		if videoURL := calendar.VideoURL(ev.Location, ev.Description); videoURL != "" {
+			iv.VideoURL = &videoURL
+		}
+		if ev.Organizer != "" {
+			iv.Organizer = &ev.Organizer
+		}
+		iv.Interviewers = interviewersExcludingOrganizer(ev.Attendees, ev.Organizer)
+
+		if err := t.db.UpsertInterview(ctx, iv); err != nil {
+			return nil, fmt.Errorf("failed to record interview %s: %w", ev.UID, err)
+		}
+
+		if status == "scheduled" {
+			hasScheduled = true
+			if scheduled == nil {
+				scheduled = iv
+			}
+		}
+	}
+
+	if hasScheduled {
+		conv.Status = database.StatusInterviewScheduled
+	}
+
+	if wasScheduled || !hasScheduled {
+		return nil, nil
+	}
+	return scheduled, nil
+}
+
+// relocate reinterprets a floating time's wall-clock fields in loc,
+// leaving the numbers (year/month/.../second) unchanged - the correct
+// reading of an RFC 5545 floating time, which carries no zone of its own.
+func relocate(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// interviewersExcludingOrganizer returns attendees minus the organizer (who
+// is also commonly listed as an attendee), so Interview.Interviewers names
+// just the people the candidate is actually meeting.
+func interviewersExcludingOrganizer(attendees []string, organizer string) []string {
+	var out []string
+	for _, a := range attendees {
+		if !strings.EqualFold(a, organizer) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// UpcomingInterviews lists every not-yet-cancelled interview starting at or
+// after now, soonest first.
+func (t *Tracker) UpcomingInterviews(ctx context.Context) ([]database.Interview, error) {
+	return t.db.ListUpcomingInterviews(ctx, time.Now())
+}
@@ -2,8 +2,10 @@ package tracker
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/filter"
@@ -11,36 +13,75 @@ import (
 
 // Learner extracts patterns from classified emails and suggests filters
 type Learner struct {
-	db *database.DB
+	db    *database.DB
+	bayes *BayesClassifier
+	cfg   *config.Config
 }
 
 // NewLearner creates a new Learner
-func NewLearner(db *database.DB) *Learner {
-	return &Learner{db: db}
+func NewLearner(db *database.DB, cfg *config.Config) *Learner {
+	return &Learner{db: db, bayes: NewBayesClassifier(db), cfg: cfg}
 }
 
-// LearnFromEmail extracts patterns from a job-related email and suggests filters
-func (l *Learner) LearnFromEmail(ctx context.Context, e *email.Email, confidence float64) error {
+// ClassifySpam scores e against the trained Bayesian classifier and returns
+// the probability it's spam (i.e. 1 - P(good)), for the sync pipeline's
+// pre-LLM auto-filter.
+func (l *Learner) ClassifySpam(ctx context.Context, e *email.Email) (float64, error) {
+	score, _, err := l.bayes.Classify(ctx, e)
+	if err != nil {
+		return 0, err
+	}
+	return 1 - score, nil
+}
+
+// ExplainSpam scores e exactly like ClassifySpam, but also returns its top
+// contributing tokens (strongest log-odds first), so a caller that auto-
+// excludes on the result can surface why rather than just the bare score.
+func (l *Learner) ExplainSpam(ctx context.Context, e *email.Email) (pSpam float64, tokens []TokenContribution, err error) {
+	result, err := l.bayes.Explain(ctx, e)
+	if err != nil {
+		return 0, nil, err
+	}
+	return 1 - result.Score, result.Tokens, nil
+}
+
+// LearnFromEmail extracts patterns from a job-related email and suggests
+// filters. If dryRun is set, nothing is written: suggestions that would have
+// been staged or promoted are printed instead.
+func (l *Learner) LearnFromEmail(ctx context.Context, e *email.Email, confidence float64, dryRun bool) error {
 	// Only learn from high-confidence classifications
 	if confidence < 0.7 {
 		return nil
 	}
 
+	if dryRun {
+		fmt.Printf("[dry-run] would train bayes classifier as %s from %s\n", database.BayesClassGood, e.From.Email)
+	} else if err := l.bayes.Train(ctx, e, database.BayesClassGood); err != nil {
+		return err
+	}
+
 	// Extract and suggest domain
-	if err := l.suggestDomain(ctx, e, confidence); err != nil {
+	if err := l.suggestDomain(ctx, e, confidence, dryRun); err != nil {
 		return err
 	}
 
 	// Extract and suggest keywords from subject
-	if err := l.suggestSubjectKeywords(ctx, e, confidence); err != nil {
+	if err := l.suggestSubjectKeywords(ctx, e, confidence, dryRun); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// suggestDomain suggests adding the email domain to whitelist
-func (l *Learner) suggestDomain(ctx context.Context, e *email.Email, confidence float64) error {
+// Classify scores an email against the Bayesian model trained from past
+// LearnFromEmail/LearnFromFeedback calls. It can be used in place of a fixed
+// confidence gate to decide whether an email is job-related.
+func (l *Learner) Classify(ctx context.Context, e *email.Email) (score float64, label string, err error) {
+	return l.bayes.Classify(ctx, e)
+}
+
+// suggestDomain suggests adding the email domain to the whitelist
+func (l *Learner) suggestDomain(ctx context.Context, e *email.Email, confidence float64, dryRun bool) error {
 	domain := e.Domain()
 	if domain == "" {
 		return nil
@@ -51,64 +92,189 @@ func (l *Learner) suggestDomain(ctx context.Context, e *email.Email, confidence
 		return nil
 	}
 
-	// Check if already exists (in any form)
-	exists, err := l.db.LearnedFilterExists(ctx, database.FilterTypeDomainWhitelist, domain)
-	if err != nil {
-		return err
-	}
-	if exists {
-		return nil
-	}
-
-	// Create suggestion
-	f := &database.LearnedFilter{
-		FilterType:         database.FilterTypeDomainWhitelist,
-		Value:              domain,
-		Source:             database.FilterSourceAISuggested,
-		FalsePositiveCount: 0,
-	}
-
-	return l.db.CreateLearnedFilter(ctx, f)
+	return l.stageSuggestion(ctx, database.FilterTypeDomainWhitelist, domain, e.ID, confidence, dryRun)
 }
 
 // suggestSubjectKeywords extracts potential keywords from email subject
-func (l *Learner) suggestSubjectKeywords(ctx context.Context, e *email.Email, confidence float64) error {
+func (l *Learner) suggestSubjectKeywords(ctx context.Context, e *email.Email, confidence float64, dryRun bool) error {
 	subject := strings.ToLower(e.Subject)
 
 	// Look for recruiting-related phrases
 	phrases := extractRecruitingPhrases(subject)
 
 	for _, phrase := range phrases {
-		// Check if already exists
-		exists, err := l.db.LearnedFilterExists(ctx, database.FilterTypeSubjectKeyword, phrase)
-		if err != nil {
+		if err := l.stageSuggestion(ctx, database.FilterTypeSubjectKeyword, phrase, e.ID, confidence, dryRun); err != nil {
 			return err
 		}
-		if exists {
-			continue
-		}
+	}
 
-		// Create suggestion
+	return nil
+}
+
+// stageSuggestion ensures a filter_type/value pair exists as an ai_suggested
+// row, accumulates its support count and confidence from emailID, and
+// auto-promotes it to ai_confirmed once it clears the configured evidence
+// and confidence*precision thresholds. A filter that's already user-set or
+// ai_confirmed is left alone. If dryRun is set, nothing is written - the
+// suggestion that would have been staged or promoted is printed instead.
+func (l *Learner) stageSuggestion(ctx context.Context, filterType, value, emailID string, confidence float64, dryRun bool) error {
+	existing, err := l.db.GetLearnedFilterByValue(ctx, filterType, value)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Source != database.FilterSourceAISuggested {
+		return nil
+	}
+
+	if dryRun {
+		fmt.Printf("[dry-run] would suggest %s: %s\n", filterType, value)
+		return nil
+	}
+
+	if existing == nil {
 		f := &database.LearnedFilter{
-			FilterType:         database.FilterTypeSubjectKeyword,
-			Value:              phrase,
-			Source:             database.FilterSourceAISuggested,
-			FalsePositiveCount: 0,
+			FilterType: filterType,
+			Value:      value,
+			Source:     database.FilterSourceAISuggested,
+			Confidence: &confidence,
 		}
-
 		if err := l.db.CreateLearnedFilter(ctx, f); err != nil {
 			return err
 		}
+	} else if existing.Confidence == nil || confidence > *existing.Confidence {
+		// Track the strongest confidence this suggestion has ever been
+		// staged with, so a single high-confidence email can clear the
+		// promotion gate even if earlier support was weaker.
+		existing.Confidence = &confidence
+		if err := l.db.CreateLearnedFilter(ctx, &database.LearnedFilter{
+			ID:         existing.ID,
+			FilterType: filterType,
+			Value:      value,
+			Source:     database.FilterSourceAISuggested,
+			Confidence: &confidence,
+		}); err != nil {
+			return err
+		}
+	}
+
+	updated, err := l.recordSupport(ctx, filterType, value, emailID)
+	if err != nil {
+		return err
 	}
 
+	if l.shouldPromote(updated) {
+		return l.db.ApproveLearnedFilter(ctx, updated.ID)
+	}
 	return nil
 }
 
+// recordSupport increments a staged filter's support counter the first time
+// it sees a given email. db.MarkSeen records the email ID against this
+// filter row exactly, mirroring BayesClassifier.Train's idempotency
+// pattern, so reprocessing the same email twice doesn't inflate support.
+func (l *Learner) recordSupport(ctx context.Context, filterType, value, emailID string) (*database.LearnedFilter, error) {
+	fs, err := l.db.GetFilterSupport(ctx, filterType, value)
+	if err != nil {
+		return nil, err
+	}
+	if fs == nil {
+		return nil, fmt.Errorf("filter not found: %s=%s", filterType, value)
+	}
+
+	isNew, err := l.db.MarkSeen(ctx, "filter_support:"+fs.ID, emailID)
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		fs.Count++
+		if err := l.db.SaveFilterSupport(ctx, fs.ID, fs.Count); err != nil {
+			return nil, err
+		}
+	}
+
+	return l.db.GetLearnedFilter(ctx, fs.ID)
+}
+
+// shouldPromote reports whether a staged filter has enough evidence, a
+// clean enough recent run, a low enough false-positive rate, and a high
+// enough confidence*precision score to become an active filter.
+func (l *Learner) shouldPromote(f *database.LearnedFilter) bool {
+	if f.Source != database.FilterSourceAISuggested {
+		return false
+	}
+	if f.SupportCount < l.cfg.Learning.MinSupport {
+		return false
+	}
+	if f.SupportSinceFP < l.cfg.Learning.CleanRunRequired {
+		return false
+	}
+	fpRate := float64(f.FalsePositiveCount) / float64(f.SupportCount)
+	if fpRate > l.cfg.Learning.MaxFalsePositiveRate {
+		return false
+	}
+	if f.Confidence == nil {
+		return false
+	}
+	return *f.Confidence*f.Precision() >= l.cfg.Learning.AutoApproveThreshold
+}
+
+// ReviewPromotions sweeps every ai_confirmed filter and demotes back to
+// ai_suggested any whose precision has fallen below
+// config.Learning.DemoteBelowPrecision since it was promoted - e.g. because
+// feedback false-positive/false-negative calls kept incrementing its
+// false-positive count after the fact. It returns the values demoted, for
+// "jobsearch sync" to report. If dryRun is set, nothing is written.
+func (l *Learner) ReviewPromotions(ctx context.Context, dryRun bool) ([]string, error) {
+	confirmed, err := l.db.ListLearnedFiltersBySource(ctx, database.FilterSourceAIConfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	var demoted []string
+	for _, f := range confirmed {
+		if f.Precision() >= l.cfg.Learning.DemoteBelowPrecision {
+			continue
+		}
+		if dryRun {
+			fmt.Printf("[dry-run] would demote %s: %s (precision %.2f)\n", f.FilterType, f.Value, f.Precision())
+			demoted = append(demoted, f.Value)
+			continue
+		}
+		if err := l.db.DemoteLearnedFilter(ctx, f.ID); err != nil {
+			return nil, err
+		}
+		demoted = append(demoted, f.Value)
+	}
+
+	return demoted, nil
+}
+
 // LearnFromFeedback learns from user feedback on misclassified emails
 func (l *Learner) LearnFromFeedback(ctx context.Context, e *email.Email, isFalsePositive bool) error {
 	domain := e.Domain()
 
+	bayesClass := database.BayesClassGood
+	if isFalsePositive {
+		bayesClass = database.BayesClassJunk
+	}
+	if err := l.bayes.Train(ctx, e, bayesClass); err != nil {
+		return err
+	}
+
 	if isFalsePositive {
+		// This email wrongly passed the filter, so penalize whichever staged
+		// suggestions would have let it through.
+		if domain != "" {
+			if err := l.db.IncrementFilterFalsePositive(ctx, database.FilterTypeDomainWhitelist, domain); err != nil {
+				return err
+			}
+		}
+		for _, phrase := range extractRecruitingPhrases(strings.ToLower(e.Subject)) {
+			if err := l.db.IncrementFilterFalsePositive(ctx, database.FilterTypeSubjectKeyword, phrase); err != nil {
+				return err
+			}
+		}
+
 		// Email was wrongly included - add domain to blacklist
 		if domain != "" && !isCommonDomain(domain) {
 			exists, err := l.db.LearnedFilterExists(ctx, database.FilterTypeDomainBlacklist, domain)
@@ -149,6 +315,19 @@ func (l *Learner) LearnFromFeedback(ctx context.Context, e *email.Email, isFalse
 			}
 		}
 	} else {
+		// This email wrongly failed the filter, so penalize whichever staged
+		// suggestions would have blocked it.
+		if domain != "" {
+			if err := l.db.IncrementFilterFalsePositive(ctx, database.FilterTypeDomainBlacklist, domain); err != nil {
+				return err
+			}
+		}
+		for _, phrase := range extractBlacklistPhrases(strings.ToLower(e.Subject)) {
+			if err := l.db.IncrementFilterFalsePositive(ctx, database.FilterTypeSubjectBlacklist, phrase); err != nil {
+				return err
+			}
+		}
+
 		// Email was wrongly excluded (false negative) - add domain to whitelist
 		if domain != "" && !isCommonDomain(domain) && !isATSDomain(domain) {
 			exists, err := l.db.LearnedFilterExists(ctx, database.FilterTypeDomainWhitelist, domain)
@@ -172,6 +351,68 @@ func (l *Learner) LearnFromFeedback(ctx context.Context, e *email.Email, isFalse
 	return nil
 }
 
+// LearnKeywordsFromCorpus runs a RAKE pass (see filter.Filter.LearnKeywordsFromCorpus)
+// over every email belonging to a conversation the user hasn't archived or
+// closed, then stages each newly discovered subject/body phrase exactly like
+// LearnFromEmail's suggestSubjectKeywords does - an ai_suggested filter that
+// auto-promotes once it clears the usual support/confidence thresholds. It
+// returns the phrases staged this run (empty if nothing new was found). If
+// dryRun is set, nothing is written.
+func (l *Learner) LearnKeywordsFromCorpus(ctx context.Context, f *filter.Filter, dryRun bool) (subjectPhrases, bodyPhrases []string, err error) {
+	closed := database.StatusClosed
+	convs, err := l.db.Query(ctx, database.SearchCriteria{Not: &database.SearchCriteria{Status: &closed}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var emails []*email.Email
+	for _, conv := range convs {
+		dbEmails, err := l.db.ListEmailsForConversation(ctx, conv.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range dbEmails {
+			emails = append(emails, emailForLearning(&dbEmails[i]))
+		}
+	}
+
+	subjectPhrases, bodyPhrases = f.LearnKeywordsFromCorpus(emails)
+
+	// corpusSupportKey is a fixed emailID: this is one pooled pass rather
+	// than per-email evidence, so every phrase this run discovers gets the
+	// same single unit of support - repeat runs accumulate it the same way
+	// LearnFromEmail accumulates support per real email.
+	const corpusSupportKey = "rake-corpus-scan"
+	for _, phrase := range subjectPhrases {
+		if err := l.stageSuggestion(ctx, database.FilterTypeSubjectKeyword, phrase, corpusSupportKey, 0.6, dryRun); err != nil {
+			return subjectPhrases, bodyPhrases, err
+		}
+	}
+	for _, phrase := range bodyPhrases {
+		if err := l.stageSuggestion(ctx, database.FilterTypeBodyKeyword, phrase, corpusSupportKey, 0.6, dryRun); err != nil {
+			return subjectPhrases, bodyPhrases, err
+		}
+	}
+
+	return subjectPhrases, bodyPhrases, nil
+}
+
+// LearnFromBounce stages domain for the domain blacklist, the same staged
+// suggestion + support-count path LearnFromEmail uses for whitelist domains.
+// It's called once per hard bounce from domain, keyed by convID so repeated
+// bounces in the same conversation don't inflate the count; once enough
+// distinct conversations have bounced (config.Learning.MinSupport), the
+// domain is auto-promoted to the confirmed blacklist exactly as any other
+// staged suggestion would be.
+func (l *Learner) LearnFromBounce(ctx context.Context, domain, convID string, dryRun bool) error {
+	if domain == "" || isCommonDomain(domain) {
+		return nil
+	}
+	// A hard bounce is as strong a signal as recipient delivery failure
+	// gets, so stage it at full confidence.
+	return l.stageSuggestion(ctx, database.FilterTypeDomainBlacklist, domain, convID, 1.0, dryRun)
+}
+
 // extractRecruitingPhrases finds recruiting-related phrases in text
 func extractRecruitingPhrases(text string) []string {
 	var phrases []string
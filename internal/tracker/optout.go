@@ -0,0 +1,71 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+)
+
+// learnOptOuts scans emails for an unsubscribe request (a List-Unsubscribe
+// header or common body phrasing) and persists each one found, adding it to
+// the filter immediately so it also takes effect for the rest of this sync.
+// Persist failures are swallowed rather than aborting the sync - the worst
+// case is one more email from that sender slips through this run.
+func (t *Tracker) learnOptOuts(ctx context.Context, emails []email.Email) {
+	for _, e := range emails {
+		if e.IsFromMe(t.userEmail) {
+			continue
+		}
+
+		source, ok := email.DetectUnsubscribeIntent(&e)
+		if !ok {
+			continue
+		}
+
+		if err := t.db.CreateOptOut(ctx, e.From.Email, source, nil); err != nil {
+			continue
+		}
+		t.filter.AddOptOuts(e.From.Email)
+	}
+}
+
+// OptOutRecruiter opts the recruiter behind convID out of future contact:
+// it records their address in the opt_outs table (so filter.Filter excludes
+// them regardless of classification from the next sync onward), clears any
+// digest events already queued for the conversation, and - if a ReplySender
+// is configured - sends a one-shot "please remove me from your list" reply
+// so the recruiter hears it directly too.
+func (t *Tracker) OptOutRecruiter(ctx context.Context, convID string) error {
+	conv, err := t.db.GetConversation(ctx, convID)
+	if err != nil {
+		return fmt.Errorf("failed to load conversation: %w", err)
+	}
+	if conv == nil {
+		return fmt.Errorf("conversation not found: %s", convID)
+	}
+	if conv.RecruiterEmail == nil || *conv.RecruiterEmail == "" {
+		return fmt.Errorf("conversation has no recruiter email to opt out")
+	}
+
+	if err := t.db.CreateOptOut(ctx, *conv.RecruiterEmail, "command", &conv.ID); err != nil {
+		return fmt.Errorf("failed to record opt-out: %w", err)
+	}
+	if t.filter != nil {
+		t.filter.AddOptOuts(*conv.RecruiterEmail)
+	}
+
+	if err := t.db.ClearDigestQueueForConversation(ctx, conv.ID); err != nil {
+		return fmt.Errorf("failed to clear queued digest events: %w", err)
+	}
+
+	if t.replySender != nil {
+		subject := "Re: " + conv.Company
+		body := "Please remove me from your list - I'd like to stop receiving emails about this role."
+		if err := t.replySender.SendReply(ctx, *conv.RecruiterEmail, subject, body); err != nil {
+			return fmt.Errorf("failed to send opt-out reply: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ const (
 	PhaseListingEmails  ProgressPhase = "listing"
 	PhaseFetchingEmails ProgressPhase = "fetching"
 	PhaseFiltering      ProgressPhase = "filtering"
+	PhaseScoringBayes   ProgressPhase = "scoring_bayes"
 	PhaseClassifying    ProgressPhase = "classifying"
 	PhaseValidating     ProgressPhase = "validating"
 	PhaseProcessing     ProgressPhase = "processing"
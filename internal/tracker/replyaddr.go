@@ -0,0 +1,92 @@
+package tracker
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// replyAddrPrefix marks addresses generated by EncodeReplyAddress so
+// DecodeReplyAddress can recognize them without matching unrelated mail
+// sent to the same domain.
+const replyAddrPrefix = "jobs+"
+
+// hmacTokenChars bounds the length of the signature appended to the
+// conversation ID, trading a longer address for less truncation risk.
+const hmacTokenChars = 10
+
+var base32NoPad = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EncodeReplyAddress builds a "jobs+<convID>-<sig>@domain" address that
+// routes a reply back to convID. The signature binds the address to convID
+// so an incoming reply can be trusted (and the conversation looked up)
+// without a separate token table.
+func EncodeReplyAddress(secret []byte, convID, domain string) string {
+	return fmt.Sprintf("%s%s-%s@%s", replyAddrPrefix, convID, signConvID(secret, convID), domain)
+}
+
+// DecodeReplyAddress extracts and verifies the conversation ID embedded in
+// an address built by EncodeReplyAddress. ok is false for addresses that
+// aren't ours, or whose signature doesn't verify against secret (tampered,
+// or signed with a different secret).
+func DecodeReplyAddress(secret []byte, addr string) (convID string, ok bool) {
+	local := addr
+	if at := strings.LastIndex(addr, "@"); at >= 0 {
+		local = addr[:at]
+	}
+	if !strings.HasPrefix(local, replyAddrPrefix) {
+		return "", false
+	}
+	local = strings.TrimPrefix(local, replyAddrPrefix)
+
+	sep := strings.LastIndex(local, "-")
+	if sep < 0 {
+		return "", false
+	}
+	convID, sig := local[:sep], local[sep+1:]
+
+	if !hmac.Equal([]byte(sig), []byte(signConvID(secret, convID))) {
+		return "", false
+	}
+	return convID, true
+}
+
+func signConvID(secret []byte, convID string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(convID))
+	sig := strings.ToLower(base32NoPad.EncodeToString(mac.Sum(nil)))
+	if len(sig) > hmacTokenChars {
+		sig = sig[:hmacTokenChars]
+	}
+	return sig
+}
+
+// LoadOrCreateReplySecret reads the HMAC signing key at path, generating and
+// persisting a new random one (0600) on first use.
+func LoadOrCreateReplySecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return data, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read reply secret: %w", err)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate reply secret: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create reply secret directory: %w", err)
+	}
+	if err := os.WriteFile(path, secret, 0600); err != nil {
+		return nil, fmt.Errorf("failed to save reply secret: %w", err)
+	}
+	return secret, nil
+}
@@ -0,0 +1,45 @@
+package tracker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
+)
+
+// evaluateRules runs conv through t.ruleEngine, if one is configured. It's
+// called alongside every t.notifier.Notify call site so classifier output
+// and conversation-lifecycle changes (new conversation, stage change) both
+// trigger rule evaluation, not just the fixed EventType notifications.
+func (t *Tracker) evaluateRules(ctx context.Context, conv *database.Conversation) {
+	if t.ruleEngine == nil {
+		return
+	}
+
+	ruleCtx := notify.RuleContext{
+		Stage:              string(conv.Status),
+		Company:            conv.Company,
+		DaysSinceLastReply: conv.DaysSinceActivity(),
+		SenderDomain:       senderDomain(conv.RecruiterEmail),
+	}
+	if t.config != nil {
+		ruleCtx.Watchlist = t.config.Notify.Watchlist
+		ruleCtx.Whitelist = t.config.Filters.DomainWhitelist
+	}
+
+	_, _ = t.ruleEngine.Evaluate(ctx, ruleCtx, notify.Event{Conversation: conv})
+}
+
+// senderDomain extracts the domain of a recruiter email address, or "" if
+// addr is nil or has no "@".
+func senderDomain(addr *string) string {
+	if addr == nil {
+		return ""
+	}
+	_, domain, found := strings.Cut(*addr, "@")
+	if !found {
+		return ""
+	}
+	return domain
+}
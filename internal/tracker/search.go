@@ -0,0 +1,20 @@
+package tracker
+
+import (
+	"context"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// Search finds conversations matching crit. It's a thin pass-through to
+// database.Query, kept on Tracker alongside the other user-facing
+// operations rather than having callers reach into t.db directly.
+func (t *Tracker) Search(ctx context.Context, crit database.SearchCriteria) ([]database.Conversation, error) {
+	return t.db.Query(ctx, crit)
+}
+
+// SearchEmails finds emails matching crit, the message-level counterpart
+// to Search.
+func (t *Tracker) SearchEmails(ctx context.Context, crit database.EmailSearchCriteria) ([]database.Email, error) {
+	return t.db.SearchEmails(ctx, crit)
+}
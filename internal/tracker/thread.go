@@ -3,8 +3,11 @@ package tracker
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/concurrency"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
 )
@@ -30,31 +33,33 @@ type Thread struct {
 	FetchedAt    time.Time              `json:"fetched_at"`
 }
 
-// FetchThread retrieves the full email thread for a conversation
-func (t *Tracker) FetchThread(ctx context.Context, companyOrID string) (*Thread, error) {
-	// Try to find conversation by company name first (exact match)
-	conv, err := t.db.GetConversationByCompany(ctx, companyOrID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to lookup conversation: %w", err)
-	}
+// FetchThreadOptions controls FetchThread's provider fan-out and progress
+// reporting, mirroring SyncOptions.
+type FetchThreadOptions struct {
+	// Progress, if set, receives PhaseFetchingEmails events as each email
+	// needing a provider round trip finishes fetching.
+	Progress ProgressCallback
+}
 
-	// If not found by company, try by ID
-	if conv == nil {
-		conv, err = t.db.GetConversation(ctx, companyOrID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to lookup conversation: %w", err)
-		}
-	}
+// FetchThread retrieves the full email thread for a conversation. companyOrID
+// is resolved via database.FindConversation: an exact ID or company match
+// wins outright, otherwise a full-text fallback match is used if it's
+// unambiguous - if it matches more than one conversation, the
+// *database.AmbiguousMatchError is returned as-is so the caller can show the
+// user what it matched.
+func (t *Tracker) FetchThread(ctx context.Context, companyOrID string) (*Thread, error) {
+	return t.FetchThreadWithOptions(ctx, companyOrID, FetchThreadOptions{})
+}
 
-	// If still not found, try search and use first result (partial match)
-	if conv == nil {
-		results, err := t.db.Search(ctx, companyOrID)
-		if err != nil {
-			return nil, fmt.Errorf("search failed: %w", err)
-		}
-		if len(results) > 0 {
-			conv = &results[0]
-		}
+// FetchThreadWithOptions is FetchThread with provider fetch progress
+// reporting. Emails whose body isn't cached in the database are fetched
+// from the provider through a bounded worker pool (config.Sync.FetchConcurrency
+// workers) instead of one at a time, since for a long thread the fetch is
+// dominated by provider round-trip latency.
+func (t *Tracker) FetchThreadWithOptions(ctx context.Context, companyOrID string, opts FetchThreadOptions) (*Thread, error) {
+	conv, err := t.db.FindConversation(ctx, companyOrID, false)
+	if err != nil {
+		return nil, err
 	}
 
 	if conv == nil {
@@ -71,9 +76,9 @@ func (t *Tracker) FetchThread(ctx context.Context, companyOrID string) (*Thread,
 		return nil, fmt.Errorf("no emails found for conversation: %s", conv.Company)
 	}
 
-	// Fetch full content for each email from provider
-	var threadEmails []ThreadEmail
-	for _, dbEmail := range dbEmails {
+	threadEmails := make([]ThreadEmail, len(dbEmails))
+	var pending []int
+	for i, dbEmail := range dbEmails {
 		te := ThreadEmail{
 			ID:         dbEmail.ID,
 			From:       dbEmail.FromAddress,
@@ -95,34 +100,78 @@ func (t *Tracker) FetchThread(ctx context.Context, companyOrID string) (*Thread,
 			te.Snippet = *dbEmail.Snippet
 		}
 
-		// Check if body is cached in database
 		if dbEmail.BodyStored && dbEmail.BodyEncrypted != nil && *dbEmail.BodyEncrypted != "" {
-			// Use cached body
+			// Cached body, no provider round trip needed.
 			te.Body = *dbEmail.BodyEncrypted
 		} else {
-			// Fetch full email content from provider
-			fullEmail, err := t.provider.GetEmail(ctx, dbEmail.GmailID)
-			if err != nil {
-				// If fetch fails, use snippet as fallback
-				te.Body = te.Snippet
-			} else if fullEmail != nil {
-				te.Body = fullEmail.Body
-				// Update other fields if they were empty
-				if te.Subject == "" {
-					te.Subject = fullEmail.Subject
-				}
-				if te.To == "" && len(fullEmail.To) > 0 {
-					te.To = fullEmail.To[0].Email
-				}
-
-				// Cache the body for future use
-				if t.config.Privacy.StoreEmailBody {
-					_ = t.db.UpdateEmailBody(ctx, dbEmail.ID, fullEmail.Body)
-				}
-			}
+			pending = append(pending, i)
+		}
+
+		threadEmails[i] = te
+	}
+
+	// sql.DB is opened with MaxOpenConns(1), so body-cache writes from the
+	// fetch workers below are funneled through a single writer goroutine
+	// rather than called directly from each worker.
+	type bodyWrite struct {
+		emailID string
+		body    string
+	}
+	writes := make(chan bodyWrite)
+	var writerWG sync.WaitGroup
+	writerWG.Add(1)
+	go func() {
+		defer writerWG.Done()
+		for w := range writes {
+			_ = t.db.UpdateEmailBody(ctx, w.emailID, w.body)
+		}
+	}()
+
+	var done int32
+	report := func() {
+		if opts.Progress == nil {
+			return
+		}
+		current := atomic.AddInt32(&done, 1)
+		opts.Progress(Progress{Phase: PhaseFetchingEmails, Current: int(current), Total: len(pending)})
+	}
+
+	fetchErr := concurrency.ForEachJob(ctx, len(pending), t.config.Sync.FetchConcurrency, func(ctx context.Context, j int) error {
+		i := pending[j]
+		dbEmail := dbEmails[i]
+		te := &threadEmails[i]
+		defer report()
+
+		fullEmail, err := t.provider.GetEmail(ctx, dbEmail.GmailID)
+		if err != nil {
+			// If fetch fails, use snippet as fallback
+			te.Body = te.Snippet
+			return nil
+		}
+		if fullEmail == nil {
+			return nil
+		}
+
+		te.Body = fullEmail.Body
+		// Update other fields if they were empty
+		if te.Subject == "" {
+			te.Subject = fullEmail.Subject
+		}
+		if te.To == "" && len(fullEmail.To) > 0 {
+			te.To = fullEmail.To[0].Email
+		}
+
+		// Cache the body for future use
+		if t.config.Privacy.StoreEmailBody {
+			writes <- bodyWrite{emailID: dbEmail.ID, body: fullEmail.Body}
 		}
 
-		threadEmails = append(threadEmails, te)
+		return nil
+	})
+	close(writes)
+	writerWG.Wait()
+	if fetchErr != nil {
+		return nil, fetchErr
 	}
 
 	return &Thread{
@@ -10,17 +10,58 @@ import (
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/classifier"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/digest"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/email/gmail"
 	"github.com/vijay-prabhu/jobsearch-mcp/internal/filter"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/threading"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/transitions"
 )
 
-// Confidence thresholds for conditional validation
+// Confidence thresholds for LLM classification of uncertain emails
 const (
-	confidenceHighThreshold   = 0.8 // Above this: skip validation
-	confidenceMediumThreshold = 0.5 // Between medium and high: run validation
+	confidenceHighThreshold   = 0.8 // At/above this: include and mark Validated
+	confidenceMediumThreshold = 0.5 // Below this: too unsure to include at all
 )
 
+// incrementalProvider is implemented by email.Provider backends that can
+// fetch only what's changed since a previously returned watermark instead
+// of re-listing by date range - currently just *gmail.Provider, via the
+// Gmail History API. It's a tracker-local interface rather than part of
+// email.Provider itself since not every backend (e.g. JMAP) has an
+// equivalent cheap incremental primitive yet.
+type incrementalProvider interface {
+	FetchIncremental(ctx context.Context, watermark string) ([]email.Email, string, error)
+}
+
+// fetchIncremental fetches via provider's watermark-based incremental path
+// instead of a full date-ranged query, persisting the new watermark
+// (keyed by the authenticated user's address, same as internal/inbound's
+// mail_watermarks usage) so the next sync resumes from here.
+func (t *Tracker) fetchIncremental(ctx context.Context, provider incrementalProvider) ([]email.Email, error) {
+	watermark, err := t.db.GetMailWatermark(ctx, t.userEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load watermark: %w", err)
+	}
+
+	cursor := ""
+	if watermark != nil {
+		cursor = watermark.Cursor
+	}
+
+	emails, newCursor, err := provider.FetchIncremental(ctx, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.db.SetMailWatermark(ctx, t.userEmail, newCursor); err != nil {
+		return nil, fmt.Errorf("failed to advance watermark: %w", err)
+	}
+
+	return emails, nil
+}
+
 // processedEmail holds a filtered email with optional LLM classification
 type processedEmail struct {
 	filter.FilteredEmail
@@ -30,13 +71,17 @@ type processedEmail struct {
 
 // Tracker orchestrates the email sync and tracking pipeline
 type Tracker struct {
-	db         *database.DB
-	provider   email.Provider
-	filter     *filter.Filter
-	classifier *classifier.Client
-	config     *config.Config
-	learner    *Learner
-	userEmail  string
+	db              *database.DB
+	provider        email.Provider
+	filter          *filter.Filter
+	classifier      *classifier.Client
+	config          *config.Config
+	learner         *Learner
+	userEmail       string
+	replySender     ReplySender
+	notifier        *notify.Registry
+	ruleEngine      *notify.RuleEngine
+	transitionRules []transitions.Rule
 }
 
 // New creates a new Tracker
@@ -47,29 +92,46 @@ func New(db *database.DB, provider email.Provider, f *filter.Filter, c *classifi
 		filter:     f,
 		classifier: c,
 		config:     cfg,
-		learner:    NewLearner(db),
+		learner:    NewLearner(db, cfg),
 	}
 }
 
 // SyncOptions configures the sync behavior
 type SyncOptions struct {
-	Days                 int              // Number of days to fetch (0 = use default or last sync)
-	FullSync             bool             // Ignore last sync time
-	Progress             ProgressCallback // Optional progress callback
-	BackgroundClassify   bool             // If true, skip classification and return quickly
-	SkipClassification   bool             // If true, skip LLM classification entirely
+	Days               int              // Number of days to fetch (0 = use default or last sync)
+	FullSync           bool             // Ignore last sync time
+	Progress           ProgressCallback // Optional progress callback
+	BackgroundClassify bool             // If true, skip classification and return quickly
+	SkipClassification bool             // If true, skip LLM classification entirely
+	DryRun             bool             // If true, print learner suggestions instead of writing them
+
+	// ExtraCriteria further restricts which emails are fetched, AND-ed
+	// against the date range above (see email.ParseSearchShorthand). A
+	// non-nil ExtraCriteria bypasses the provider's incremental fetch path
+	// the same way an explicit Days/FullSync does, since incremental sync
+	// ignores Criteria entirely and this filter needs to actually apply.
+	ExtraCriteria *email.SearchCriteria
 }
 
 // SyncResult contains the results of a sync operation
 type SyncResult struct {
-	EmailsFetched          int
-	EmailsFiltered         int
-	EmailsClassified       int
-	EmailsPendingClassify  int  // Emails skipped for background classification
-	ConversationsNew       int
-	ConversationsUpdated   int
-	ClassificationSkipped  bool // True if classification was skipped
-	Errors                 []error
+	EmailsFetched         int
+	EmailsFiltered        int
+	EmailsClassified      int
+	EmailsPendingClassify int // Emails skipped for background classification
+	ConversationsNew      int
+	ConversationsUpdated  int
+	ClassificationSkipped bool     // True if classification was skipped
+	FiltersDemoted        []string // Values demoted from ai_confirmed back to ai_suggested
+	EmailsBayesFiltered   int      // Uncertain emails auto-excluded by the Bayes classifier, skipping the LLM
+	// BayesExcluded carries a filter.LayerBayes Result (with a Reason citing
+	// the classifier's top contributing tokens) for every email counted in
+	// EmailsBayesFiltered, so a caller that wants to explain a sync rather
+	// than just total it can see why each one was dropped.
+	BayesExcluded   []filter.FilteredEmail
+	BouncesDetected int // Delivery-status notifications recorded via HandleBounce
+	CommandsApplied int // "#jobsearch ..." self-reply directives applied via applyInboxCommand
+	Errors          []error
 }
 
 // Sync fetches new emails and processes them with default options
@@ -104,7 +166,7 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 	t.filter.SetUserEmail(userEmail)
 
 	// Load learned blacklist from database and add to filter
-	learnedBlacklist, err := t.db.GetLearnedBlacklist(ctx)
+	learnedBlacklist, err := t.db.GetLearnedFiltersByType(ctx, database.FilterTypeDomainBlacklist)
 	if err != nil {
 		// Non-fatal: log and continue
 		result.Errors = append(result.Errors, fmt.Errorf("failed to load learned blacklist: %w", err))
@@ -112,6 +174,14 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 		t.filter.AddLearnedFilters("domain_blacklist", learnedBlacklist)
 	}
 
+	// Load opted-out addresses from database and add to filter
+	optOuts, err := t.db.GetOptOutAddresses(ctx)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Errorf("failed to load opt-outs: %w", err))
+	} else if len(optOuts) > 0 {
+		t.filter.AddOptOuts(optOuts...)
+	}
+
 	// Get sync state
 	syncState, err := t.db.GetSyncState(ctx)
 	if err != nil {
@@ -126,13 +196,17 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 	if syncOpts.Days > 0 {
 		// Use custom days range
 		after := time.Now().AddDate(0, 0, -syncOpts.Days)
-		opts.After = &after
+		opts.Criteria.After = &after
 	} else if syncOpts.FullSync {
 		// Full sync - use default 30 days, ignore last sync
-		// opts.After is already set by DefaultFetchOptions
+		// opts.Criteria.After is already set by DefaultFetchOptions
 	} else if syncState.LastSyncAt != nil {
 		// Incremental sync - fetch since last sync
-		opts.After = syncState.LastSyncAt
+		opts.Criteria.After = syncState.LastSyncAt
+	}
+
+	if syncOpts.ExtraCriteria != nil {
+		opts.Criteria = email.SearchCriteria{All: []email.SearchCriteria{opts.Criteria, *syncOpts.ExtraCriteria}}
 	}
 
 	// Set up progress callback for email provider
@@ -147,13 +221,32 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 		})
 	}
 
-	// Fetch emails
-	emails, err := t.provider.FetchEmails(ctx, opts)
+	// Fetch emails. An explicit --days or --full bypasses the incremental
+	// path in favor of FetchEmails' bounded query, since both ask for a
+	// specific range rather than "whatever changed since last time".
+	var emails []email.Email
+	incremental, supportsIncremental := t.provider.(incrementalProvider)
+	if supportsIncremental && syncOpts.Days == 0 && !syncOpts.FullSync && syncOpts.ExtraCriteria == nil {
+		emails, err = t.fetchIncremental(ctx, incremental)
+	} else {
+		emails, err = t.provider.FetchEmails(ctx, opts)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch emails: %w", err)
 	}
 	result.EmailsFetched = len(emails)
 
+	// Detect delivery-status notifications among the emails just fetched
+	// and route each to HandleBounce instead of the normal filter/classify
+	// pipeline - a bounce isn't a recruiter conversation in its own right.
+	emails = t.handleBounces(ctx, result, emails)
+
+	// Detect "#jobsearch ..." self-reply directives among the remaining
+	// emails and apply each to the conversation it targets, for the same
+	// reason bounces are pulled out above - a command reply isn't a
+	// recruiter conversation in its own right either.
+	emails = t.handleInboxCommands(ctx, result, emails)
+
 	if len(emails) == 0 {
 		// Update sync state even if no new emails
 		now := time.Now()
@@ -162,6 +255,11 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 		return result, nil
 	}
 
+	// Detect unsubscribe requests among the emails just fetched and record
+	// them before filtering, so an opt-out learned from this batch also
+	// applies to the rest of this same sync rather than only the next one.
+	t.learnOptOuts(ctx, emails)
+
 	// Apply filtering
 	report(PhaseFiltering, 0, len(emails), "Applying filters")
 	filtered := t.filter.ApplyBatch(emails)
@@ -179,70 +277,63 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 		toProcess = append(toProcess, processedEmail{FilteredEmail: fe})
 	}
 
+	// Score uncertain emails with the local Bayes classifier before spending
+	// an LLM call on them: a high enough spam score auto-excludes without
+	// the classifier at all, while a lower one is just surfaced alongside
+	// whatever the LLM decides.
+	if t.learner != nil && len(uncertain) > 0 {
+		report(PhaseScoringBayes, 0, len(uncertain), "Scoring with Bayes classifier")
+		stillUncertain := uncertain[:0]
+		for i, fe := range uncertain {
+			pSpam, tokens, err := t.learner.ExplainSpam(ctx, &fe.Email)
+			switch {
+			case err != nil:
+				result.Errors = append(result.Errors, fmt.Errorf("bayes scoring failed: %w", err))
+				stillUncertain = append(stillUncertain, fe)
+			case pSpam >= t.config.Learning.BayesAutoFilterThreshold:
+				result.EmailsBayesFiltered++
+				result.BayesExcluded = append(result.BayesExcluded, filter.FilteredEmail{
+					Email: fe.Email,
+					Result: filter.Result{
+						Include:    false,
+						Layer:      filter.LayerBayes,
+						Confidence: pSpam,
+						Reason:     bayesExclusionReason(pSpam, tokens),
+					},
+				})
+			default:
+				fe.Result.Confidence = pSpam
+				stillUncertain = append(stillUncertain, fe)
+			}
+			report(PhaseScoringBayes, i+1, len(uncertain), "Scoring with Bayes classifier")
+		}
+		uncertain = stillUncertain
+	}
+
 	// Classify uncertain emails with LLM (unless skipped or background mode)
 	skipClassification := syncOpts.SkipClassification || syncOpts.BackgroundClassify
 	if len(uncertain) > 0 && t.classifier != nil && t.classifier.IsRunning(ctx) && !skipClassification {
-		// Use batch API for faster classification (5 emails per LLM call)
-		const batchSize = 5
-		var batchResults []classifier.BatchClassifyResult
-
-		report(PhaseClassifying, 0, len(uncertain), "Classifying with LLM")
-
-		for batchStart := 0; batchStart < len(uncertain); batchStart += batchSize {
-			batchEnd := batchStart + batchSize
-			if batchEnd > len(uncertain) {
-				batchEnd = len(uncertain)
-			}
-
-			// Build batch items
-			batchEmails := make([]classifier.BatchEmailItem, batchEnd-batchStart)
-			for i, e := range uncertain[batchStart:batchEnd] {
-				batchEmails[i] = classifier.BatchEmailItem{
-					Subject:     e.Email.Subject,
-					Body:        e.Email.Body,
-					FromAddress: e.Email.From.Email,
-				}
-			}
-
-			// Try batch API first
-			batchResp, err := t.classifier.ClassifyBatchAPI(ctx, batchEmails, t.config.LLM.Primary)
-			if err != nil {
-				// Fallback to individual classification
-				for i, e := range uncertain[batchStart:batchEnd] {
-					req := classifier.ClassifyRequest{
-						EmailSubject: e.Email.Subject,
-						EmailBody:    e.Email.Body,
-						EmailFrom:    e.Email.From.Email,
-					}
-					resp, classifyErr := t.classifier.ClassifyWithFallback(ctx, req, t.config.LLM.Primary, t.config.LLM.Fallback)
-					batchResults = append(batchResults, classifier.BatchClassifyResult{
-						Index:    batchStart + i,
-						Response: resp,
-						Error:    classifyErr,
-					})
-					report(PhaseClassifying, batchStart+i+1, len(uncertain), "Classifying with LLM")
-				}
-			} else {
-				// Use batch results
-				for i, resp := range batchResp.Results {
-					batchResults = append(batchResults, classifier.BatchClassifyResult{
-						Index:    batchStart + i,
-						Response: &resp,
-						Error:    nil,
-					})
-				}
-				report(PhaseClassifying, batchEnd, len(uncertain), "Classifying with LLM")
+		requests := make([]classifier.ClassifyRequest, len(uncertain))
+		for i, e := range uncertain {
+			requests[i] = classifier.ClassifyRequest{
+				EmailSubject: e.Email.Subject,
+				EmailBody:    e.Email.Body,
+				EmailFrom:    e.Email.From.Email,
 			}
 		}
 
-		// Process results - collect emails that need validation
-		var needsValidation []struct {
-			index          int
-			email          *filter.FilteredEmail
-			classification *classifier.ClassifyResponse
-		}
+		report(PhaseClassifying, 0, len(uncertain), "Classifying with LLM")
+		batchResults := t.classifier.ClassifyBatchWithProgress(ctx, requests, t.config.LLM.Primary, t.config.LLM.Fallback,
+			func(current, total int) {
+				report(PhaseClassifying, current, total, "Classifying with LLM")
+			})
 
-		for i, br := range batchResults {
+		// Below confidenceHighThreshold the classification is included but
+		// marked unvalidated rather than discarded, since the classifier
+		// service has no separate revalidation endpoint to confirm a
+		// medium-confidence call - the reviewer sees it flagged instead of
+		// silently dropped or silently trusted.
+		for _, br := range batchResults {
 			if br.Error != nil {
 				result.Errors = append(result.Errors, fmt.Errorf("classification failed: %w", br.Error))
 				continue
@@ -250,91 +341,22 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 
 			result.EmailsClassified++
 			classification := br.Response
-
-			if classification.IsJobRelated {
-				e := &uncertain[i]
-
-				// Check if validation is needed (medium confidence)
-				if classification.Confidence < confidenceHighThreshold &&
-					classification.Confidence >= confidenceMediumThreshold {
-					needsValidation = append(needsValidation, struct {
-						index          int
-						email          *filter.FilteredEmail
-						classification *classifier.ClassifyResponse
-					}{i, e, classification})
-					continue
-				}
-
-				// High confidence - include without validation
-				e.Result.Include = true
-				e.Result.Layer = filter.LayerLLM
-				e.Result.Confidence = classification.Confidence
-				toProcess = append(toProcess, processedEmail{
-					FilteredEmail:  *e,
-					Classification: classification,
-				})
-
-				// Learn from this classification
-				if t.learner != nil {
-					_ = t.learner.LearnFromEmail(ctx, &e.Email, classification.Confidence)
-				}
+			if !classification.IsJobRelated || classification.Confidence < confidenceMediumThreshold {
+				continue
 			}
-		}
-
-		// Run validation for medium-confidence emails
-		if len(needsValidation) > 0 {
-			report(PhaseValidating, 0, len(needsValidation), "Validating uncertain emails")
-
-			for j, nv := range needsValidation {
-				report(PhaseValidating, j+1, len(needsValidation), "Validating uncertain emails")
 
-				valReq := classifier.ValidateRequest{
-					EmailSubject: nv.email.Email.Subject,
-					EmailBody:    nv.email.Email.Body,
-					EmailFrom:    nv.email.Email.From.Email,
-				}
-
-				valResp, err := t.classifier.ValidateWithFallback(ctx, valReq, t.config.LLM.Primary, t.config.LLM.Fallback)
-				if err != nil {
-					// Validation failed - use original classification conservatively
-					result.Errors = append(result.Errors, fmt.Errorf("validation failed for email: %w", err))
-					// Include with original classification but flag for review
-					nv.email.Result.Include = true
-					nv.email.Result.Layer = filter.LayerLLM
-					nv.email.Result.Confidence = nv.classification.Confidence
-					toProcess = append(toProcess, processedEmail{
-						FilteredEmail:  *nv.email,
-						Classification: nv.classification,
-						Validated:      false,
-					})
-					continue
-				}
+			e := &uncertain[br.Index]
+			e.Result.Include = true
+			e.Result.Layer = filter.LayerLLM
+			e.Result.Confidence = classification.Confidence
+			toProcess = append(toProcess, processedEmail{
+				FilteredEmail:  *e,
+				Classification: classification,
+				Validated:      classification.Confidence >= confidenceHighThreshold,
+			})
 
-				// Use validation result
-				if valResp.FinalVerdict {
-					// Validation confirms - include
-					nv.email.Result.Include = true
-					nv.email.Result.Layer = filter.LayerLLM
-					nv.email.Result.Confidence = valResp.Confidence
-					toProcess = append(toProcess, processedEmail{
-						FilteredEmail:  *nv.email,
-						Classification: nv.classification,
-						Validated:      true,
-					})
-
-					// Learn from validated classification
-					if t.learner != nil {
-						_ = t.learner.LearnFromEmail(ctx, &nv.email.Email, valResp.Confidence)
-					}
-				} else {
-					// Validation rejects - this is a false positive caught by validation
-					// Log for metrics tracking but don't include
-					if valResp.Reasoning != nil {
-						result.Errors = append(result.Errors,
-							fmt.Errorf("validation rejected: %s (reason: %s)",
-								nv.email.Email.From.Email, *valResp.Reasoning))
-					}
-				}
+			if t.learner != nil {
+				_ = t.learner.LearnFromEmail(ctx, &e.Email, classification.Confidence, syncOpts.DryRun)
 			}
 		}
 	} else if len(uncertain) > 0 && skipClassification {
@@ -347,7 +369,7 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 	totalToProcess := len(toProcess)
 	for i, pe := range toProcess {
 		report(PhaseProcessing, i+1, totalToProcess, "Processing emails into conversations")
-		newConv, err := t.processEmail(ctx, &pe)
+		_, newConv, err := t.processEmail(ctx, &pe)
 		if err != nil {
 			result.Errors = append(result.Errors, err)
 			continue
@@ -374,26 +396,41 @@ func (t *Tracker) SyncWithOptions(ctx context.Context, syncOpts SyncOptions) (*S
 		result.Errors = append(result.Errors, fmt.Errorf("failed to update statuses: %w", err))
 	}
 
+	// Review confirmed filters for demotion now that this sync's feedback
+	// has been recorded.
+	if t.learner != nil {
+		demoted, err := t.learner.ReviewPromotions(ctx, syncOpts.DryRun)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("failed to review filter promotions: %w", err))
+		} else {
+			result.FiltersDemoted = demoted
+		}
+	}
+
 	return result, nil
 }
 
 // processEmail processes a single filtered email with optional classification
-func (t *Tracker) processEmail(ctx context.Context, pe *processedEmail) (bool, error) {
+func (t *Tracker) processEmail(ctx context.Context, pe *processedEmail) (string, bool, error) {
 	fe := &pe.FilteredEmail
 
 	// Check if email already exists
 	existing, err := t.db.GetEmailByGmailID(ctx, fe.Email.ID)
 	if err != nil {
-		return false, err
+		return "", false, err
 	}
 	if existing != nil {
-		return false, nil // Already processed
+		return existing.ConversationID, false, nil // Already processed
 	}
 
+	// Derive Message-ID/In-Reply-To/References for JWZ threading, before
+	// conversation lookup so it can use the reply chain.
+	messageID, inReplyTo, references := messageThreadHeaders(&fe.Email)
+
 	// Find or create conversation
-	conv, isNew, err := t.findOrCreateConversation(ctx, &fe.Email, pe.Classification)
+	conv, isNew, err := t.findOrCreateConversation(ctx, &fe.Email, pe.Classification, inReplyTo, references)
 	if err != nil {
-		return false, err
+		return "", false, err
 	}
 
 	// Determine direction
@@ -421,6 +458,12 @@ func (t *Tracker) processEmail(ctx context.Context, pe *processedEmail) (bool, e
 		Snippet:        &snippet,
 		Classification: &layer,
 		Confidence:     &confidence,
+		MessageID:      &messageID,
+		InReplyTo:      &inReplyTo,
+	}
+	if referencesJSON, err := json.Marshal(references); err == nil {
+		jsonStr := string(referencesJSON)
+		dbEmail.References = &jsonStr
 	}
 
 	// Store extracted data from LLM if available
@@ -438,53 +481,84 @@ func (t *Tracker) processEmail(ctx context.Context, pe *processedEmail) (bool, e
 		}
 	}
 
-	if err := t.db.CreateEmail(ctx, dbEmail); err != nil {
-		return false, err
+	// Store the email, bump the conversation's email count, and (if this
+	// email is newer) its last activity timestamp all in one transaction,
+	// so a failure partway through doesn't leave the email recorded but the
+	// conversation's counters stale, or vice versa.
+	updateActivity := fe.Email.Date.After(conv.LastActivityAt)
+	if updateActivity {
+		conv.LastActivityAt = fe.Email.Date
 	}
-
-	// Update conversation
-	if err := t.db.IncrementEmailCount(ctx, conv.ID); err != nil {
-		return false, err
+	if err := t.db.RecordEmailAtomic(ctx, dbEmail, conv, updateActivity); err != nil {
+		return "", false, err
 	}
 
-	// Update last activity
-	if fe.Email.Date.After(conv.LastActivityAt) {
-		conv.LastActivityAt = fe.Email.Date
+	if len(fe.Email.Events) > 0 {
+		scheduled, err := t.handleInterviews(ctx, conv, fe.Email.Events)
+		if err != nil {
+			return conv.ID, isNew, fmt.Errorf("failed to handle calendar events: %w", err)
+		}
 		if err := t.db.UpdateConversation(ctx, conv); err != nil {
-			return false, err
+			return conv.ID, isNew, fmt.Errorf("failed to update conversation status: %w", err)
+		}
+		if scheduled != nil && t.notifier != nil {
+			_ = t.notifier.Notify(ctx, notify.Event{Type: notify.EventInterviewScheduled, Conversation: conv, Interview: scheduled})
 		}
 	}
 
-	return isNew, nil
-}
+	if direction == database.DirectionInbound && len(t.transitionRules) > 0 {
+		if err := t.applyContentTransition(ctx, conv, &fe.Email, messageID); err != nil {
+			return conv.ID, isNew, fmt.Errorf("failed to apply content transition: %w", err)
+		}
+	}
 
-// findOrCreateConversation finds an existing conversation or creates a new one
-func (t *Tracker) findOrCreateConversation(ctx context.Context, e *email.Email, classification *classifier.ClassifyResponse) (*database.Conversation, bool, error) {
-	// First, try to find by thread ID (exact thread match)
-	conv, err := t.db.GetConversationByThreadID(ctx, e.ThreadID)
-	if err != nil {
-		return nil, false, err
+	if isNew && t.config.Digest.Enabled {
+		if err := t.db.EnqueueDigestEvent(ctx, t.userEmail, conv.ID, digest.EventNewConversation); err != nil {
+			return conv.ID, isNew, fmt.Errorf("failed to queue digest event: %w", err)
+		}
 	}
-	if conv != nil {
-		return conv, false, nil
+
+	if isNew && t.notifier != nil {
+		_ = t.notifier.Notify(ctx, notify.Event{Type: notify.EventConversationCreated, Conversation: conv})
+	}
+	if isNew {
+		t.evaluateRules(ctx, conv)
 	}
 
-	// Determine recruiter email for smart grouping
-	groupByEmail := e.From.Email
-	if e.IsFromMe(t.userEmail) {
-		// For outbound emails, try to find recruiter from To address
-		if len(e.To) > 0 {
-			groupByEmail = e.To[0].Email
+	return conv.ID, isNew, nil
+}
+
+// findOrCreateConversation finds an existing conversation or creates a new
+// one. It prefers JWZ reply-chain threading (internal/threading) over
+// Gmail's thread ID and company-name matching: a recruiter who starts a new
+// Gmail thread to CC a colleague, or whose message gets forwarded from
+// another address, still lands in the same conversation as long as the
+// Message-ID chain says so.
+func (t *Tracker) findOrCreateConversation(ctx context.Context, e *email.Email, classification *classifier.ClassifyResponse, inReplyTo string, references []string) (*database.Conversation, bool, error) {
+	for _, ancestorID := range ancestorLookupOrder(inReplyTo, references) {
+		ancestor, err := t.db.GetEmailByMessageID(ctx, ancestorID)
+		if err != nil {
+			return nil, false, err
+		}
+		if ancestor == nil {
+			continue
+		}
+		conv, err := t.db.GetConversation(ctx, ancestor.ConversationID)
+		if err != nil {
+			return nil, false, err
+		}
+		if conv != nil {
+			return conv, false, nil
 		}
 	}
 
-	// Smart grouping: try to find existing conversation with same recruiter email
-	conv, err = t.db.GetConversationByRecruiterEmail(ctx, groupByEmail)
+	// Fall back to Gmail's own thread ID (reliable even when a message
+	// arrives without usable Message-ID headers).
+	conv, err := t.db.GetConversationByThreadID(ctx, e.ThreadID)
 	if err != nil {
 		return nil, false, err
 	}
 	if conv != nil {
-		// Found existing conversation with same recruiter - add email to it
 		return conv, false, nil
 	}
 
@@ -575,10 +649,50 @@ func (t *Tracker) extractCompanyName(e *email.Email, classification *classifier.
 	return company
 }
 
+// messageThreadHeaders extracts (or synthesizes) the Message-ID/
+// In-Reply-To/References a message carries, for JWZ threading.
+func messageThreadHeaders(e *email.Email) (messageID, inReplyTo string, references []string) {
+	if v, ok := e.Header("Message-ID"); ok {
+		if ids := threading.ParseMessageIDs(v); len(ids) > 0 {
+			messageID = ids[0]
+		}
+	}
+	if messageID == "" {
+		messageID = threading.SynthesizeMessageID(e.From.Email, e.Date, e.Subject)
+	}
+
+	if v, ok := e.Header("In-Reply-To"); ok {
+		if ids := threading.ParseMessageIDs(v); len(ids) > 0 {
+			inReplyTo = ids[0]
+		}
+	}
+	if v, ok := e.Header("References"); ok {
+		references = threading.ParseMessageIDs(v)
+	}
+	return messageID, inReplyTo, references
+}
+
+// ancestorLookupOrder returns the Message-IDs to check for an already-known
+// conversation, most recent ancestor first: In-Reply-To is the most direct
+// link, then the References chain read backwards (nearest ancestor to
+// furthest), skipping anything already covered by inReplyTo.
+func ancestorLookupOrder(inReplyTo string, references []string) []string {
+	var order []string
+	if inReplyTo != "" {
+		order = append(order, inReplyTo)
+	}
+	for i := len(references) - 1; i >= 0; i-- {
+		if references[i] != "" && references[i] != inReplyTo {
+			order = append(order, references[i])
+		}
+	}
+	return order
+}
+
 // updateAllStatuses updates the status of all active conversations
 func (t *Tracker) updateAllStatuses(ctx context.Context) error {
 	// Get all non-closed conversations
-	convs, err := t.db.ListConversations(ctx, database.ListOptions{})
+	convs, err := t.db.Query(ctx, database.SearchCriteria{})
 	if err != nil {
 		return err
 	}
@@ -595,14 +709,68 @@ func (t *Tracker) updateAllStatuses(ctx context.Context) error {
 
 		newStatus := ComputeStatus(emails, t.userEmail, t.config.Tracking.StaleAfterDays)
 		if newStatus != conv.Status {
+			if newStatus == database.StatusWaitingOnThem {
+				t.scheduleWaitingOnThemReminder(ctx, &conv)
+			}
 			conv.Status = newStatus
 			_ = t.db.UpdateConversation(ctx, &conv)
+			if t.config.Digest.Enabled {
+				_ = t.db.EnqueueDigestEvent(ctx, t.userEmail, conv.ID, digest.EventStageChange)
+			}
+			if t.notifier != nil {
+				eventType := notify.EventStatusChanged
+				if newStatus == database.StatusStale {
+					eventType = notify.EventStaleReminder
+				}
+				_ = t.notifier.Notify(ctx, notify.Event{Type: eventType, Conversation: &conv})
+			}
+			t.evaluateRules(ctx, &conv)
 		}
 	}
 
 	return nil
 }
 
+// scheduleWaitingOnThemReminder auto-creates a desktop reminder.Manager
+// follow-up when conv just transitioned into waiting_on_them, firing
+// WaitingOnThemThresholdDays later. AnchorStatus = StatusWaitingOnThem
+// means Manager auto-cancels it instead of firing if the recruiter already
+// replied (status moved on) by then. A zero threshold disables this.
+func (t *Tracker) scheduleWaitingOnThemReminder(ctx context.Context, conv *database.Conversation) {
+	days := t.config.Reminders.WaitingOnThemThresholdDays
+	if days == 0 {
+		return
+	}
+
+	_ = t.db.CreateReminder(ctx, &database.Reminder{
+		ConversationID: conv.ID,
+		FireAt:         time.Now().AddDate(0, 0, days),
+		Action:         database.ReminderActionDesktop,
+		AnchorStatus:   database.StatusWaitingOnThem,
+		Note:           fmt.Sprintf("Still waiting on %s after %d day(s) - consider following up", conv.Company, days),
+	})
+}
+
+// emailForLearning builds the email.Email the Bayes classifier and filter
+// learner train against from a stored database.Email, including the
+// subject, snippet, and body text tokenize draws its features from.
+func emailForLearning(dbEmail *database.Email) *email.Email {
+	e := &email.Email{
+		ID:   dbEmail.ID,
+		From: email.Address{Email: dbEmail.FromAddress},
+	}
+	if dbEmail.Subject != nil {
+		e.Subject = *dbEmail.Subject
+	}
+	if dbEmail.Snippet != nil {
+		e.Snippet = *dbEmail.Snippet
+	}
+	if dbEmail.BodyStored && dbEmail.BodyEncrypted != nil {
+		e.Body = *dbEmail.BodyEncrypted
+	}
+	return e
+}
+
 // MarkFalsePositive marks a conversation as incorrectly included (learns from mistake)
 func (t *Tracker) MarkFalsePositive(ctx context.Context, convID string) error {
 	conv, err := t.db.GetConversation(ctx, convID)
@@ -616,13 +784,7 @@ func (t *Tracker) MarkFalsePositive(ctx context.Context, convID string) error {
 		return fmt.Errorf("no emails found for conversation")
 	}
 
-	// Create email.Email from database.Email for the learner
-	e := &email.Email{
-		From: email.Address{Email: emails[0].FromAddress},
-	}
-	if emails[0].Subject != nil {
-		e.Subject = *emails[0].Subject
-	}
+	e := emailForLearning(&emails[0])
 
 	// Learn from feedback
 	if t.learner != nil {
@@ -633,7 +795,52 @@ func (t *Tracker) MarkFalsePositive(ctx context.Context, convID string) error {
 
 	// Mark conversation as closed
 	conv.Status = database.StatusClosed
-	return t.db.UpdateConversation(ctx, conv)
+	if err := t.db.UpdateConversation(ctx, conv); err != nil {
+		return err
+	}
+
+	if t.notifier != nil {
+		_ = t.notifier.Notify(ctx, notify.Event{Type: notify.EventFalsePositiveLearned, Conversation: conv})
+	}
+
+	return nil
+}
+
+// LearnConversation trains the Bayesian classifier directly from a
+// conversation's first email with an explicit label, without the blacklist
+// or status side effects MarkFalsePositive carries. It's the entry point for
+// the `jobsearch learn` command, used to bulk-label history rather than
+// correct a specific misclassification.
+func (t *Tracker) LearnConversation(ctx context.Context, convID, label string) error {
+	conv, err := t.db.GetConversation(ctx, convID)
+	if err != nil || conv == nil {
+		return fmt.Errorf("conversation not found: %s", convID)
+	}
+
+	emails, err := t.db.ListEmailsForConversation(ctx, conv.ID)
+	if err != nil || len(emails) == 0 {
+		return fmt.Errorf("no emails found for conversation")
+	}
+
+	e := emailForLearning(&emails[0])
+
+	if t.learner == nil {
+		return nil
+	}
+
+	return t.learner.bayes.Train(ctx, e, label)
+}
+
+// LearnKeywords runs a RAKE keyword-extraction pass over every
+// non-archived, non-closed conversation's emails and stages newly found
+// subject/body phrases as ai_suggested filters (see
+// Learner.LearnKeywordsFromCorpus). It returns the phrases staged this run.
+// If dryRun is set, nothing is written.
+func (t *Tracker) LearnKeywords(ctx context.Context, dryRun bool) (subjectPhrases, bodyPhrases []string, err error) {
+	if t.learner == nil {
+		return nil, nil, nil
+	}
+	return t.learner.LearnKeywordsFromCorpus(ctx, t.filter, dryRun)
 }
 
 // MarkFalseNegative records that an email was incorrectly excluded (for learning)
@@ -0,0 +1,55 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/email"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/notify"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/transitions"
+)
+
+// applyContentTransition evaluates t.transitionRules against e's subject
+// and snippet and, if one matches, moves conv to the matched status and
+// records the change against e's Message-ID so
+// "jobsearch feedback rollback-transition" can undo it.
+//
+// This is deliberately scoped to statuses the tracker already has: a
+// rule's Status is one of database's existing ConversationStatus values,
+// most commonly StatusClosed for a rejection reply or
+// StatusInterviewScheduled for a scheduling-link reply that arrives
+// without a calendar invite for Tracker.handleInterviews to parse.
+// "Ghosted" and "awaiting_response" from the original ask are
+// StatusStale and StatusWaitingOnThem, which ComputeStatus/
+// updateAllStatuses already derive from silence and reply direction -
+// no content rule is needed for either.
+func (t *Tracker) applyContentTransition(ctx context.Context, conv *database.Conversation, e *email.Email, messageID string) error {
+	match := transitions.Evaluate(e.Subject+"\n"+e.Snippet, t.transitionRules)
+	if match == nil || match.Status == conv.Status {
+		return nil
+	}
+
+	from := conv.Status
+	conv.Status = match.Status
+	if err := t.db.UpdateConversation(ctx, conv); err != nil {
+		return err
+	}
+	if err := t.db.RecordStatusTransition(ctx, &database.StatusTransition{
+		ConversationID: conv.ID,
+		MessageID:      messageID,
+		RuleName:       match.Rule,
+		FromStatus:     from,
+		ToStatus:       match.Status,
+		Confidence:     match.Confidence,
+	}); err != nil {
+		return fmt.Errorf("failed to log status transition: %w", err)
+	}
+
+	if match.Status == database.StatusClosed && t.notifier != nil {
+		_ = t.notifier.Notify(ctx, notify.Event{Type: notify.EventRejectionDetected, Conversation: conv})
+	}
+	t.evaluateRules(ctx, conv)
+
+	return nil
+}
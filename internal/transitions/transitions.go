@@ -0,0 +1,66 @@
+// Package transitions evaluates config.TransitionsConfig's declarative
+// regex rules against an inbound email's text, so Tracker can move a
+// conversation to a new status based on what a reply actually says rather
+// than just who sent it or how long it's been. See Tracker.
+// applyContentTransition for how a Match is applied and logged.
+package transitions
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+// Rule is a compiled config.TransitionRule.
+type Rule struct {
+	Name       string
+	Pattern    *regexp.Regexp
+	Status     database.ConversationStatus
+	Confidence float64
+}
+
+// Match reports which Rule fired and what it wants the conversation moved
+// to.
+type Match struct {
+	Rule       string
+	Status     database.ConversationStatus
+	Confidence float64
+}
+
+// BuildRules compiles cfg's rules, case-insensitively. A malformed regex is
+// a configuration error, same as notify.Rule's unparseable condition - it
+// surfaces immediately rather than silently never matching.
+func BuildRules(cfg config.TransitionsConfig) ([]Rule, error) {
+	rules := make([]Rule, 0, len(cfg.Rules))
+	for _, entry := range cfg.Rules {
+		pattern, err := regexp.Compile("(?i)" + entry.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: invalid pattern %q: %w", entry.Name, entry.Pattern, err)
+		}
+		rules = append(rules, Rule{
+			Name:       entry.Name,
+			Pattern:    pattern,
+			Status:     database.ConversationStatus(entry.Status),
+			Confidence: entry.Confidence,
+		})
+	}
+	return rules, nil
+}
+
+// Evaluate returns the highest-confidence rule in rules whose Pattern
+// matches text, or nil if none match. Ties keep whichever rule appears
+// first in rules, same tie-break as config declaration order.
+func Evaluate(text string, rules []Rule) *Match {
+	var best *Match
+	for _, rule := range rules {
+		if !rule.Pattern.MatchString(text) {
+			continue
+		}
+		if best == nil || rule.Confidence > best.Confidence {
+			best = &Match{Rule: rule.Name, Status: rule.Status, Confidence: rule.Confidence}
+		}
+	}
+	return best
+}
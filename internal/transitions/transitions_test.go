@@ -0,0 +1,48 @@
+package transitions
+
+import (
+	"testing"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/config"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+func TestEvaluate_HighestConfidenceWins(t *testing.T) {
+	rules, err := BuildRules(config.TransitionsConfig{Rules: []config.TransitionRule{
+		{Name: "rejection", Pattern: `unfortunately|moving forward with other candidates`, Status: "closed", Confidence: 0.9},
+		{Name: "scheduling_link", Pattern: `calendly\.com|cal\.com`, Status: "interview_scheduled", Confidence: 0.6},
+	}})
+	if err != nil {
+		t.Fatalf("BuildRules: %v", err)
+	}
+
+	match := Evaluate("Thanks for applying - Unfortunately, we're moving forward with other candidates. Feedback call: calendly.com/jordan", rules)
+	if match == nil || match.Rule != "rejection" || match.Status != database.StatusClosed {
+		t.Fatalf("expected rejection match, got %+v", match)
+	}
+}
+
+func TestEvaluate_CaseInsensitiveAndNoMatch(t *testing.T) {
+	rules, err := BuildRules(config.TransitionsConfig{Rules: []config.TransitionRule{
+		{Name: "scheduling_link", Pattern: `calendly\.com`, Status: "interview_scheduled", Confidence: 0.6},
+	}})
+	if err != nil {
+		t.Fatalf("BuildRules: %v", err)
+	}
+
+	if match := Evaluate("Pick a time here: https://CALENDLY.COM/jordan/phone-screen", rules); match == nil {
+		t.Error("expected case-insensitive match, got nil")
+	}
+	if match := Evaluate("Thanks for your application, we'll be in touch.", rules); match != nil {
+		t.Errorf("expected no match, got %+v", match)
+	}
+}
+
+func TestBuildRules_InvalidPattern(t *testing.T) {
+	_, err := BuildRules(config.TransitionsConfig{Rules: []config.TransitionRule{
+		{Name: "broken", Pattern: "(unclosed", Status: "closed", Confidence: 0.5},
+	}})
+	if err == nil {
+		t.Error("expected an error for an invalid regex, got nil")
+	}
+}
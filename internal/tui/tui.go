@@ -0,0 +1,430 @@
+// Package tui implements an interactive, three-pane terminal UI for
+// browsing conversations: a status-grouped list on the left, the message
+// thread for the selected conversation in the middle, and detected
+// action items / next steps on the right. It's a thin, hand-rolled
+// raw-terminal renderer (like internal/cli/terminal.go's spinner/color
+// helpers, just with full-screen redraws) rather than a pull of a
+// third-party TUI framework, to keep this package's only new dependency
+// the x/term raw-mode support the CLI already uses for password prompts.
+//
+// The TUI reads through the same database.SearchCriteria/ListOptions
+// plumbing as the MCP handlers and CLI commands, so filtering and status
+// grouping behave identically everywhere. Syncs are triggered through
+// internal/jobs so progress is visible the same way an MCP client polling
+// jobsearch://jobs would see it.
+package tui
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/jobs"
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/tracker"
+)
+
+// SyncFunc runs a full sync and reports progress as it goes. The caller
+// (cli/tui.go) builds this from the same Gmail provider, classifier, and
+// filter wiring internal/cli/sync.go uses, so a sync kicked off from the
+// TUI behaves exactly like `jobsearch sync`.
+type SyncFunc func(ctx context.Context, progress func(tracker.Progress)) (*tracker.SyncResult, error)
+
+// mode tracks what keystrokes the event loop should interpret as.
+type mode int
+
+const (
+	modeBrowse mode = iota
+	modeSearch
+	modeMerge
+)
+
+// statusOrder is the order conversation groups are rendered in, most
+// actionable first.
+var statusOrder = []database.ConversationStatus{
+	database.StatusWaitingOnMe,
+	database.StatusWaitingOnThem,
+	database.StatusStale,
+	database.StatusActive,
+	database.StatusClosed,
+}
+
+// row is one selectable line in the left pane: either a group header
+// (conv == nil) or a conversation under the current header.
+type row struct {
+	header string
+	conv   *database.Conversation
+}
+
+type model struct {
+	ctx   context.Context
+	db    *database.DB
+	queue *jobs.Queue
+	sync  SyncFunc
+
+	crit database.SearchCriteria
+	rows []row
+
+	selected int
+	emails   []database.Email
+
+	mode   mode
+	input  string
+	status string
+
+	syncJobID string
+	progress  tracker.Progress
+	syncing   bool
+
+	quit bool
+}
+
+// Run takes over the terminal and drives the TUI until the user quits or
+// ctx is cancelled. sync may be nil, in which case the 's' keybinding
+// reports that no sync is configured instead of enqueueing a job.
+func Run(ctx context.Context, db *database.DB, sync SyncFunc) error {
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("failed to enter raw terminal mode: %w", err)
+	}
+	defer term.Restore(fd, oldState)
+
+	m := &model{
+		ctx:   ctx,
+		db:    db,
+		queue: jobs.NewQueue(db),
+		sync:  sync,
+	}
+	m.reload()
+
+	keys := make(chan byte)
+	go readKeys(keys)
+
+	progressCh := make(chan tracker.Progress, 8)
+	doneCh := make(chan syncResult, 1)
+
+	for {
+		m.render()
+
+		if m.quit {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case b := <-keys:
+			m.handleKey(b, progressCh, doneCh)
+		case p := <-progressCh:
+			m.progress = p
+		case res := <-doneCh:
+			m.syncing = false
+			if res.err != nil {
+				m.status = fmt.Sprintf("sync failed: %v", res.err)
+			} else {
+				m.status = fmt.Sprintf("sync complete: %d new, %d updated conversations",
+					res.result.ConversationsNew, res.result.ConversationsUpdated)
+			}
+			m.reload()
+		}
+	}
+}
+
+type syncResult struct {
+	result *tracker.SyncResult
+	err    error
+}
+
+func readKeys(out chan<- byte) {
+	r := bufio.NewReader(os.Stdin)
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			close(out)
+			return
+		}
+		out <- b
+	}
+}
+
+// reload re-runs crit against the database and rebuilds the grouped row
+// list, keeping the current conversation selected if it's still present.
+func (m *model) reload() {
+	var keepID string
+	if c := m.selectedConversation(); c != nil {
+		keepID = c.ID
+	}
+
+	convs, err := m.db.Query(m.ctx, m.crit)
+	if err != nil {
+		m.status = fmt.Sprintf("query failed: %v", err)
+		return
+	}
+
+	byStatus := make(map[database.ConversationStatus][]database.Conversation)
+	for _, c := range convs {
+		byStatus[c.Status] = append(byStatus[c.Status], c)
+	}
+
+	m.rows = m.rows[:0]
+	for _, status := range statusOrder {
+		group := byStatus[status]
+		if len(group) == 0 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].LastActivityAt.After(group[j].LastActivityAt)
+		})
+		m.rows = append(m.rows, row{header: fmt.Sprintf("%s (%d)", status, len(group))})
+		for i := range group {
+			c := group[i]
+			m.rows = append(m.rows, row{conv: &c})
+		}
+	}
+
+	m.selected = 0
+	if keepID != "" {
+		for i, r := range m.rows {
+			if r.conv != nil && r.conv.ID == keepID {
+				m.selected = i
+				break
+			}
+		}
+	} else {
+		m.moveToNextConversation(1)
+	}
+	m.loadEmails()
+}
+
+func (m *model) selectedConversation() *database.Conversation {
+	if m.selected < 0 || m.selected >= len(m.rows) {
+		return nil
+	}
+	return m.rows[m.selected].conv
+}
+
+func (m *model) loadEmails() {
+	c := m.selectedConversation()
+	if c == nil {
+		m.emails = nil
+		return
+	}
+	emails, err := m.db.ListEmailsForConversation(m.ctx, c.ID)
+	if err != nil {
+		m.status = fmt.Sprintf("failed to load thread: %v", err)
+		return
+	}
+	m.emails = emails
+}
+
+// moveToNextConversation skips header rows, stepping by delta (+1/-1)
+// until it lands on a conversation row or runs off the end.
+func (m *model) moveToNextConversation(delta int) {
+	for i := m.selected; i >= 0 && i < len(m.rows); i += delta {
+		if m.rows[i].conv != nil {
+			m.selected = i
+			return
+		}
+	}
+}
+
+func (m *model) handleKey(b byte, progressCh chan tracker.Progress, doneCh chan syncResult) {
+	switch m.mode {
+	case modeSearch:
+		m.handleSearchKey(b)
+		return
+	case modeMerge:
+		m.handleMergeKey(b)
+		return
+	}
+
+	switch b {
+	case 'q', 3: // 3 = Ctrl-C
+		m.quit = true
+	case 'j':
+		m.step(1)
+	case 'k':
+		m.step(-1)
+	case '/':
+		m.mode = modeSearch
+		m.input = ""
+	case 'a':
+		m.archiveSelected()
+	case 'm':
+		if m.selectedConversation() != nil {
+			m.mode = modeMerge
+			m.input = ""
+		}
+	case 'e':
+		m.openInEditor()
+	case 's':
+		m.triggerSync(progressCh, doneCh)
+	}
+}
+
+func (m *model) step(delta int) {
+	next := m.selected + delta
+	for next >= 0 && next < len(m.rows) && m.rows[next].conv == nil {
+		next += delta
+	}
+	if next >= 0 && next < len(m.rows) {
+		m.selected = next
+		m.loadEmails()
+	}
+}
+
+func (m *model) handleSearchKey(b byte) {
+	switch b {
+	case '\r', '\n':
+		text := m.input
+		m.crit.FullText = &text
+		m.mode = modeBrowse
+		m.reload()
+	case 27: // Esc
+		m.mode = modeBrowse
+	case 127, 8: // Backspace
+		if len(m.input) > 0 {
+			m.input = m.input[:len(m.input)-1]
+		}
+	default:
+		if b >= 32 && b < 127 {
+			m.input += string(b)
+		}
+	}
+}
+
+func (m *model) handleMergeKey(b byte) {
+	switch b {
+	case '\r', '\n':
+		target := m.input
+		m.mode = modeBrowse
+		m.mergeInto(target)
+	case 27:
+		m.mode = modeBrowse
+	case 127, 8:
+		if len(m.input) > 0 {
+			m.input = m.input[:len(m.input)-1]
+		}
+	default:
+		if b >= 32 && b < 127 {
+			m.input += string(b)
+		}
+	}
+}
+
+func (m *model) archiveSelected() {
+	c := m.selectedConversation()
+	if c == nil {
+		return
+	}
+	if _, err := m.db.ArchiveConversation(m.ctx, c.ID); err != nil {
+		m.status = fmt.Sprintf("archive failed: %v", err)
+		return
+	}
+	m.status = fmt.Sprintf("archived %s", c.Company)
+	m.reload()
+}
+
+func (m *model) mergeInto(target string) {
+	c := m.selectedConversation()
+	if c == nil || target == "" {
+		return
+	}
+	result, err := m.db.MergeConversations(m.ctx, c.ID, target)
+	if err != nil {
+		m.status = fmt.Sprintf("merge failed: %v", err)
+		return
+	}
+	m.status = fmt.Sprintf("merged into %s: %d emails moved", c.Company, result.EmailsMoved)
+	m.reload()
+}
+
+// openInEditor writes the most recent email in the selected thread to a
+// temp file and opens it in $EDITOR, restoring the terminal's raw mode
+// around the external process the way gmail/auth.go shells out to a
+// browser for the OAuth flow.
+func (m *model) openInEditor() {
+	if len(m.emails) == 0 {
+		return
+	}
+	e := m.emails[len(m.emails)-1]
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	f, err := os.CreateTemp("", "jobsearch-email-*.eml")
+	if err != nil {
+		m.status = fmt.Sprintf("failed to open editor: %v", err)
+		return
+	}
+	defer os.Remove(f.Name())
+
+	fmt.Fprintf(f, "From: %s\nDate: %s\nSubject: %s\n\n", e.FromAddress, e.Date, subjectOf(e))
+	if e.BodyEncrypted != nil {
+		fmt.Fprintln(f, *e.BodyEncrypted)
+	} else if e.Snippet != nil {
+		fmt.Fprintln(f, *e.Snippet)
+	}
+	f.Close()
+
+	fd := int(os.Stdin.Fd())
+	state, _ := term.GetState(fd)
+
+	cmd := exec.Command(editor, f.Name())
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		m.status = fmt.Sprintf("editor exited with error: %v", err)
+	}
+
+	if state != nil {
+		term.Restore(fd, state)
+	}
+}
+
+func (m *model) triggerSync(progressCh chan tracker.Progress, doneCh chan syncResult) {
+	if m.sync == nil {
+		m.status = "sync is not available in this session"
+		return
+	}
+	if m.syncing {
+		m.status = fmt.Sprintf("sync already running (job %s)", m.syncJobID)
+		return
+	}
+
+	job, err := m.queue.CreateJob(m.ctx, jobs.TypeSync, jobs.PriorityUserSync, time.Now(), struct{}{})
+	if err != nil {
+		m.status = fmt.Sprintf("failed to enqueue sync job: %v", err)
+		return
+	}
+	m.syncJobID = job.ID
+	m.syncing = true
+	m.status = fmt.Sprintf("sync job %s queued", job.ID)
+
+	pool := jobs.NewPool(m.queue, jobs.TypeSync, 1, func(ctx context.Context, _ *jobs.Job) error {
+		result, err := m.sync(ctx, func(p tracker.Progress) {
+			select {
+			case progressCh <- p:
+			default:
+			}
+		})
+		doneCh <- syncResult{result: result, err: err}
+		return err
+	})
+	go pool.RunOne(m.ctx)
+}
+
+func subjectOf(e database.Email) string {
+	if e.Subject != nil {
+		return *e.Subject
+	}
+	return "(no subject)"
+}
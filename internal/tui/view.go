@@ -0,0 +1,273 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/vijay-prabhu/jobsearch-mcp/internal/database"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorBold   = "\033[1m"
+	colorInvert = "\033[7m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorCyan   = "\033[36m"
+	colorGray   = "\033[90m"
+)
+
+func statusColor(status database.ConversationStatus) string {
+	switch status {
+	case database.StatusWaitingOnMe:
+		return colorRed
+	case database.StatusWaitingOnThem:
+		return colorYellow
+	case database.StatusStale:
+		return colorGray
+	case database.StatusClosed:
+		return colorGreen
+	default:
+		return colorCyan
+	}
+}
+
+// render redraws the full screen: three side-by-side panes plus a status
+// bar, using a full clear-and-redraw rather than tracking dirty regions -
+// simple, and plenty fast for a conversation list that tops out in the
+// hundreds of rows.
+func (m *model) render() {
+	width, height := 100, 30
+	if w, h, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 && h > 0 {
+		width, height = w, h
+	}
+
+	leftW := width * 3 / 10
+	rightW := width * 3 / 10
+	midW := width - leftW - rightW - 2
+	bodyHeight := height - 2 // reserve the last 2 lines for the status bar
+
+	left := m.renderList(leftW, bodyHeight)
+	mid := m.renderThread(midW, bodyHeight)
+	right := m.renderActions(rightW, bodyHeight)
+
+	var b strings.Builder
+	b.WriteString("\033[2J\033[H")
+	for i := 0; i < bodyHeight; i++ {
+		b.WriteString(padTo(line(left, i), leftW))
+		b.WriteString(" ")
+		b.WriteString(padTo(line(mid, i), midW))
+		b.WriteString(" ")
+		b.WriteString(padTo(line(right, i), rightW))
+		b.WriteString("\r\n")
+	}
+	b.WriteString(m.renderStatusBar(width))
+
+	fmt.Fprint(os.Stdout, b.String())
+}
+
+func (m *model) renderList(width, height int) []string {
+	var out []string
+	for i, r := range m.rows {
+		if r.conv == nil {
+			out = append(out, colorBold+truncate(r.header, width)+colorReset)
+			continue
+		}
+		c := r.conv
+		name := c.Company
+		if c.RecruiterName != nil && *c.RecruiterName != "" {
+			name = *c.RecruiterName + " @ " + c.Company
+		}
+		line := fmt.Sprintf("  %s (%dd)", name, c.DaysSinceActivity())
+		line = statusColor(c.Status) + truncate(line, width) + colorReset
+		if i == m.selected {
+			line = colorInvert + truncate(fmt.Sprintf("  %s (%dd)", name, c.DaysSinceActivity()), width) + colorReset
+		}
+		out = append(out, line)
+	}
+	return out
+}
+
+func (m *model) renderThread(width, height int) []string {
+	c := m.selectedConversation()
+	if c == nil {
+		return []string{colorGray + "No conversation selected" + colorReset}
+	}
+
+	var out []string
+	out = append(out, colorBold+truncate(fmt.Sprintf("%s - %s", c.Company, c.Status), width)+colorReset)
+	out = append(out, "")
+
+	for _, e := range m.emails {
+		from := e.FromAddress
+		if e.FromName != nil && *e.FromName != "" {
+			from = *e.FromName
+		}
+		dir := "<-"
+		if e.Direction == database.DirectionOutbound {
+			dir = "->"
+		}
+		out = append(out, fmt.Sprintf("%s %s  %s", dir, e.Date.Format("2006-01-02"), from))
+		if e.Subject != nil && *e.Subject != "" {
+			out = append(out, "  "+truncate(*e.Subject, width-2))
+		}
+		body := ""
+		if e.BodyEncrypted != nil {
+			body = *e.BodyEncrypted
+		} else if e.Snippet != nil {
+			body = *e.Snippet
+		}
+		out = append(out, wrap(body, width-2, 4)...)
+		out = append(out, "")
+	}
+	return out
+}
+
+func (m *model) renderActions(width, height int) []string {
+	c := m.selectedConversation()
+	out := []string{colorBold + "Action items" + colorReset, ""}
+	if c == nil {
+		return out
+	}
+
+	switch c.Status {
+	case database.StatusWaitingOnMe:
+		out = append(out, "Reply needed - it's your turn.")
+	case database.StatusStale:
+		out = append(out, fmt.Sprintf("Stale for %d days.", c.DaysSinceActivity()))
+		out = append(out, "Follow up or archive with 'a'.")
+	case database.StatusWaitingOnThem:
+		out = append(out, "Waiting on their reply.")
+	}
+	if c.SnoozedUntil != nil {
+		out = append(out, "", fmt.Sprintf("Snoozed until %s", c.SnoozedUntil.Format("2006-01-02")))
+	}
+	if c.Bounced {
+		out = append(out, "", colorRed+"This thread bounced."+colorReset)
+	}
+
+	if len(m.emails) > 0 {
+		last := m.emails[len(m.emails)-1]
+		if data, err := last.GetExtractedData(); err == nil && len(data) > 0 {
+			out = append(out, "", colorBold+"Extracted"+colorReset)
+			for k, v := range data {
+				out = append(out, fmt.Sprintf("%s: %v", k, v))
+			}
+		}
+	}
+
+	return out
+}
+
+func (m *model) renderStatusBar(width int) string {
+	stats, err := m.db.GetStats(m.ctx, nil)
+	var statsLine string
+	if err == nil {
+		statsLine = fmt.Sprintf("%d conversations | %d waiting on you | %d stale",
+			stats.TotalConversations, stats.WaitingOnMe, stats.Stale)
+	}
+
+	var help string
+	switch m.mode {
+	case modeSearch:
+		help = "search: " + m.input + "_"
+	case modeMerge:
+		help = "merge into (company or id): " + m.input + "_"
+	default:
+		help = "j/k move  / search  a archive  m merge  e edit  s sync  q quit"
+	}
+
+	line1 := padTo(statsLine, width)
+	line2 := padTo(help, width)
+	if m.status != "" && m.mode == modeBrowse {
+		line2 = padTo(m.status, width)
+	}
+	if m.syncing {
+		line1 = padTo(fmt.Sprintf("%s | syncing: %s", statsLine, m.progress.Phase), width)
+	}
+	return colorInvert + line1 + colorReset + "\r\n" + line2
+}
+
+func line(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
+
+func padTo(s string, width int) string {
+	visible := visibleLen(s)
+	if visible >= width {
+		return s
+	}
+	return s + strings.Repeat(" ", width-visible)
+}
+
+func truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	if width <= 1 {
+		return string(r[:width])
+	}
+	return string(r[:width-1]) + "…"
+}
+
+// wrap breaks s into width-wide lines, each indented by indent spaces.
+func wrap(s string, width, indent int) []string {
+	if width <= 0 {
+		width = 40
+	}
+	pad := strings.Repeat(" ", indent)
+	var out []string
+	for _, raw := range strings.Split(s, "\n") {
+		words := strings.Fields(raw)
+		if len(words) == 0 {
+			out = append(out, "")
+			continue
+		}
+		cur := pad
+		for _, w := range words {
+			if visibleLen(cur)+1+len(w) > width+indent && cur != pad {
+				out = append(out, cur)
+				cur = pad
+			}
+			if cur == pad {
+				cur += w
+			} else {
+				cur += " " + w
+			}
+		}
+		out = append(out, cur)
+	}
+	return out
+}
+
+// visibleLen approximates a string's on-screen width by stripping ANSI
+// escape sequences before counting runes.
+func visibleLen(s string) int {
+	n := 0
+	inEscape := false
+	for _, r := range s {
+		if inEscape {
+			if r == 'm' {
+				inEscape = false
+			}
+			continue
+		}
+		if r == '\033' {
+			inEscape = true
+			continue
+		}
+		n++
+	}
+	return n
+}